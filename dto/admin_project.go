@@ -0,0 +1,16 @@
+package dto
+
+// AdminProjectFilter narrows down the projects listed to admins, empty fields match every project.
+type AdminProjectFilter struct {
+	Creator    string `json:"creator" form:"creator" example:"alice"`
+	Status     string `json:"status" form:"status" example:"archived"`
+	Visibility string `json:"visibility" form:"visibility" example:"private"`
+}
+
+type ProjectVisibilityUpdate struct {
+	Visibility string `json:"visibility" example:"private"`
+}
+
+type ProjectStatusUpdate struct {
+	Status string `json:"status" example:"archived"`
+}
@@ -16,7 +16,12 @@ type ProjectCreation struct {
 	Tags         *[]string `json:"tags" example:"backend,api"`
 	LiveURL      *string   `json:"live_url" example:"https://example.com/live"`
 	Category     string    `json:"category" example:"Web Development"`
-	Contributors *[]string `json:"contributors" example:"2,3,4"`
+	// Usernames to invite, they are added as contributors once they accept.
+	Contributors *[]string `json:"contributors" example:"bob,carol"`
+	// Draft projects are only visible to the owner and contributors until published.
+	Draft bool `json:"draft" example:"false"`
+	// PublishAt creates the project as a draft that is published at that time.
+	PublishAt *time.Time `json:"publish_at" example:"2024-05-01T09:00:00Z"`
 }
 
 type ProjectResponse struct {
@@ -34,6 +39,8 @@ type ProjectResponse struct {
 	Cost                int            `json:"cost"`
 	Category            string         `json:"category"`
 	IsLiked             bool           `json:"is_liked"`
+	Version             string         `json:"version"`
+	ForkedFromID        *uint          `json:"forked_from_id,omitempty"`
 	CreatorDetails      Contributor    `json:"creator_details,omitempty"`
 	ContributorsDetails *[]Contributor `json:"contributors_details,omitempty"`
 	TagsDetails         *[]Tag         `json:"tags_details,omitempty"`
@@ -59,6 +66,7 @@ type ProjectResponseForPublic struct {
 	Version             string         `json:"version"`
 	Cost                int            `json:"cost"`
 	Category            string         `json:"category"`
+	ForkedFromID        *uint          `json:"forked_from_id,omitempty"`
 	CreatorDetails      Contributor    `json:"creator_details,omitempty"`
 	ContributorsDetails *[]Contributor `json:"contributors_details,omitempty"`
 	TagsDetails         *[]Tag         `json:"tags_details,omitempty"`
@@ -101,3 +109,19 @@ type ProjectsEssentialInfo struct {
 	CreatedAt  string `json:"created_at"`
 	UpdatedAt  string `json:"updated_at"`
 }
+
+// ProjectUpdate represents a project update request
+// @Description Only the fields that are sent are changed
+type ProjectUpdate struct {
+	Title        *string   `json:"title" example:"My Project"`
+	Image        *string   `json:"image" example:"https://example.com/image.jpg"`
+	Description  *string   `json:"description" example:"This is a sample project description"`
+	Status       *string   `json:"status" example:"archived"`
+	Visibility   *string   `json:"visibility" example:"public"`
+	GithubLink   *string   `json:"github_link" example:"https://github.com/user/project"`
+	LiveURL      *string   `json:"live_url" example:"https://example.com/live"`
+	Category     *string   `json:"category" example:"Web Development"`
+	Cost         *int      `json:"cost" example:"1500"`
+	Technologies *[]string `json:"technologies" example:"Go, Gin, GORM"`
+	Tags         *[]string `json:"tags" example:"backend,api"`
+}
@@ -0,0 +1,54 @@
+package dto
+
+import (
+	"io"
+	"time"
+)
+
+type ProjectAttachment struct {
+	ID          uint   `json:"id"`
+	Kind        string `json:"kind"`
+	Name        string `json:"name"`
+	Caption     string `json:"caption"`
+	ContentType string `json:"content_type"`
+	Size        int64  `json:"size"`
+	// Checksum is the hex encoded SHA-256 of the file.
+	Checksum   string               `json:"checksum"`
+	Position   int                  `json:"position"`
+	URL        string               `json:"url"`
+	Sizes      map[string]ImageSize `json:"sizes,omitempty"`
+	UploadedBy Contributor          `json:"uploaded_by"`
+	CreatedAt  time.Time            `json:"created_at"`
+}
+
+// ProjectAttachments splits the attachments into the image gallery and the other files,
+// both in position order.
+type ProjectAttachments struct {
+	Gallery []ProjectAttachment `json:"gallery"`
+	Files   []ProjectAttachment `json:"files"`
+}
+
+// AttachmentCaption represents a caption change
+// @Description New caption of the attachment
+type AttachmentCaption struct {
+	Caption string `json:"caption" example:"Wiring of the sensor board"`
+}
+
+// AttachmentOrder represents a reorder request
+// @Description Every attachment ID of the project, in the new order
+type AttachmentOrder struct {
+	IDs []uint `json:"ids" example:"3,1,2"`
+}
+
+// AttachmentFile is an attachment, or one of its image sizes, opened for download.
+//
+// The caller closes Content.
+type AttachmentFile struct {
+	Name        string
+	ContentType string
+	// Size is -1 for the image sizes, their length is not stored.
+	Size int64
+	// Inline is true for gallery images, other files are downloaded.
+	Inline  bool
+	Content io.ReadCloser
+}
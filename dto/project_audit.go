@@ -0,0 +1,33 @@
+package dto
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// ProjectAuditFilter represents the filters of the audit log, empty fields do not filter
+type ProjectAuditFilter struct {
+	Actor     string
+	Action    string
+	ProjectID uint
+	RequestID string
+	From      *time.Time
+	To        *time.Time
+}
+
+type ProjectAuditEntry struct {
+	ID        uint   `json:"id"`
+	RequestID string `json:"request_id"`
+	// Actor is empty for requests without a user, like webhook deliveries, and
+	// "system" for changes the module made on its own.
+	Actor     string `json:"actor"`
+	Action    string `json:"action"`
+	ProjectID *uint  `json:"project_id"`
+	Method    string `json:"method"`
+	Path      string `json:"path"`
+	Status    int    `json:"status"`
+	// Before and After are the project before and after the change, null when it did not exist.
+	Before    json.RawMessage `json:"before" swaggertype:"object"`
+	After     json.RawMessage `json:"after" swaggertype:"object"`
+	CreatedAt time.Time       `json:"created_at"`
+}
@@ -0,0 +1,34 @@
+package dto
+
+import "time"
+
+// ProjectBookmarkRequest represents a bookmark request
+// @Description Bookmarking a project again moves it to folder_id, without folder_id it is in no folder
+type ProjectBookmarkRequest struct {
+	ProjectID uint  `json:"project_id" example:"1"`
+	FolderID  *uint `json:"folder_id" example:"2"`
+}
+
+// ProjectBookmarkFolderRequest represents a folder creation or rename request
+type ProjectBookmarkFolderRequest struct {
+	Name string `json:"name" example:"Ideas for the final year project"`
+}
+
+type ProjectBookmarkFolder struct {
+	ID        uint      `json:"id"`
+	Name      string    `json:"name"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type ProjectBookmark struct {
+	ID        uint `json:"id"`
+	ProjectID uint `json:"project_id"`
+	// Title is the current title, or the title when the project was saved once it is no longer available.
+	Title  string                 `json:"title"`
+	Folder *ProjectBookmarkFolder `json:"folder,omitempty"`
+	// State is available, private when the project was made private or hidden, or deleted.
+	State string `json:"state"`
+	// Project is only set while the project is available.
+	Project   *ProjectResponseForPublic `json:"project,omitempty"`
+	CreatedAt time.Time                 `json:"created_at"`
+}
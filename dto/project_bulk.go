@@ -0,0 +1,40 @@
+package dto
+
+import "github.com/aruncs31s/esdcprojectmodule/models"
+
+// BulkProjectRequest selects projects by ID or by filter and runs one operation on them.
+type BulkProjectRequest struct {
+	// ProjectIDs and Filter are exclusive, an empty filter selects every project.
+	ProjectIDs []uint              `json:"project_ids" example:"1,2,3"`
+	Filter     *AdminProjectFilter `json:"filter"`
+	// Operation is set_status, set_visibility, add_tag, remove_tag, trash, restore or transfer_owner.
+	Operation string `json:"operation" example:"add_tag"`
+	// Value is the status, the visibility, the tag or the username of the new owner,
+	// trash and restore take no value.
+	Value string `json:"value" example:"techfest"`
+	// DryRun only reports what would change.
+	DryRun bool `json:"dry_run" example:"true"`
+}
+
+// BulkProjectItem is the outcome of the operation for one project.
+type BulkProjectItem struct {
+	ProjectID uint `json:"project_id"`
+	Success   bool `json:"success"`
+	// Unchanged projects already were in the requested state and were left alone.
+	Unchanged bool   `json:"unchanged,omitempty"`
+	Error     string `json:"error,omitempty"`
+}
+
+type BulkProjectResult struct {
+	Operation string `json:"operation"`
+	DryRun    bool   `json:"dry_run"`
+	// Affected are the IDs of the projects that were changed, or would be on a dry run.
+	Affected  []uint            `json:"affected"`
+	Succeeded int               `json:"succeeded"`
+	Unchanged int               `json:"unchanged"`
+	Failed    int               `json:"failed"`
+	Items     []BulkProjectItem `json:"items"`
+	// Before holds the projects that were changed as they were before the change,
+	// for the audit log. It is not sent to the client.
+	Before map[uint]*models.ProjectAuditSnapshot `json:"-"`
+}
@@ -0,0 +1,43 @@
+package dto
+
+// ProjectImportRow is one project of a bulk import, a project creation request made for the creator.
+//
+// CSV imports have a header row naming the columns after the json fields, the
+// tags, technologies and contributors columns are lists separated by ";".
+type ProjectImportRow struct {
+	// Creator is the username of the user who owns the project.
+	Creator string `json:"creator" example:"alice"`
+	ProjectCreation
+	// CellErrors are the cells of a CSV row that could not be read, the row fails with them.
+	CellErrors []error `json:"-"`
+}
+
+// ProjectImportOptions says how the rows of a bulk import are committed.
+type ProjectImportOptions struct {
+	// Mode is all_or_nothing, the default, or best_effort.
+	Mode string
+	// DryRun only checks the rows.
+	DryRun bool
+}
+
+// ProjectImportRowResult reports on one row of a bulk import.
+type ProjectImportRowResult struct {
+	// Row is the number of the row in the import, starting at 1 after the CSV header.
+	Row   int    `json:"row"`
+	Title string `json:"title"`
+	// Success reports whether the row is valid and, unless nothing was committed, created.
+	Success   bool     `json:"success"`
+	ProjectID *uint    `json:"project_id,omitempty"`
+	Errors    []string `json:"errors,omitempty"`
+}
+
+type ProjectImportResult struct {
+	Mode   string `json:"mode"`
+	DryRun bool   `json:"dry_run"`
+	// Committed is false when no project was created, on dry runs and when an
+	// all_or_nothing import had a failing row.
+	Committed bool                     `json:"committed"`
+	Created   int                      `json:"created"`
+	Failed    int                      `json:"failed"`
+	Rows      []ProjectImportRowResult `json:"rows"`
+}
@@ -0,0 +1,52 @@
+package dto
+
+import "time"
+
+// ProjectCollectionRequest represents a collection creation request
+// @Description The slug defaults to one made from the title and visibility to private, admin_owned collections are run by the admins
+type ProjectCollectionRequest struct {
+	Slug        string  `json:"slug" example:"tech-fest-2026-finalists"`
+	Title       string  `json:"title" example:"Tech Fest 2026 finalists"`
+	Description string  `json:"description" example:"The projects that made it to the final round"`
+	Cover       *string `json:"cover" example:"https://example.com/cover.jpg"`
+	Visibility  string  `json:"visibility" example:"public"`
+	// AdminOwned can only be set by admins.
+	AdminOwned bool   `json:"admin_owned" example:"false"`
+	ProjectIDs []uint `json:"project_ids" example:"3,1,2"`
+}
+
+// ProjectCollectionUpdate represents a collection update request
+// @Description Only the fields that are sent are changed
+type ProjectCollectionUpdate struct {
+	Slug        *string `json:"slug" example:"tech-fest-2026-finalists"`
+	Title       *string `json:"title" example:"Tech Fest 2026 finalists"`
+	Description *string `json:"description" example:"The projects that made it to the final round"`
+	Cover       *string `json:"cover" example:"https://example.com/cover.jpg"`
+	Visibility  *string `json:"visibility" example:"private"`
+}
+
+// ProjectCollectionProjects represents the projects of a collection
+// @Description Every project of the collection, in order
+type ProjectCollectionProjects struct {
+	ProjectIDs []uint `json:"project_ids" example:"3,1,2"`
+}
+
+type ProjectCollection struct {
+	ID          uint    `json:"id"`
+	Slug        string  `json:"slug"`
+	Title       string  `json:"title"`
+	Description string  `json:"description"`
+	Cover       *string `json:"cover"`
+	Visibility  string  `json:"visibility"`
+	// Owner is left out for collections run by the admins.
+	Owner      *Contributor `json:"owner,omitempty"`
+	AdminOwned bool         `json:"admin_owned"`
+	CreatedAt  time.Time    `json:"created_at"`
+	UpdatedAt  time.Time    `json:"updated_at"`
+}
+
+type ProjectCollectionDetail struct {
+	ProjectCollection
+	// Projects are the projects of the collection the viewer can see, in order.
+	Projects []ProjectResponseForPublic `json:"projects"`
+}
@@ -0,0 +1,13 @@
+package dto
+
+// ProjectContributor is a contributor of a project with its role.
+type ProjectContributor struct {
+	Contributor
+	Role string `json:"role" example:"editor"`
+}
+
+// UpdateContributorRole represents a request to change the role of a contributor
+// @Description The new role, one of maintainer, editor or viewer
+type UpdateContributorRole struct {
+	Role string `json:"role" example:"maintainer"`
+}
@@ -0,0 +1,28 @@
+package dto
+
+import "time"
+
+// ProjectExportRow is one project of an export.
+//
+// CSV exports flatten the lists into one column each, see the export handler.
+type ProjectExportRow struct {
+	ID           uint      `json:"id"`
+	Title        string    `json:"title"`
+	Description  string    `json:"description"`
+	Category     string    `json:"category"`
+	Status       string    `json:"status"`
+	Visibility   string    `json:"visibility"`
+	Version      string    `json:"version"`
+	Cost         int       `json:"cost"`
+	Likes        int       `json:"likes"`
+	Views        int       `json:"views"`
+	GithubLink   string    `json:"github_link"`
+	LiveURL      string    `json:"live_url"`
+	Creator      string    `json:"creator"`
+	Tags         []string  `json:"tags"`
+	Technologies []string  `json:"technologies"`
+	Contributors []string  `json:"contributors"`
+	ForkedFromID *uint     `json:"forked_from_id,omitempty"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
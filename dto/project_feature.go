@@ -0,0 +1,27 @@
+package dto
+
+import "time"
+
+// ProjectFeatureRequest represents a request to feature a project
+// @Description starts_at defaults to now, without ends_at the project stays featured until taken off
+type ProjectFeatureRequest struct {
+	Position int        `json:"position" example:"1"`
+	StartsAt *time.Time `json:"starts_at" example:"2025-01-01T00:00:00Z"`
+	EndsAt   *time.Time `json:"ends_at" example:"2025-02-01T00:00:00Z"`
+}
+
+type ProjectFeature struct {
+	ProjectID uint       `json:"project_id"`
+	Title     string     `json:"title"`
+	Position  int        `json:"position"`
+	StartsAt  time.Time  `json:"starts_at"`
+	EndsAt    *time.Time `json:"ends_at"`
+	// Active is true while the project is shown on the homepage.
+	Active bool `json:"active"`
+}
+
+// ProjectPinOrder represents a reorder request
+// @Description Every pinned project ID, in the new order
+type ProjectPinOrder struct {
+	ProjectIDs []uint `json:"project_ids" example:"3,1,2"`
+}
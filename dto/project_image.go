@@ -0,0 +1,18 @@
+package dto
+
+import "time"
+
+type ProjectImage struct {
+	ProjectID uint      `json:"project_id"`
+	Original  ImageSize `json:"original"`
+	// Sizes holds the resized variants by size name, "thumb", "medium" and "large".
+	Sizes      map[string]ImageSize `json:"sizes"`
+	UploadedAt time.Time            `json:"uploaded_at"`
+}
+
+type ImageSize struct {
+	Width  int `json:"width"`
+	Height int `json:"height"`
+	// URLs by format, "jpeg" or "png" and "webp".
+	URLs map[string]string `json:"urls"`
+}
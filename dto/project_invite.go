@@ -0,0 +1,29 @@
+package dto
+
+import "time"
+
+// InviteContributors represents a request to invite users to a project
+// @Description Usernames to invite as contributors
+type InviteContributors struct {
+	Usernames []string `json:"usernames" example:"bob,carol"`
+	// Role given to the users once they accept, defaults to editor.
+	Role string `json:"role" example:"editor"`
+}
+
+type ProjectInvite struct {
+	ID           uint        `json:"id"`
+	ProjectID    uint        `json:"project_id"`
+	ProjectTitle string      `json:"project_title"`
+	Invitee      Contributor `json:"invitee"`
+	InvitedBy    Contributor `json:"invited_by"`
+	Role         string      `json:"role"`
+	Status       string      `json:"status"`
+	CreatedAt    time.Time   `json:"created_at"`
+	RespondedAt  *time.Time  `json:"responded_at,omitempty"`
+}
+
+type InviteContributorsResult struct {
+	Invited  []ProjectInvite `json:"invited"`
+	NotFound []string        `json:"not_found"`
+	Skipped  []string        `json:"skipped"`
+}
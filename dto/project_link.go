@@ -0,0 +1,30 @@
+package dto
+
+import "time"
+
+type LinkHealth struct {
+	// Kind is "github" or "live".
+	Kind         string     `json:"kind" example:"live"`
+	URL          string     `json:"url"`
+	Healthy      bool       `json:"healthy"`
+	StatusCode   int        `json:"status_code,omitempty" example:"404"`
+	Error        string     `json:"error,omitempty"`
+	LatencyMs    int64      `json:"latency_ms"`
+	CheckedAt    time.Time  `json:"checked_at"`
+	FailingSince *time.Time `json:"failing_since,omitempty"`
+}
+
+// ProjectBrokenLinks lists the links of a project that failed their last check.
+type ProjectBrokenLinks struct {
+	ProjectID uint         `json:"project_id"`
+	Title     string       `json:"title"`
+	CreatedBy uint         `json:"created_by"`
+	Links     []LinkHealth `json:"links"`
+}
+
+// LinkReport is the admin overview of the link checks.
+type LinkReport struct {
+	CheckedLinks int64                `json:"checked_links"`
+	BrokenLinks  int64                `json:"broken_links"`
+	Projects     []ProjectBrokenLinks `json:"projects"`
+}
@@ -0,0 +1,56 @@
+package dto
+
+import "time"
+
+type ProjectReportRequest struct {
+	// Reason is spam, inappropriate, copyright, malicious or other.
+	Reason  string `json:"reason" example:"spam"`
+	Details string `json:"details" example:"Links to a shop, not a project"`
+}
+
+type ProjectReport struct {
+	ID        uint         `json:"id"`
+	ProjectID uint         `json:"project_id"`
+	Reporter  *Contributor `json:"reporter,omitempty"`
+	Reason    string       `json:"reason"`
+	Details   string       `json:"details"`
+	// Status is open until a moderator decides on the reports of the project.
+	Status    string    `json:"status"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// ModerationQueueEntry is a project with open reports.
+type ModerationQueueEntry struct {
+	ProjectID uint        `json:"project_id"`
+	Title     string      `json:"title"`
+	Owner     Contributor `json:"owner"`
+	Status    string      `json:"status"`
+	// Reports counts the distinct users with an open report.
+	Reports int `json:"reports"`
+	// Reasons counts the open reports by reason.
+	Reasons        map[string]int `json:"reasons"`
+	LastReportedAt time.Time      `json:"last_reported_at"`
+}
+
+type ModerationDecisionRequest struct {
+	// Action is hide, warn, remove or dismiss.
+	Action string `json:"action" example:"hide"`
+	// Note is sent to the owner along with the decision.
+	Note string `json:"note" example:"Please remove the shop links"`
+}
+
+type ModerationDecision struct {
+	ID        uint   `json:"id"`
+	ProjectID uint   `json:"project_id"`
+	Action    string `json:"action"`
+	Note      string `json:"note"`
+	// DecidedBy is the username of the moderator, empty when the project was hidden automatically.
+	DecidedBy string    `json:"decided_by"`
+	Automatic bool      `json:"automatic"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type ProjectModerationHistory struct {
+	Reports   []ProjectReport      `json:"reports"`
+	Decisions []ModerationDecision `json:"decisions"`
+}
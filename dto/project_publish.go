@@ -0,0 +1,15 @@
+package dto
+
+import "time"
+
+// PublishProject represents a publish request for a draft
+// @Description Leave publish_at out to publish right away
+type PublishProject struct {
+	PublishAt *time.Time `json:"publish_at" example:"2024-05-01T09:00:00Z"`
+}
+
+type ProjectPublication struct {
+	ProjectID uint       `json:"project_id"`
+	Status    string     `json:"status"`
+	PublishAt *time.Time `json:"publish_at,omitempty"`
+}
@@ -0,0 +1,28 @@
+package dto
+
+import "time"
+
+// ReleaseCreation represents a new release of a project
+// @Description Version must be semver, greater than the latest release and not lower than the project version, released_at defaults to now
+type ReleaseCreation struct {
+	Version    string            `json:"version" example:"1.2.0"`
+	Notes      string            `json:"notes" example:"## Changes\n- Added sensor calibration"`
+	ReleasedAt *time.Time        `json:"released_at" example:"2024-05-01T00:00:00Z"`
+	Artifacts  []ReleaseArtifact `json:"artifacts"`
+}
+
+type ReleaseArtifact struct {
+	Name string `json:"name" example:"firmware.bin"`
+	URL  string `json:"url" example:"https://example.com/firmware.bin"`
+}
+
+type ProjectRelease struct {
+	ID         uint              `json:"id"`
+	ProjectID  uint              `json:"project_id"`
+	Version    string            `json:"version"`
+	Notes      string            `json:"notes"`
+	Artifacts  []ReleaseArtifact `json:"artifacts"`
+	ReleasedAt time.Time         `json:"released_at"`
+	CreatedBy  Contributor       `json:"created_by"`
+	CreatedAt  time.Time         `json:"created_at"`
+}
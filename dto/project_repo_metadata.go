@@ -0,0 +1,30 @@
+package dto
+
+import "time"
+
+type RepoMetadata struct {
+	ProjectID uint       `json:"project_id"`
+	Provider  string     `json:"provider" example:"github"`
+	URL       string     `json:"url" example:"https://github.com/owner/repo"`
+	Stars     int        `json:"stars"`
+	Forks     int        `json:"forks"`
+	Language  string     `json:"language" example:"Go"`
+	License   string     `json:"license" example:"MIT"`
+	PushedAt  *time.Time `json:"pushed_at"`
+	Topics    []string   `json:"topics"`
+	Languages []string   `json:"languages"`
+	SyncedAt  time.Time  `json:"synced_at"`
+	// SyncError is set when the last sync failed, the other values are from an earlier one.
+	SyncError string `json:"sync_error,omitempty"`
+}
+
+// RepoSuggestions are the repository languages and topics the project does not list yet.
+type RepoSuggestions struct {
+	Technologies []string `json:"technologies"`
+	Tags         []string `json:"tags"`
+}
+
+// ProjectImport represents the request to create a project from a repository.
+type ProjectImport struct {
+	URL string `json:"url" example:"https://github.com/user/project"`
+}
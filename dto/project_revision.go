@@ -0,0 +1,33 @@
+package dto
+
+import "time"
+
+type ProjectRevision struct {
+	Revision     int       `json:"revision"`
+	Title        string    `json:"title"`
+	Description  string    `json:"description"`
+	GithubLink   string    `json:"github_link"`
+	LiveURL      *string   `json:"live_url"`
+	Tags         []string  `json:"tags"`
+	Technologies []string  `json:"technologies"`
+	Status       string    `json:"status"`
+	Visibility   string    `json:"visibility"`
+	Cost         int       `json:"cost"`
+	Version      string    `json:"version"`
+	RestoredFrom *int      `json:"restored_from,omitempty"`
+	ModifiedBy   uint      `json:"modified_by"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+// FieldChange is one changed field between two revisions.
+type FieldChange struct {
+	Field string      `json:"field"`
+	From  interface{} `json:"from"`
+	To    interface{} `json:"to"`
+}
+
+type RevisionDiff struct {
+	From    int           `json:"from"`
+	To      int           `json:"to"`
+	Changes []FieldChange `json:"changes"`
+}
@@ -0,0 +1,38 @@
+package dto
+
+import "time"
+
+// StatCount is the number of projects in one group, like the projects of a status or with a tag.
+type StatCount struct {
+	Name  string `json:"name" example:"arduino"`
+	Count int64  `json:"count" example:"12"`
+}
+
+// WeekCount is the number of projects created in the week starting on Monday WeekStart.
+type WeekCount struct {
+	WeekStart string `json:"week_start" example:"2026-10-12"`
+	Count     int64  `json:"count" example:"3"`
+}
+
+// CreatorStats sums up the projects of one of the most active creators.
+type CreatorStats struct {
+	Username string `json:"username" example:"alice"`
+	Projects int64  `json:"projects" example:"4"`
+	Likes    int64  `json:"likes" example:"27"`
+}
+
+// ProjectStats is the admin dashboard overview of every project.
+type ProjectStats struct {
+	TotalProjects   int64            `json:"total_projects"`
+	ByStatus        map[string]int64 `json:"by_status"`
+	ByVisibility    map[string]int64 `json:"by_visibility"`
+	ByCategory      map[string]int64 `json:"by_category"`
+	NewPerWeek      []WeekCount      `json:"new_per_week"`
+	TopTechnologies []StatCount      `json:"top_technologies"`
+	TopTags         []StatCount      `json:"top_tags"`
+	TopCreators     []CreatorStats   `json:"top_creators"`
+	AverageLikes    float64          `json:"average_likes"`
+	AverageViews    float64          `json:"average_views"`
+	// GeneratedAt is when the stats were computed, they are cached for a short while.
+	GeneratedAt time.Time `json:"generated_at"`
+}
@@ -0,0 +1,22 @@
+package dto
+
+import "time"
+
+// TransferOwnership represents a request to hand a project over to another user
+// @Description Username of the new owner, force is only allowed for admins
+type TransferOwnership struct {
+	Username string `json:"username" example:"bob"`
+	Force    bool   `json:"force" example:"false"`
+}
+
+type ProjectTransfer struct {
+	ID           uint        `json:"id"`
+	ProjectID    uint        `json:"project_id"`
+	ProjectTitle string      `json:"project_title"`
+	From         Contributor `json:"from"`
+	To           Contributor `json:"to"`
+	Status       string      `json:"status"`
+	Forced       bool        `json:"forced"`
+	CreatedAt    time.Time   `json:"created_at"`
+	RespondedAt  *time.Time  `json:"responded_at,omitempty"`
+}
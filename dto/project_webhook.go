@@ -0,0 +1,31 @@
+package dto
+
+import "time"
+
+// ProjectWebhook describes the webhook of a project, the secret is only returned when it is created.
+type ProjectWebhook struct {
+	ProjectID uint `json:"project_id"`
+	// PayloadURL is where the code host has to send the deliveries.
+	PayloadURL  string                `json:"payload_url" example:"/api/hooks/github"`
+	ContentType string                `json:"content_type" example:"application/json"`
+	Secret      string                `json:"secret,omitempty"`
+	CreatedAt   time.Time             `json:"created_at"`
+	Events      []ProjectWebhookEvent `json:"events"`
+}
+
+type ProjectWebhookEvent struct {
+	ID         uint      `json:"id"`
+	DeliveryID string    `json:"delivery_id"`
+	Event      string    `json:"event" example:"push"`
+	Action     string    `json:"action,omitempty" example:"published"`
+	Summary    string    `json:"summary" example:"refs/heads/main at 1a2b3c4"`
+	Error      string    `json:"error,omitempty"`
+	ReceivedAt time.Time `json:"received_at"`
+}
+
+// WebhookDelivery is the result of receiving a delivery.
+type WebhookDelivery struct {
+	Event string `json:"event"`
+	// Projects are the IDs of the projects the delivery was applied to.
+	Projects []uint `json:"projects"`
+}
@@ -8,6 +8,7 @@ require (
 	github.com/aruncs31s/esdcusermodule v0.1.5
 	github.com/aruncs31s/responsehelper v0.3.0
 	github.com/gin-gonic/gin v1.11.0
+	golang.org/x/image v0.25.0
 	gorm.io/driver/sqlite v1.6.0
 	gorm.io/gorm v1.31.0
 )
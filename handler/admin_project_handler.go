@@ -0,0 +1,196 @@
+package handler
+
+import (
+	"github.com/aruncs31s/esdcprojectmodule/dto"
+	"github.com/aruncs31s/esdcprojectmodule/interfaces/handler"
+	"github.com/aruncs31s/esdcprojectmodule/interfaces/service"
+	"github.com/aruncs31s/esdcsharedhelpersmodule/helper"
+	sharedHelper "github.com/aruncs31s/esdcsharedhelpersmodule/interface/helper"
+	"github.com/aruncs31s/esdcsharedhelpersmodule/utils"
+	"github.com/aruncs31s/responsehelper"
+	"github.com/gin-gonic/gin"
+)
+
+type adminProjectHandler struct {
+	adminService   service.AdminProjectService
+	requestHelper  sharedHelper.RequestHelper
+	responseHelper responsehelper.ResponseHelper
+	validator      sharedHelper.RequestValidator
+}
+
+func NewAdminProjectHandler(adminService service.AdminProjectService) handler.AdminProjectHandler {
+	responseHelper, requestHelper, validator := getHelpers()
+	return &adminProjectHandler{
+		adminService:   adminService,
+		requestHelper:  requestHelper,
+		responseHelper: responseHelper,
+		validator:      validator,
+	}
+}
+
+func (h *adminProjectHandler) GetValidator() sharedHelper.RequestValidator {
+	return h.validator
+}
+func (h *adminProjectHandler) GetResponseHelper() responsehelper.ResponseHelper {
+	return h.responseHelper
+}
+
+// GetProjects godoc
+// @Summary List every project
+// @Description Projects of every owner, visibility and status, newest first
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Param creator query string false "Username of the creator"
+// @Param status query string false "Status"
+// @Param visibility query string false "public or private"
+// @Param page query int false "Page number"
+// @Param per-page query int false "Page size"
+// @Success 200 {array} dto.ProjectsEssentialInfo "Projects"
+// @Failure 401 {object} map[string]interface{} "Not an admin"
+// @Router /admin/projects [get]
+func (h *adminProjectHandler) GetProjects(c *gin.Context) {
+	user, failed := h.requestHelper.GetAndValidateUsername(c, h)
+	if failed {
+		return
+	}
+	limit, offset := h.requestHelper.GetLimitAndOffset(c)
+	filter := dto.AdminProjectFilter{
+		Creator:    c.Query("creator"),
+		Status:     c.Query("status"),
+		Visibility: c.Query("visibility"),
+	}
+	projects, err := h.adminService.GetProjects(user, filter, limit, offset)
+	if err != nil {
+		respondWithError(c, h.responseHelper, "Failed to retrieve projects", err)
+		return
+	}
+	h.responseHelper.Success(c, projects)
+}
+
+// UpdateProject godoc
+// @Summary Force edit a project
+// @Description Changes the fields that are sent without the checks made for owners, drafts included
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Project ID"
+// @Param body body dto.ProjectUpdate true "Fields to change"
+// @Success 200 {object} dto.ProjectsEssentialInfo "Updated project"
+// @Failure 401 {object} map[string]interface{} "Not an admin"
+// @Failure 404 {object} map[string]interface{} "Project not found"
+// @Router /admin/projects/{id} [put]
+func (h *adminProjectHandler) UpdateProject(c *gin.Context) {
+	user, failed := h.requestHelper.GetAndValidateUsername(c, h)
+	if failed {
+		return
+	}
+	projectID, failed := h.requestHelper.ValidateAndParseID(h, "id", c, utils.FixInvalidID)
+	if failed {
+		return
+	}
+	request, failed := helper.GetJSONDataFromRequest[dto.ProjectUpdate](c, h.responseHelper)
+	if failed {
+		return
+	}
+	project, err := h.adminService.UpdateProject(user, projectID, request)
+	if err != nil {
+		respondWithError(c, h.responseHelper, "Failed to update project", err)
+		return
+	}
+	h.responseHelper.Success(c, project)
+}
+
+// SetVisibility godoc
+// @Summary Change the visibility of a project
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Project ID"
+// @Param body body dto.ProjectVisibilityUpdate true "public or private"
+// @Success 200 {object} dto.ProjectsEssentialInfo "Updated project"
+// @Failure 400 {object} map[string]interface{} "Invalid visibility"
+// @Failure 401 {object} map[string]interface{} "Not an admin"
+// @Router /admin/projects/{id}/visibility [patch]
+func (h *adminProjectHandler) SetVisibility(c *gin.Context) {
+	user, failed := h.requestHelper.GetAndValidateUsername(c, h)
+	if failed {
+		return
+	}
+	projectID, failed := h.requestHelper.ValidateAndParseID(h, "id", c, utils.FixInvalidID)
+	if failed {
+		return
+	}
+	request, failed := helper.GetJSONDataFromRequest[dto.ProjectVisibilityUpdate](c, h.responseHelper)
+	if failed {
+		return
+	}
+	project, err := h.adminService.SetVisibility(user, projectID, request.Visibility)
+	if err != nil {
+		respondWithError(c, h.responseHelper, "Failed to change visibility", err)
+		return
+	}
+	h.responseHelper.Success(c, project)
+}
+
+// SetStatus godoc
+// @Summary Change the status of a project
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Project ID"
+// @Param body body dto.ProjectStatusUpdate true "New status"
+// @Success 200 {object} dto.ProjectsEssentialInfo "Updated project"
+// @Failure 400 {object} map[string]interface{} "Empty status"
+// @Failure 401 {object} map[string]interface{} "Not an admin"
+// @Router /admin/projects/{id}/status [patch]
+func (h *adminProjectHandler) SetStatus(c *gin.Context) {
+	user, failed := h.requestHelper.GetAndValidateUsername(c, h)
+	if failed {
+		return
+	}
+	projectID, failed := h.requestHelper.ValidateAndParseID(h, "id", c, utils.FixInvalidID)
+	if failed {
+		return
+	}
+	request, failed := helper.GetJSONDataFromRequest[dto.ProjectStatusUpdate](c, h.responseHelper)
+	if failed {
+		return
+	}
+	project, err := h.adminService.SetStatus(user, projectID, request.Status)
+	if err != nil {
+		respondWithError(c, h.responseHelper, "Failed to change status", err)
+		return
+	}
+	h.responseHelper.Success(c, project)
+}
+
+// DeleteProject godoc
+// @Summary Force delete a project
+// @Description Removes the project, its uploaded files and everything the module keeps for it, forks are kept
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Project ID"
+// @Success 200 {object} map[string]interface{} "Project deleted"
+// @Failure 401 {object} map[string]interface{} "Not an admin"
+// @Failure 404 {object} map[string]interface{} "Project not found"
+// @Router /admin/projects/{id} [delete]
+func (h *adminProjectHandler) DeleteProject(c *gin.Context) {
+	user, failed := h.requestHelper.GetAndValidateUsername(c, h)
+	if failed {
+		return
+	}
+	projectID, failed := h.requestHelper.ValidateAndParseID(h, "id", c, utils.FixInvalidID)
+	if failed {
+		return
+	}
+	if err := h.adminService.DeleteProject(user, projectID); err != nil {
+		respondWithError(c, h.responseHelper, "Failed to delete project", err)
+		return
+	}
+	h.responseHelper.Deleted(c, "Project")
+}
@@ -0,0 +1,27 @@
+package handler
+
+import (
+	"errors"
+
+	"github.com/aruncs31s/esdcsharedhelpersmodule/utils"
+	"github.com/aruncs31s/responsehelper"
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+// respondWithError picks the response for an error returned by a service.
+//
+// The module errors wrap the shared errors, so only those need to be checked here.
+// There is no forbidden response in the response helper, Unauthorized is used instead.
+func respondWithError(c *gin.Context, responseHelper responsehelper.ResponseHelper, message string, err error) {
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound), errors.Is(err, utils.ErrNotFound):
+		responseHelper.NotFound(c, err.Error())
+	case errors.Is(err, utils.ErrForbidden):
+		responseHelper.Unauthorized(c, err.Error())
+	case errors.Is(err, utils.ErrBadRequest):
+		responseHelper.BadRequest(c, message, err.Error())
+	default:
+		responseHelper.InternalError(c, message, err)
+	}
+}
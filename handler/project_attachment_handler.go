@@ -0,0 +1,245 @@
+package handler
+
+import (
+	"mime"
+	"net/http"
+
+	"github.com/aruncs31s/esdcprojectmodule/dto"
+	"github.com/aruncs31s/esdcprojectmodule/interfaces/handler"
+	"github.com/aruncs31s/esdcprojectmodule/interfaces/service"
+	"github.com/aruncs31s/esdcsharedhelpersmodule/helper"
+	sharedHelper "github.com/aruncs31s/esdcsharedhelpersmodule/interface/helper"
+	"github.com/aruncs31s/esdcsharedhelpersmodule/utils"
+	"github.com/aruncs31s/responsehelper"
+	"github.com/gin-gonic/gin"
+)
+
+type projectAttachmentHandler struct {
+	attachmentService service.ProjectAttachmentService
+	requestHelper     sharedHelper.RequestHelper
+	responseHelper    responsehelper.ResponseHelper
+	validator         sharedHelper.RequestValidator
+}
+
+func NewProjectAttachmentHandler(attachmentService service.ProjectAttachmentService) handler.ProjectAttachmentHandler {
+	responseHelper, requestHelper, validator := getHelpers()
+	return &projectAttachmentHandler{
+		attachmentService: attachmentService,
+		requestHelper:     requestHelper,
+		responseHelper:    responseHelper,
+		validator:         validator,
+	}
+}
+
+func (h *projectAttachmentHandler) GetValidator() sharedHelper.RequestValidator {
+	return h.validator
+}
+func (h *projectAttachmentHandler) GetResponseHelper() responsehelper.ResponseHelper {
+	return h.responseHelper
+}
+
+// GetAttachments godoc
+// @Summary Gallery and file attachments of a project
+// @Description Only contributors of the project can list them
+// @Tags projects
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Project ID"
+// @Success 200 {object} map[string]interface{} "Gallery and files"
+// @Router /projects/{id}/attachments [get]
+func (h *projectAttachmentHandler) GetAttachments(c *gin.Context) {
+	user, failed := h.requestHelper.GetAndValidateUsername(c, h)
+	if failed {
+		return
+	}
+	projectID, failed := h.requestHelper.ValidateAndParseID(h, "id", c, utils.FixInvalidID)
+	if failed {
+		return
+	}
+	attachments, err := h.attachmentService.GetAttachments(user, projectID)
+	if err != nil {
+		respondWithError(c, h.responseHelper, "Failed to retrieve attachments", err)
+		return
+	}
+	h.responseHelper.Success(c, attachments)
+}
+
+// UploadAttachment godoc
+// @Summary Upload a gallery image or a file
+// @Description Images, PDFs, firmware, CAD files and zip archives, each kind has its own size limit
+// @Tags projects
+// @Accept multipart/form-data
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Project ID"
+// @Param file formData file true "File"
+// @Param caption formData string false "Caption"
+// @Success 201 {object} map[string]interface{} "Attachment created"
+// @Failure 400 {object} map[string]interface{} "Unsupported or too large file"
+// @Router /projects/{id}/attachments [post]
+func (h *projectAttachmentHandler) UploadAttachment(c *gin.Context) {
+	user, failed := h.requestHelper.GetAndValidateUsername(c, h)
+	if failed {
+		return
+	}
+	projectID, failed := h.requestHelper.ValidateAndParseID(h, "id", c, utils.FixInvalidID)
+	if failed {
+		return
+	}
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		h.responseHelper.BadRequest(c, err.Error(), "Please upload the file in the file field.")
+		return
+	}
+	file, err := fileHeader.Open()
+	if err != nil {
+		h.responseHelper.InternalError(c, "Failed to read file", err)
+		return
+	}
+	defer file.Close()
+	attachment, err := h.attachmentService.UploadAttachment(user, projectID, fileHeader.Filename, c.PostForm("caption"), file)
+	if err != nil {
+		respondWithError(c, h.responseHelper, "Failed to upload attachment", err)
+		return
+	}
+	h.responseHelper.Created(c, attachment)
+}
+
+// DownloadAttachment godoc
+// @Summary Download an attachment
+// @Description Only contributors of the project can download them, gallery images can be fetched in one of their sizes
+// @Tags projects
+// @Produce octet-stream
+// @Security BearerAuth
+// @Param id path int true "Project ID"
+// @Param attachmentId path int true "Attachment ID"
+// @Param size query string false "Image size, thumb, medium or large"
+// @Param format query string false "Image format of the size, jpeg, png or webp"
+// @Success 200 {file} file "Attachment"
+// @Failure 404 {object} map[string]interface{} "Attachment not found"
+// @Router /projects/{id}/attachments/{attachmentId}/file [get]
+func (h *projectAttachmentHandler) DownloadAttachment(c *gin.Context) {
+	user, failed := h.requestHelper.GetAndValidateUsername(c, h)
+	if failed {
+		return
+	}
+	projectID, failed := h.requestHelper.ValidateAndParseID(h, "id", c, utils.FixInvalidID)
+	if failed {
+		return
+	}
+	attachmentID, failed := h.requestHelper.ValidateAndParseID(h, "attachmentId", c, utils.FixInvalidID)
+	if failed {
+		return
+	}
+	file, err := h.attachmentService.OpenAttachment(user, projectID, attachmentID, c.Query("size"), c.Query("format"))
+	if err != nil {
+		respondWithError(c, h.responseHelper, "Failed to download attachment", err)
+		return
+	}
+	defer file.Content.Close()
+	disposition := "attachment"
+	if file.Inline {
+		disposition = "inline"
+	}
+	c.DataFromReader(http.StatusOK, file.Size, file.ContentType, file.Content, map[string]string{
+		"Content-Disposition":    mime.FormatMediaType(disposition, map[string]string{"filename": file.Name}),
+		"Cache-Control":          "private, no-cache",
+		"X-Content-Type-Options": "nosniff",
+	})
+}
+
+// UpdateCaption godoc
+// @Summary Change the caption of an attachment
+// @Tags projects
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Project ID"
+// @Param attachmentId path int true "Attachment ID"
+// @Param body body dto.AttachmentCaption true "Caption"
+// @Success 200 {object} map[string]interface{} "Attachment updated"
+// @Router /projects/{id}/attachments/{attachmentId} [put]
+func (h *projectAttachmentHandler) UpdateCaption(c *gin.Context) {
+	user, failed := h.requestHelper.GetAndValidateUsername(c, h)
+	if failed {
+		return
+	}
+	projectID, failed := h.requestHelper.ValidateAndParseID(h, "id", c, utils.FixInvalidID)
+	if failed {
+		return
+	}
+	attachmentID, failed := h.requestHelper.ValidateAndParseID(h, "attachmentId", c, utils.FixInvalidID)
+	if failed {
+		return
+	}
+	request, failed := helper.GetJSONDataFromRequest[dto.AttachmentCaption](c, h.responseHelper)
+	if failed {
+		return
+	}
+	attachment, err := h.attachmentService.UpdateCaption(user, projectID, attachmentID, request.Caption)
+	if err != nil {
+		respondWithError(c, h.responseHelper, "Failed to update attachment", err)
+		return
+	}
+	h.responseHelper.Success(c, attachment)
+}
+
+// ReorderAttachments godoc
+// @Summary Reorder the attachments of a project
+// @Tags projects
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Project ID"
+// @Param body body dto.AttachmentOrder true "Every attachment ID in the new order"
+// @Success 200 {object} map[string]interface{} "Gallery and files in the new order"
+// @Router /projects/{id}/attachments/order [put]
+func (h *projectAttachmentHandler) ReorderAttachments(c *gin.Context) {
+	user, failed := h.requestHelper.GetAndValidateUsername(c, h)
+	if failed {
+		return
+	}
+	projectID, failed := h.requestHelper.ValidateAndParseID(h, "id", c, utils.FixInvalidID)
+	if failed {
+		return
+	}
+	request, failed := helper.GetJSONDataFromRequest[dto.AttachmentOrder](c, h.responseHelper)
+	if failed {
+		return
+	}
+	attachments, err := h.attachmentService.ReorderAttachments(user, projectID, request.IDs)
+	if err != nil {
+		respondWithError(c, h.responseHelper, "Failed to reorder attachments", err)
+		return
+	}
+	h.responseHelper.Success(c, attachments)
+}
+
+// DeleteAttachment godoc
+// @Summary Delete an attachment
+// @Tags projects
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Project ID"
+// @Param attachmentId path int true "Attachment ID"
+// @Success 200 {object} map[string]interface{} "Attachment deleted"
+// @Router /projects/{id}/attachments/{attachmentId} [delete]
+func (h *projectAttachmentHandler) DeleteAttachment(c *gin.Context) {
+	user, failed := h.requestHelper.GetAndValidateUsername(c, h)
+	if failed {
+		return
+	}
+	projectID, failed := h.requestHelper.ValidateAndParseID(h, "id", c, utils.FixInvalidID)
+	if failed {
+		return
+	}
+	attachmentID, failed := h.requestHelper.ValidateAndParseID(h, "attachmentId", c, utils.FixInvalidID)
+	if failed {
+		return
+	}
+	if err := h.attachmentService.DeleteAttachment(user, projectID, attachmentID); err != nil {
+		respondWithError(c, h.responseHelper, "Failed to delete attachment", err)
+		return
+	}
+	h.responseHelper.Deleted(c, "Attachment")
+}
@@ -0,0 +1,222 @@
+package handler
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/aruncs31s/esdcprojectmodule/dto"
+	"github.com/aruncs31s/esdcprojectmodule/interfaces/handler"
+	"github.com/aruncs31s/esdcprojectmodule/interfaces/service"
+	"github.com/aruncs31s/esdcprojectmodule/models"
+	sharedHelper "github.com/aruncs31s/esdcsharedhelpersmodule/interface/helper"
+	"github.com/aruncs31s/responsehelper"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	// requestIDHeader carries the ID the server gave the request, the ID a client
+	// sends is not trusted as it would end up in the audit log as is.
+	requestIDHeader = "X-Request-ID"
+	// auditProjectKey holds the project changed by a request whose route does not
+	// have it in the "id" param, see setAuditProject.
+	auditProjectKey = "auditProjectID"
+	// auditProjectsKey holds the projects changed by a bulk request, see setAuditProjects.
+	auditProjectsKey = "auditProjectIDs"
+	// auditBeforeKey holds the snapshots of the projects changed by a bulk request.
+	auditBeforeKey = "auditBefore"
+	// auditSkipKey marks requests that changed nothing, see skipAudit.
+	auditSkipKey = "auditSkip"
+)
+
+// setAuditProject tells the audit middleware which project the request changed,
+// for routes like create where the project is not in the "id" param.
+func setAuditProject(c *gin.Context, projectID uint) {
+	c.Set(auditProjectKey, projectID)
+}
+
+// setAuditProjects tells the audit middleware that the request changed many projects,
+// an entry is recorded for each of them. before holds the projects as they were
+// before the change, projects missing from it did not exist.
+func setAuditProjects(c *gin.Context, projectIDs []uint, before map[uint]*models.ProjectAuditSnapshot) {
+	c.Set(auditProjectsKey, projectIDs)
+	c.Set(auditBeforeKey, before)
+}
+
+// skipAudit tells the audit middleware not to record a request that succeeded
+// without changing anything, like a dry run.
+func skipAudit(c *gin.Context) {
+	c.Set(auditSkipKey, true)
+}
+
+type projectAuditHandler struct {
+	auditService   service.ProjectAuditService
+	requestHelper  sharedHelper.RequestHelper
+	responseHelper responsehelper.ResponseHelper
+	validator      sharedHelper.RequestValidator
+}
+
+func NewProjectAuditHandler(auditService service.ProjectAuditService) handler.ProjectAuditHandler {
+	responseHelper, requestHelper, validator := getHelpers()
+	return &projectAuditHandler{
+		auditService:   auditService,
+		requestHelper:  requestHelper,
+		responseHelper: responseHelper,
+		validator:      validator,
+	}
+}
+
+func (h *projectAuditHandler) GetValidator() sharedHelper.RequestValidator {
+	return h.validator
+}
+func (h *projectAuditHandler) GetResponseHelper() responsehelper.ResponseHelper {
+	return h.responseHelper
+}
+
+// Audit records the request once the handler succeeded.
+//
+// The project is taken from the "id" param of routes under /projects/:id, or from
+// setAuditProject, and is snapshotted before and after the handler runs. The
+// snapshot before is only taken for signed in callers, handlers turn anonymous
+// requests away before they change anything. Bulk requests record an entry per
+// project given to setAuditProjects, with the snapshots the handler took before
+// the change.
+func (h *projectAuditHandler) Audit(action string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		requestID := newRequestID()
+		c.Header(requestIDHeader, requestID)
+		var projectID *uint
+		var before *models.ProjectAuditSnapshot
+		if strings.Contains(c.FullPath(), "/projects/:id") {
+			if id, err := h.validator.ValidateIDAndParse(c.Param("id")); err == nil {
+				projectID = &id
+				if c.GetString("username") != "" {
+					if before, err = h.auditService.Snapshot(id); err != nil {
+						log.Printf("Error taking the audit snapshot of project %d: %v", id, err)
+					}
+				}
+			}
+		}
+		c.Next()
+		if c.Writer.Status() >= http.StatusBadRequest || c.GetBool(auditSkipKey) {
+			return
+		}
+		if projectIDs, ok := c.Get(auditProjectsKey); ok {
+			snapshots, _ := c.Get(auditBeforeKey)
+			before, _ := snapshots.(map[uint]*models.ProjectAuditSnapshot)
+			for _, id := range projectIDs.([]uint) {
+				h.record(c, action, requestID, &id, before[id])
+			}
+			return
+		}
+		if id := c.GetUint(auditProjectKey); id != 0 {
+			if projectID == nil || *projectID != id {
+				before = nil
+			}
+			projectID = &id
+		}
+		h.record(c, action, requestID, projectID, before)
+	}
+}
+
+// record writes the audit entry of a request that succeeded, with the project
+// snapshotted after the change.
+func (h *projectAuditHandler) record(c *gin.Context, action, requestID string, projectID *uint, before *models.ProjectAuditSnapshot) {
+	entry := &models.ProjectAuditEntry{
+		RequestID: requestID,
+		Actor:     c.GetString("username"),
+		Action:    action,
+		ProjectID: projectID,
+		Method:    c.Request.Method,
+		Path:      c.Request.URL.Path,
+		Status:    c.Writer.Status(),
+		Before:    before,
+	}
+	if projectID != nil {
+		after, err := h.auditService.Snapshot(*projectID)
+		if err != nil {
+			log.Printf("Error taking the audit snapshot of project %d: %v", *projectID, err)
+		}
+		entry.After = after
+	}
+	if err := h.auditService.Record(entry); err != nil {
+		log.Printf("Error recording audit entry %s for %s: %v", action, requestID, err)
+	}
+}
+
+func newRequestID() string {
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return ""
+	}
+	return hex.EncodeToString(id)
+}
+
+// GetEntries godoc
+// @Summary Audit log
+// @Description Changes made through the module, newest first, with the project before and after each change
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Param actor query string false "Username of the actor, system for changes the module made on its own"
+// @Param action query string false "Action, like project.update"
+// @Param project_id query int false "Project ID"
+// @Param request_id query string false "Request ID"
+// @Param from query string false "Entries created at or after, RFC 3339"
+// @Param to query string false "Entries created before, RFC 3339"
+// @Param page query int false "Page number"
+// @Param per-page query int false "Page size"
+// @Success 200 {array} dto.ProjectAuditEntry "Audit entries"
+// @Failure 400 {object} map[string]interface{} "Invalid filter"
+// @Failure 401 {object} map[string]interface{} "Not an admin"
+// @Router /admin/projects/audit [get]
+func (h *projectAuditHandler) GetEntries(c *gin.Context) {
+	user, failed := h.requestHelper.GetAndValidateUsername(c, h)
+	if failed {
+		return
+	}
+	filter := dto.ProjectAuditFilter{
+		Actor:     c.Query("actor"),
+		Action:    c.Query("action"),
+		RequestID: c.Query("request_id"),
+	}
+	if c.Query("project_id") != "" {
+		projectID, err := h.validator.ValidateIDAndParse(c.Query("project_id"))
+		if err != nil {
+			h.responseHelper.BadRequest(c, err.Error(), "Please provide a valid project_id.")
+			return
+		}
+		filter.ProjectID = projectID
+	}
+	var err error
+	if filter.From, err = timeQuery(c, "from"); err != nil {
+		h.responseHelper.BadRequest(c, err.Error(), "Please provide from as an RFC 3339 time.")
+		return
+	}
+	if filter.To, err = timeQuery(c, "to"); err != nil {
+		h.responseHelper.BadRequest(c, err.Error(), "Please provide to as an RFC 3339 time.")
+		return
+	}
+	limit, offset := h.requestHelper.GetLimitAndOffset(c)
+	entries, err := h.auditService.GetEntries(user, filter, limit, offset)
+	if err != nil {
+		respondWithError(c, h.responseHelper, "Failed to retrieve the audit log", err)
+		return
+	}
+	h.responseHelper.Success(c, entries)
+}
+
+// timeQuery parses the RFC 3339 time in the query parameter, nil when it is not set.
+func timeQuery(c *gin.Context, name string) (*time.Time, error) {
+	if c.Query(name) == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, c.Query(name))
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
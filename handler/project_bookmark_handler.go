@@ -0,0 +1,230 @@
+package handler
+
+import (
+	"github.com/aruncs31s/esdcprojectmodule/dto"
+	"github.com/aruncs31s/esdcprojectmodule/interfaces/handler"
+	"github.com/aruncs31s/esdcprojectmodule/interfaces/service"
+	"github.com/aruncs31s/esdcsharedhelpersmodule/helper"
+	sharedHelper "github.com/aruncs31s/esdcsharedhelpersmodule/interface/helper"
+	"github.com/aruncs31s/esdcsharedhelpersmodule/utils"
+	"github.com/aruncs31s/responsehelper"
+	"github.com/gin-gonic/gin"
+)
+
+type projectBookmarkHandler struct {
+	bookmarkService service.ProjectBookmarkService
+	requestHelper   sharedHelper.RequestHelper
+	responseHelper  responsehelper.ResponseHelper
+	validator       sharedHelper.RequestValidator
+}
+
+func NewProjectBookmarkHandler(bookmarkService service.ProjectBookmarkService) handler.ProjectBookmarkHandler {
+	responseHelper, requestHelper, validator := getHelpers()
+	return &projectBookmarkHandler{
+		bookmarkService: bookmarkService,
+		requestHelper:   requestHelper,
+		responseHelper:  responseHelper,
+		validator:       validator,
+	}
+}
+
+func (h *projectBookmarkHandler) GetValidator() sharedHelper.RequestValidator {
+	return h.validator
+}
+func (h *projectBookmarkHandler) GetResponseHelper() responsehelper.ResponseHelper {
+	return h.responseHelper
+}
+
+// GetBookmarks godoc
+// @Summary Own bookmarks
+// @Description Bookmarked projects, newest first. The state tells whether each project is still available, was made private or was deleted
+// @Tags projects
+// @Produce json
+// @Security BearerAuth
+// @Param folder_id query int false "Only the bookmarks of this folder"
+// @Param page query int false "Page number"
+// @Param per-page query int false "Page size"
+// @Success 200 {array} dto.ProjectBookmark "Bookmarks"
+// @Failure 404 {object} map[string]interface{} "Folder not found"
+// @Router /projects/bookmarks [get]
+func (h *projectBookmarkHandler) GetBookmarks(c *gin.Context) {
+	user, failed := h.requestHelper.GetAndValidateUsername(c, h)
+	if failed {
+		return
+	}
+	var folderID *uint
+	if c.Query("folder_id") != "" {
+		id, err := h.validator.ValidateIDAndParse(c.Query("folder_id"))
+		if err != nil {
+			h.responseHelper.BadRequest(c, err.Error(), "Please provide a valid folder_id.")
+			return
+		}
+		folderID = &id
+	}
+	limit, offset := h.requestHelper.GetLimitAndOffset(c)
+	bookmarks, err := h.bookmarkService.GetBookmarks(user, folderID, limit, offset)
+	if err != nil {
+		respondWithError(c, h.responseHelper, "Failed to retrieve bookmarks", err)
+		return
+	}
+	h.responseHelper.Success(c, bookmarks)
+}
+
+// Bookmark godoc
+// @Summary Bookmark a project
+// @Description Saves a project for later, bookmarking it again moves it to another folder
+// @Tags projects
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param body body dto.ProjectBookmarkRequest true "Project and folder"
+// @Success 200 {object} dto.ProjectBookmark "Bookmark"
+// @Failure 404 {object} map[string]interface{} "Project or folder not found"
+// @Router /projects/bookmarks [post]
+func (h *projectBookmarkHandler) Bookmark(c *gin.Context) {
+	user, failed := h.requestHelper.GetAndValidateUsername(c, h)
+	if failed {
+		return
+	}
+	request, failed := helper.GetJSONDataFromRequest[dto.ProjectBookmarkRequest](c, h.responseHelper)
+	if failed {
+		return
+	}
+	bookmark, err := h.bookmarkService.Bookmark(user, request)
+	if err != nil {
+		respondWithError(c, h.responseHelper, "Failed to bookmark project", err)
+		return
+	}
+	h.responseHelper.Success(c, bookmark)
+}
+
+// RemoveBookmark godoc
+// @Summary Remove a bookmark
+// @Tags projects
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Project ID"
+// @Success 200 {object} map[string]interface{} "Bookmark deleted"
+// @Failure 404 {object} map[string]interface{} "Project not bookmarked"
+// @Router /projects/bookmarks/{id} [delete]
+func (h *projectBookmarkHandler) RemoveBookmark(c *gin.Context) {
+	user, failed := h.requestHelper.GetAndValidateUsername(c, h)
+	if failed {
+		return
+	}
+	projectID, failed := h.requestHelper.ValidateAndParseID(h, "id", c, utils.FixInvalidID)
+	if failed {
+		return
+	}
+	if err := h.bookmarkService.RemoveBookmark(user, projectID); err != nil {
+		respondWithError(c, h.responseHelper, "Failed to remove bookmark", err)
+		return
+	}
+	h.responseHelper.Deleted(c, "Bookmark")
+}
+
+// GetFolders godoc
+// @Summary Bookmark folders
+// @Tags projects
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {array} dto.ProjectBookmarkFolder "Folders"
+// @Router /projects/bookmarks/folders [get]
+func (h *projectBookmarkHandler) GetFolders(c *gin.Context) {
+	user, failed := h.requestHelper.GetAndValidateUsername(c, h)
+	if failed {
+		return
+	}
+	folders, err := h.bookmarkService.GetFolders(user)
+	if err != nil {
+		respondWithError(c, h.responseHelper, "Failed to retrieve bookmark folders", err)
+		return
+	}
+	h.responseHelper.Success(c, folders)
+}
+
+// CreateFolder godoc
+// @Summary Create a bookmark folder
+// @Tags projects
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param body body dto.ProjectBookmarkFolderRequest true "Folder"
+// @Success 201 {object} dto.ProjectBookmarkFolder "Folder created"
+// @Failure 400 {object} map[string]interface{} "Empty or duplicate name"
+// @Router /projects/bookmarks/folders [post]
+func (h *projectBookmarkHandler) CreateFolder(c *gin.Context) {
+	user, failed := h.requestHelper.GetAndValidateUsername(c, h)
+	if failed {
+		return
+	}
+	request, failed := helper.GetJSONDataFromRequest[dto.ProjectBookmarkFolderRequest](c, h.responseHelper)
+	if failed {
+		return
+	}
+	folder, err := h.bookmarkService.CreateFolder(user, request.Name)
+	if err != nil {
+		respondWithError(c, h.responseHelper, "Failed to create bookmark folder", err)
+		return
+	}
+	h.responseHelper.Created(c, folder)
+}
+
+// RenameFolder godoc
+// @Summary Rename a bookmark folder
+// @Tags projects
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Folder ID"
+// @Param body body dto.ProjectBookmarkFolderRequest true "Folder"
+// @Success 200 {object} dto.ProjectBookmarkFolder "Folder renamed"
+// @Failure 400 {object} map[string]interface{} "Empty or duplicate name"
+// @Failure 404 {object} map[string]interface{} "Folder not found"
+// @Router /projects/bookmarks/folders/{id} [put]
+func (h *projectBookmarkHandler) RenameFolder(c *gin.Context) {
+	user, failed := h.requestHelper.GetAndValidateUsername(c, h)
+	if failed {
+		return
+	}
+	folderID, failed := h.requestHelper.ValidateAndParseID(h, "id", c, utils.FixInvalidID)
+	if failed {
+		return
+	}
+	request, failed := helper.GetJSONDataFromRequest[dto.ProjectBookmarkFolderRequest](c, h.responseHelper)
+	if failed {
+		return
+	}
+	folder, err := h.bookmarkService.RenameFolder(user, folderID, request.Name)
+	if err != nil {
+		respondWithError(c, h.responseHelper, "Failed to rename bookmark folder", err)
+		return
+	}
+	h.responseHelper.Success(c, folder)
+}
+
+// DeleteFolder godoc
+// @Summary Delete a bookmark folder
+// @Description The bookmarks of the folder are kept without a folder
+// @Tags projects
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Folder ID"
+// @Success 200 {object} map[string]interface{} "Folder deleted"
+// @Failure 404 {object} map[string]interface{} "Folder not found"
+// @Router /projects/bookmarks/folders/{id} [delete]
+func (h *projectBookmarkHandler) DeleteFolder(c *gin.Context) {
+	user, failed := h.requestHelper.GetAndValidateUsername(c, h)
+	if failed {
+		return
+	}
+	folderID, failed := h.requestHelper.ValidateAndParseID(h, "id", c, utils.FixInvalidID)
+	if failed {
+		return
+	}
+	if err := h.bookmarkService.DeleteFolder(user, folderID); err != nil {
+		respondWithError(c, h.responseHelper, "Failed to delete bookmark folder", err)
+		return
+	}
+	h.responseHelper.Deleted(c, "Folder")
+}
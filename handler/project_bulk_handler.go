@@ -0,0 +1,69 @@
+package handler
+
+import (
+	"github.com/aruncs31s/esdcprojectmodule/dto"
+	"github.com/aruncs31s/esdcprojectmodule/interfaces/handler"
+	"github.com/aruncs31s/esdcprojectmodule/interfaces/service"
+	"github.com/aruncs31s/esdcsharedhelpersmodule/helper"
+	sharedHelper "github.com/aruncs31s/esdcsharedhelpersmodule/interface/helper"
+	"github.com/aruncs31s/responsehelper"
+	"github.com/gin-gonic/gin"
+)
+
+type projectBulkHandler struct {
+	bulkService    service.ProjectBulkService
+	requestHelper  sharedHelper.RequestHelper
+	responseHelper responsehelper.ResponseHelper
+	validator      sharedHelper.RequestValidator
+}
+
+func NewProjectBulkHandler(bulkService service.ProjectBulkService) handler.ProjectBulkHandler {
+	responseHelper, requestHelper, validator := getHelpers()
+	return &projectBulkHandler{
+		bulkService:    bulkService,
+		requestHelper:  requestHelper,
+		responseHelper: responseHelper,
+		validator:      validator,
+	}
+}
+
+func (h *projectBulkHandler) GetValidator() sharedHelper.RequestValidator {
+	return h.validator
+}
+func (h *projectBulkHandler) GetResponseHelper() responsehelper.ResponseHelper {
+	return h.responseHelper
+}
+
+// Run godoc
+// @Summary Run one operation on many projects
+// @Description Selects projects by ID or by the filter of the admin list and sets their status or visibility, adds or removes a tag, trashes or restores them or transfers them to a new owner. Projects are changed in chunks of one transaction each, the outcome is reported per project. A dry run only reports what would change.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param body body dto.BulkProjectRequest true "Projects and operation"
+// @Success 200 {object} dto.BulkProjectResult "Outcome per project"
+// @Failure 400 {object} map[string]interface{} "Invalid operation or selection"
+// @Failure 401 {object} map[string]interface{} "Not an admin"
+// @Router /admin/projects/bulk [post]
+func (h *projectBulkHandler) Run(c *gin.Context) {
+	user, failed := h.requestHelper.GetAndValidateUsername(c, h)
+	if failed {
+		return
+	}
+	request, failed := helper.GetJSONDataFromRequest[dto.BulkProjectRequest](c, h.responseHelper)
+	if failed {
+		return
+	}
+	result, err := h.bulkService.Run(user, request)
+	if err != nil {
+		respondWithError(c, h.responseHelper, "Failed to run the bulk operation", err)
+		return
+	}
+	if result.DryRun {
+		skipAudit(c)
+	} else {
+		setAuditProjects(c, result.Affected, result.Before)
+	}
+	h.responseHelper.Success(c, result)
+}
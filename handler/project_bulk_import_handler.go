@@ -0,0 +1,198 @@
+package handler
+
+import (
+	"encoding/csv"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aruncs31s/esdcprojectmodule/dto"
+	"github.com/aruncs31s/esdcprojectmodule/interfaces/handler"
+	"github.com/aruncs31s/esdcprojectmodule/interfaces/service"
+	projectUtils "github.com/aruncs31s/esdcprojectmodule/utils"
+	"github.com/aruncs31s/esdcsharedhelpersmodule/helper"
+	sharedHelper "github.com/aruncs31s/esdcsharedhelpersmodule/interface/helper"
+	"github.com/aruncs31s/responsehelper"
+	"github.com/gin-gonic/gin"
+)
+
+// importListSeparator separates the tags, technologies and contributors in one CSV column.
+const importListSeparator = ";"
+
+type projectBulkImportHandler struct {
+	importService  service.ProjectBulkImportService
+	requestHelper  sharedHelper.RequestHelper
+	responseHelper responsehelper.ResponseHelper
+	validator      sharedHelper.RequestValidator
+}
+
+func NewProjectBulkImportHandler(importService service.ProjectBulkImportService) handler.ProjectBulkImportHandler {
+	responseHelper, requestHelper, validator := getHelpers()
+	return &projectBulkImportHandler{
+		importService:  importService,
+		requestHelper:  requestHelper,
+		responseHelper: responseHelper,
+		validator:      validator,
+	}
+}
+
+func (h *projectBulkImportHandler) GetValidator() sharedHelper.RequestValidator {
+	return h.validator
+}
+func (h *projectBulkImportHandler) GetResponseHelper() responsehelper.ResponseHelper {
+	return h.responseHelper
+}
+
+// ImportProjects godoc
+// @Summary Import projects from a CSV or JSON file
+// @Description Creates a project for the creator of each row, checked with the rules of project creation. CSV files have a header row naming the columns like the json fields, unknown columns are ignored and tags, technologies and contributors are separated by ";". Contributors are invited. A cell that can not be read, like a draft that is not true or false, only fails its row. A dry run only reports the errors of each row. all_or_nothing imports create nothing unless every row can be created, best_effort imports create the valid rows.
+// @Tags admin
+// @Accept text/csv
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param body body []dto.ProjectImportRow true "Projects"
+// @Param mode query string false "all_or_nothing (default) or best_effort"
+// @Param dry_run query bool false "Only check the rows"
+// @Success 200 {object} dto.ProjectImportResult "Report per row"
+// @Failure 400 {object} map[string]interface{} "Unreadable file or invalid mode"
+// @Failure 401 {object} map[string]interface{} "Not an admin"
+// @Router /admin/projects/import [post]
+func (h *projectBulkImportHandler) ImportProjects(c *gin.Context) {
+	user, failed := h.requestHelper.GetAndValidateUsername(c, h)
+	if failed {
+		return
+	}
+	options := dto.ProjectImportOptions{Mode: c.Query("mode")}
+	if c.Query("dry_run") != "" {
+		dryRun, err := strconv.ParseBool(c.Query("dry_run"))
+		if err != nil {
+			h.responseHelper.BadRequest(c, err.Error(), "Please provide dry_run as true or false.")
+			return
+		}
+		options.DryRun = dryRun
+	}
+	var rows []dto.ProjectImportRow
+	switch c.ContentType() {
+	case "text/csv":
+		var err error
+		if rows, err = parseImportCSV(c.Request.Body); err != nil {
+			h.responseHelper.BadRequest(c, "Failed to read the CSV file", err.Error())
+			return
+		}
+	case "application/json":
+		if rows, failed = helper.GetJSONDataFromRequest[[]dto.ProjectImportRow](c, h.responseHelper); failed {
+			return
+		}
+	default:
+		respondWithError(c, h.responseHelper, "Failed to import projects", projectUtils.ErrUnsupportedImport)
+		return
+	}
+	result, err := h.importService.ImportProjects(user, rows, options)
+	if err != nil {
+		respondWithError(c, h.responseHelper, "Failed to import projects", err)
+		return
+	}
+	created := make([]uint, 0, result.Created)
+	for _, row := range result.Rows {
+		if row.ProjectID != nil {
+			created = append(created, *row.ProjectID)
+		}
+	}
+	if len(created) == 0 {
+		skipAudit(c)
+	} else {
+		setAuditProjects(c, created, nil)
+	}
+	h.responseHelper.Success(c, result)
+}
+
+// parseImportCSV reads the rows of a CSV import, the first line names the columns.
+func parseImportCSV(r io.Reader) ([]dto.ProjectImportRow, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	header, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	var rows []dto.ProjectImportRow
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return rows, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, parseImportRecord(record, columns))
+	}
+}
+
+// parseImportRecord reads one CSV row, cells that can not be read are reported
+// in CellErrors so the row fails on its own.
+func parseImportRecord(record []string, columns map[string]int) dto.ProjectImportRow {
+	value := func(column string) string {
+		i, ok := columns[column]
+		if !ok || i >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[i])
+	}
+	optional := func(column string) *string {
+		if v := value(column); v != "" {
+			return &v
+		}
+		return nil
+	}
+	list := func(column string) *[]string {
+		items := make([]string, 0)
+		for _, item := range strings.Split(value(column), importListSeparator) {
+			if item = strings.TrimSpace(item); item != "" {
+				items = append(items, item)
+			}
+		}
+		return &items
+	}
+	row := dto.ProjectImportRow{
+		Creator: value("creator"),
+		ProjectCreation: dto.ProjectCreation{
+			Title:        value("title"),
+			Image:        optional("image"),
+			Description:  value("description"),
+			Status:       value("status"),
+			Visibility:   value("visibility"),
+			GithubLink:   value("github_link"),
+			Technologies: list("technologies"),
+			Tags:         list("tags"),
+			LiveURL:      optional("live_url"),
+			Category:     value("category"),
+			Contributors: list("contributors"),
+		},
+	}
+	if draft := value("draft"); draft != "" {
+		parsed, err := strconv.ParseBool(draft)
+		if err != nil {
+			row.CellErrors = append(row.CellErrors, projectUtils.ErrInvalidImportDraft)
+		} else {
+			row.Draft = parsed
+		}
+	}
+	if publishAt := value("publish_at"); publishAt != "" {
+		parsed, err := time.Parse(time.RFC3339, publishAt)
+		if err != nil {
+			row.CellErrors = append(row.CellErrors, projectUtils.ErrInvalidImportPublishAt)
+		} else {
+			row.PublishAt = &parsed
+		}
+	}
+	return row
+}
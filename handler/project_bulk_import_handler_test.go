@@ -0,0 +1,62 @@
+package handler
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	projectUtils "github.com/aruncs31s/esdcprojectmodule/utils"
+)
+
+func TestParseImportCSV(t *testing.T) {
+	rows, err := parseImportCSV(strings.NewReader(
+		"Title,creator,draft,publish_at,tags,unknown\n" +
+			"Robot,alice,true,,arduino; led ;,x\n" +
+			"Lamp,bob,maybe,2026-03-02T10:00:00Z,,x\n" +
+			"Rover,carol,,next week,,x\n" +
+			"Short row,dave\n",
+	))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rows) != 4 {
+		t.Fatalf("got %d rows, want 4", len(rows))
+	}
+
+	robot := rows[0]
+	if robot.Title != "Robot" || robot.Creator != "alice" || !robot.Draft || robot.PublishAt != nil || len(robot.CellErrors) != 0 {
+		t.Errorf("row 1 = %+v", robot)
+	}
+	if robot.Tags == nil || strings.Join(*robot.Tags, ",") != "arduino,led" {
+		t.Errorf("row 1 tags = %v", robot.Tags)
+	}
+
+	// A bad cell fails its row, the other cells are still read.
+	lamp := rows[1]
+	publishAt := time.Date(2026, time.March, 2, 10, 0, 0, 0, time.UTC)
+	if len(lamp.CellErrors) != 1 || !errors.Is(lamp.CellErrors[0], projectUtils.ErrInvalidImportDraft) {
+		t.Errorf("row 2 errors = %v, want ErrInvalidImportDraft", lamp.CellErrors)
+	}
+	if lamp.Title != "Lamp" || lamp.PublishAt == nil || !lamp.PublishAt.Equal(publishAt) {
+		t.Errorf("row 2 = %+v", lamp)
+	}
+	rover := rows[2]
+	if len(rover.CellErrors) != 1 || !errors.Is(rover.CellErrors[0], projectUtils.ErrInvalidImportPublishAt) || rover.PublishAt != nil {
+		t.Errorf("row 3 = %+v, want ErrInvalidImportPublishAt", rover)
+	}
+
+	short := rows[3]
+	if short.Title != "Short row" || short.Creator != "dave" || short.Draft || len(short.CellErrors) != 0 {
+		t.Errorf("row 4 = %+v", short)
+	}
+}
+
+func TestParseImportCSVUnreadableFile(t *testing.T) {
+	if rows, err := parseImportCSV(strings.NewReader("")); err != nil || rows != nil {
+		t.Fatalf("parseImportCSV(empty) = %v, %v", rows, err)
+	}
+	if _, err := parseImportCSV(strings.NewReader("title\n\"Robot\n")); err == nil {
+		t.Fatal("parseImportCSV() with an unterminated quote error = nil")
+	}
+}
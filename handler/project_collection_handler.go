@@ -0,0 +1,228 @@
+package handler
+
+import (
+	"github.com/aruncs31s/esdcprojectmodule/dto"
+	"github.com/aruncs31s/esdcprojectmodule/interfaces/handler"
+	"github.com/aruncs31s/esdcprojectmodule/interfaces/service"
+	"github.com/aruncs31s/esdcsharedhelpersmodule/helper"
+	sharedHelper "github.com/aruncs31s/esdcsharedhelpersmodule/interface/helper"
+	"github.com/aruncs31s/responsehelper"
+	"github.com/gin-gonic/gin"
+)
+
+type projectCollectionHandler struct {
+	collectionService service.ProjectCollectionService
+	requestHelper     sharedHelper.RequestHelper
+	responseHelper    responsehelper.ResponseHelper
+	validator         sharedHelper.RequestValidator
+}
+
+func NewProjectCollectionHandler(collectionService service.ProjectCollectionService) handler.ProjectCollectionHandler {
+	responseHelper, requestHelper, validator := getHelpers()
+	return &projectCollectionHandler{
+		collectionService: collectionService,
+		requestHelper:     requestHelper,
+		responseHelper:    responseHelper,
+		validator:         validator,
+	}
+}
+
+func (h *projectCollectionHandler) GetValidator() sharedHelper.RequestValidator {
+	return h.validator
+}
+func (h *projectCollectionHandler) GetResponseHelper() responsehelper.ResponseHelper {
+	return h.responseHelper
+}
+
+// GetPublicCollections godoc
+// @Summary Public collections
+// @Description Public collections of projects, newest first
+// @Tags collections
+// @Produce json
+// @Param page query int false "Page number"
+// @Param per-page query int false "Page size"
+// @Success 200 {array} dto.ProjectCollection "Collections"
+// @Router /public/collections [get]
+func (h *projectCollectionHandler) GetPublicCollections(c *gin.Context) {
+	limit, offset := h.requestHelper.GetLimitAndOffset(c)
+	collections, err := h.collectionService.GetPublicCollections(limit, offset)
+	if err != nil {
+		respondWithError(c, h.responseHelper, "Failed to retrieve collections", err)
+		return
+	}
+	h.responseHelper.Success(c, collections)
+}
+
+// GetPublicCollection godoc
+// @Summary Public collection
+// @Description A public collection with its projects in order, projects that are no longer visible to everyone are left out
+// @Tags collections
+// @Produce json
+// @Param slug path string true "Collection slug"
+// @Success 200 {object} dto.ProjectCollectionDetail "Collection"
+// @Failure 404 {object} map[string]interface{} "Collection not found"
+// @Router /public/collections/{slug} [get]
+func (h *projectCollectionHandler) GetPublicCollection(c *gin.Context) {
+	collection, err := h.collectionService.GetPublicCollection(c.Param("slug"))
+	if err != nil {
+		respondWithError(c, h.responseHelper, "Failed to retrieve collection", err)
+		return
+	}
+	h.responseHelper.Success(c, collection)
+}
+
+// GetCollections godoc
+// @Summary Own collections
+// @Description The collections the user can change, admins get the collections run by the admins as well
+// @Tags collections
+// @Produce json
+// @Security BearerAuth
+// @Param page query int false "Page number"
+// @Param per-page query int false "Page size"
+// @Success 200 {array} dto.ProjectCollection "Collections"
+// @Router /collections [get]
+func (h *projectCollectionHandler) GetCollections(c *gin.Context) {
+	user, failed := h.requestHelper.GetAndValidateUsername(c, h)
+	if failed {
+		return
+	}
+	limit, offset := h.requestHelper.GetLimitAndOffset(c)
+	collections, err := h.collectionService.GetCollections(user, limit, offset)
+	if err != nil {
+		respondWithError(c, h.responseHelper, "Failed to retrieve collections", err)
+		return
+	}
+	h.responseHelper.Success(c, collections)
+}
+
+// GetCollection godoc
+// @Summary Collection
+// @Description A collection with the projects the user can see, in order
+// @Tags collections
+// @Produce json
+// @Security BearerAuth
+// @Param slug path string true "Collection slug"
+// @Success 200 {object} dto.ProjectCollectionDetail "Collection"
+// @Failure 404 {object} map[string]interface{} "Collection not found"
+// @Router /collections/{slug} [get]
+func (h *projectCollectionHandler) GetCollection(c *gin.Context) {
+	user, failed := h.requestHelper.GetAndValidateUsername(c, h)
+	if failed {
+		return
+	}
+	collection, err := h.collectionService.GetCollection(user, c.Param("slug"))
+	if err != nil {
+		respondWithError(c, h.responseHelper, "Failed to retrieve collection", err)
+		return
+	}
+	h.responseHelper.Success(c, collection)
+}
+
+// CreateCollection godoc
+// @Summary Create a collection
+// @Tags collections
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param body body dto.ProjectCollectionRequest true "Collection"
+// @Success 201 {object} dto.ProjectCollectionDetail "Collection created"
+// @Failure 400 {object} map[string]interface{} "Invalid collection"
+// @Failure 401 {object} map[string]interface{} "Not an admin, or a project the user can not see"
+// @Router /collections [post]
+func (h *projectCollectionHandler) CreateCollection(c *gin.Context) {
+	user, failed := h.requestHelper.GetAndValidateUsername(c, h)
+	if failed {
+		return
+	}
+	request, failed := helper.GetJSONDataFromRequest[dto.ProjectCollectionRequest](c, h.responseHelper)
+	if failed {
+		return
+	}
+	collection, err := h.collectionService.CreateCollection(user, request)
+	if err != nil {
+		respondWithError(c, h.responseHelper, "Failed to create collection", err)
+		return
+	}
+	h.responseHelper.Created(c, collection)
+}
+
+// UpdateCollection godoc
+// @Summary Update a collection
+// @Tags collections
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param slug path string true "Collection slug"
+// @Param body body dto.ProjectCollectionUpdate true "Changes"
+// @Success 200 {object} dto.ProjectCollectionDetail "Collection updated"
+// @Failure 400 {object} map[string]interface{} "Invalid changes"
+// @Failure 401 {object} map[string]interface{} "Not the owner"
+// @Router /collections/{slug} [put]
+func (h *projectCollectionHandler) UpdateCollection(c *gin.Context) {
+	user, failed := h.requestHelper.GetAndValidateUsername(c, h)
+	if failed {
+		return
+	}
+	request, failed := helper.GetJSONDataFromRequest[dto.ProjectCollectionUpdate](c, h.responseHelper)
+	if failed {
+		return
+	}
+	collection, err := h.collectionService.UpdateCollection(user, c.Param("slug"), request)
+	if err != nil {
+		respondWithError(c, h.responseHelper, "Failed to update collection", err)
+		return
+	}
+	h.responseHelper.Success(c, collection)
+}
+
+// DeleteCollection godoc
+// @Summary Delete a collection
+// @Description The projects of the collection are not touched
+// @Tags collections
+// @Produce json
+// @Security BearerAuth
+// @Param slug path string true "Collection slug"
+// @Success 200 {object} map[string]interface{} "Collection deleted"
+// @Failure 401 {object} map[string]interface{} "Not the owner"
+// @Router /collections/{slug} [delete]
+func (h *projectCollectionHandler) DeleteCollection(c *gin.Context) {
+	user, failed := h.requestHelper.GetAndValidateUsername(c, h)
+	if failed {
+		return
+	}
+	if err := h.collectionService.DeleteCollection(user, c.Param("slug")); err != nil {
+		respondWithError(c, h.responseHelper, "Failed to delete collection", err)
+		return
+	}
+	h.responseHelper.Deleted(c, "Collection")
+}
+
+// SetProjects godoc
+// @Summary Set the projects of a collection
+// @Description Replaces the projects of the collection, adding, removing and reordering them at once
+// @Tags collections
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param slug path string true "Collection slug"
+// @Param body body dto.ProjectCollectionProjects true "Every project of the collection in order"
+// @Success 200 {object} dto.ProjectCollectionDetail "Collection"
+// @Failure 400 {object} map[string]interface{} "A project is listed twice"
+// @Failure 401 {object} map[string]interface{} "Not the owner, or a project the user can not see"
+// @Router /collections/{slug}/projects [put]
+func (h *projectCollectionHandler) SetProjects(c *gin.Context) {
+	user, failed := h.requestHelper.GetAndValidateUsername(c, h)
+	if failed {
+		return
+	}
+	request, failed := helper.GetJSONDataFromRequest[dto.ProjectCollectionProjects](c, h.responseHelper)
+	if failed {
+		return
+	}
+	collection, err := h.collectionService.SetProjects(user, c.Param("slug"), request.ProjectIDs)
+	if err != nil {
+		respondWithError(c, h.responseHelper, "Failed to set the projects of the collection", err)
+		return
+	}
+	h.responseHelper.Success(c, collection)
+}
@@ -0,0 +1,127 @@
+package handler
+
+import (
+	"github.com/aruncs31s/esdcprojectmodule/dto"
+	"github.com/aruncs31s/esdcprojectmodule/interfaces/handler"
+	"github.com/aruncs31s/esdcprojectmodule/interfaces/service"
+	"github.com/aruncs31s/esdcsharedhelpersmodule/helper"
+	sharedHelper "github.com/aruncs31s/esdcsharedhelpersmodule/interface/helper"
+	"github.com/aruncs31s/esdcsharedhelpersmodule/utils"
+	"github.com/aruncs31s/responsehelper"
+	"github.com/gin-gonic/gin"
+)
+
+type projectContributorHandler struct {
+	contributorService service.ProjectContributorService
+	requestHelper      sharedHelper.RequestHelper
+	responseHelper     responsehelper.ResponseHelper
+	validator          sharedHelper.RequestValidator
+}
+
+func NewProjectContributorHandler(contributorService service.ProjectContributorService) handler.ProjectContributorHandler {
+	responseHelper, requestHelper, validator := getHelpers()
+	return &projectContributorHandler{
+		contributorService: contributorService,
+		requestHelper:      requestHelper,
+		responseHelper:     responseHelper,
+		validator:          validator,
+	}
+}
+
+func (h *projectContributorHandler) GetValidator() sharedHelper.RequestValidator {
+	return h.validator
+}
+func (h *projectContributorHandler) GetResponseHelper() responsehelper.ResponseHelper {
+	return h.responseHelper
+}
+
+// GetContributors godoc
+// @Summary List the contributors of a project with their roles
+// @Tags projects
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Project ID"
+// @Success 200 {object} map[string]interface{} "Contributors"
+// @Router /projects/{id}/contributors [get]
+func (h *projectContributorHandler) GetContributors(c *gin.Context) {
+	user, failed := h.requestHelper.GetAndValidateUsername(c, h)
+	if failed {
+		return
+	}
+	projectID, failed := h.requestHelper.ValidateAndParseID(h, "id", c, utils.FixInvalidID)
+	if failed {
+		return
+	}
+	contributors, err := h.contributorService.GetContributors(user, projectID)
+	if err != nil {
+		respondWithError(c, h.responseHelper, "Failed to retrieve contributors", err)
+		return
+	}
+	h.responseHelper.Success(c, contributors)
+}
+
+// UpdateRole godoc
+// @Summary Change the role of a contributor
+// @Tags projects
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Project ID"
+// @Param userId path int true "Contributor user ID"
+// @Param body body dto.UpdateContributorRole true "New role"
+// @Success 200 {object} map[string]interface{} "Role updated"
+// @Router /projects/{id}/contributors/{userId} [put]
+func (h *projectContributorHandler) UpdateRole(c *gin.Context) {
+	user, failed := h.requestHelper.GetAndValidateUsername(c, h)
+	if failed {
+		return
+	}
+	projectID, failed := h.requestHelper.ValidateAndParseID(h, "id", c, utils.FixInvalidID)
+	if failed {
+		return
+	}
+	contributorID, failed := h.requestHelper.ValidateAndParseID(h, "userId", c, utils.FixInvalidID)
+	if failed {
+		return
+	}
+	request, failed := helper.GetJSONDataFromRequest[dto.UpdateContributorRole](c, h.responseHelper)
+	if failed {
+		return
+	}
+	if err := h.contributorService.UpdateRole(user, projectID, contributorID, request.Role); err != nil {
+		respondWithError(c, h.responseHelper, "Failed to update role", err)
+		return
+	}
+	h.responseHelper.Success(c, map[string]interface{}{
+		"message": "Role updated successfully",
+	})
+}
+
+// RemoveContributor godoc
+// @Summary Remove a contributor from a project
+// @Tags projects
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Project ID"
+// @Param userId path int true "Contributor user ID"
+// @Success 200 {object} map[string]interface{} "Contributor removed"
+// @Router /projects/{id}/contributors/{userId} [delete]
+func (h *projectContributorHandler) RemoveContributor(c *gin.Context) {
+	user, failed := h.requestHelper.GetAndValidateUsername(c, h)
+	if failed {
+		return
+	}
+	projectID, failed := h.requestHelper.ValidateAndParseID(h, "id", c, utils.FixInvalidID)
+	if failed {
+		return
+	}
+	contributorID, failed := h.requestHelper.ValidateAndParseID(h, "userId", c, utils.FixInvalidID)
+	if failed {
+		return
+	}
+	if err := h.contributorService.RemoveContributor(user, projectID, contributorID); err != nil {
+		respondWithError(c, h.responseHelper, "Failed to remove contributor", err)
+		return
+	}
+	h.responseHelper.Deleted(c, "Contributor")
+}
@@ -0,0 +1,305 @@
+package handler
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aruncs31s/esdcprojectmodule/dto"
+	"github.com/aruncs31s/esdcprojectmodule/interfaces/handler"
+	"github.com/aruncs31s/esdcprojectmodule/interfaces/service"
+	projectUtils "github.com/aruncs31s/esdcprojectmodule/utils"
+	sharedHelper "github.com/aruncs31s/esdcsharedhelpersmodule/interface/helper"
+	"github.com/aruncs31s/responsehelper"
+	"github.com/gin-gonic/gin"
+)
+
+// Formats of the exports, csv when the format query is empty.
+const (
+	exportFormatCSV    = "csv"
+	exportFormatJSON   = "json"
+	exportFormatNDJSON = "ndjson"
+)
+
+// exportListSeparator joins the tags, technologies and contributors of a project in one CSV column.
+const exportListSeparator = "; "
+
+// exportColumns is the header row of CSV exports, in the order of exportRecord.
+var exportColumns = []string{
+	"id", "title", "description", "category", "status", "visibility", "version", "cost",
+	"likes", "views", "github_link", "live_url", "creator", "tags", "technologies",
+	"contributors", "forked_from_id", "created_at", "updated_at",
+}
+
+type projectExportHandler struct {
+	exportService  service.ProjectExportService
+	requestHelper  sharedHelper.RequestHelper
+	responseHelper responsehelper.ResponseHelper
+	validator      sharedHelper.RequestValidator
+}
+
+func NewProjectExportHandler(exportService service.ProjectExportService) handler.ProjectExportHandler {
+	responseHelper, requestHelper, validator := getHelpers()
+	return &projectExportHandler{
+		exportService:  exportService,
+		requestHelper:  requestHelper,
+		responseHelper: responseHelper,
+		validator:      validator,
+	}
+}
+
+func (h *projectExportHandler) GetValidator() sharedHelper.RequestValidator {
+	return h.validator
+}
+func (h *projectExportHandler) GetResponseHelper() responsehelper.ResponseHelper {
+	return h.responseHelper
+}
+
+// ExportProjects godoc
+// @Summary Export every project
+// @Description Streams the projects matching the filters of the admin list. CSV exports join the tags, technologies and contributors of a project with "; " and prefix cells starting with =, +, - or @ with a quote.
+// @Tags admin
+// @Produce text/csv
+// @Produce json
+// @Produce application/x-ndjson
+// @Security BearerAuth
+// @Param format query string false "csv, json or ndjson, csv by default"
+// @Param creator query string false "Username of the creator"
+// @Param status query string false "Status"
+// @Param visibility query string false "public or private"
+// @Success 200 {array} dto.ProjectExportRow "Projects"
+// @Failure 400 {object} map[string]interface{} "Invalid format or filter"
+// @Failure 401 {object} map[string]interface{} "Not an admin"
+// @Router /admin/projects/export [get]
+func (h *projectExportHandler) ExportProjects(c *gin.Context) {
+	user, failed := h.requestHelper.GetAndValidateUsername(c, h)
+	if failed {
+		return
+	}
+	filter := dto.AdminProjectFilter{
+		Creator:    c.Query("creator"),
+		Status:     c.Query("status"),
+		Visibility: c.Query("visibility"),
+	}
+	h.export(c, "projects", func(each func(row dto.ProjectExportRow) error) error {
+		return h.exportService.ExportProjects(user, filter, each)
+	})
+}
+
+// ExportMyProjects godoc
+// @Summary Export my projects
+// @Description Streams the projects created by the user. CSV exports join the tags, technologies and contributors of a project with "; " and prefix cells starting with =, +, - or @ with a quote.
+// @Tags projects
+// @Produce text/csv
+// @Produce json
+// @Produce application/x-ndjson
+// @Security BearerAuth
+// @Param format query string false "csv, json or ndjson, csv by default"
+// @Param status query string false "Status"
+// @Param visibility query string false "public or private"
+// @Success 200 {array} dto.ProjectExportRow "Projects"
+// @Failure 400 {object} map[string]interface{} "Invalid format or filter"
+// @Router /projects/export [get]
+func (h *projectExportHandler) ExportMyProjects(c *gin.Context) {
+	user, failed := h.requestHelper.GetAndValidateUsername(c, h)
+	if failed {
+		return
+	}
+	filter := dto.AdminProjectFilter{
+		Status:     c.Query("status"),
+		Visibility: c.Query("visibility"),
+	}
+	h.export(c, "my-projects", func(each func(row dto.ProjectExportRow) error) error {
+		return h.exportService.ExportMyProjects(user, filter, each)
+	})
+}
+
+// export writes the rows given by run as they come.
+//
+// The headers are only sent with the first row, so errors returned before it,
+// like a failed admin check, still get an error response. Once rows are sent
+// an error can only cut the export short.
+func (h *projectExportHandler) export(c *gin.Context, name string, run func(each func(row dto.ProjectExportRow) error) error) {
+	format := c.DefaultQuery("format", exportFormatCSV)
+	writer, contentType, ok := newExportWriter(format, c.Writer)
+	if !ok {
+		respondWithError(c, h.responseHelper, "Failed to export projects", projectUtils.ErrInvalidExportFormat)
+		return
+	}
+	started := false
+	start := func() {
+		started = true
+		c.Header("Content-Type", contentType)
+		c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s.%s"`, name, format))
+		c.Status(http.StatusOK)
+	}
+	err := run(func(row dto.ProjectExportRow) error {
+		if !started {
+			start()
+		}
+		if err := writer.WriteRow(row); err != nil {
+			return err
+		}
+		c.Writer.Flush()
+		return nil
+	})
+	if err != nil {
+		if !started {
+			respondWithError(c, h.responseHelper, "Failed to export projects", err)
+			return
+		}
+		log.Printf("Error exporting projects: %v", err)
+		c.Abort()
+		return
+	}
+	if !started {
+		start()
+	}
+	if err := writer.Close(); err != nil {
+		log.Printf("Error finishing project export: %v", err)
+	}
+}
+
+// exportWriter encodes the rows of an export in one format.
+type exportWriter interface {
+	WriteRow(row dto.ProjectExportRow) error
+	// Close ends the export, it is called after the last row.
+	Close() error
+}
+
+func newExportWriter(format string, w io.Writer) (exportWriter, string, bool) {
+	switch format {
+	case exportFormatCSV:
+		return &csvExportWriter{w: csv.NewWriter(w)}, "text/csv; charset=utf-8", true
+	case exportFormatJSON:
+		return &jsonExportWriter{w: w}, "application/json; charset=utf-8", true
+	case exportFormatNDJSON:
+		return &ndjsonExportWriter{w: json.NewEncoder(w)}, "application/x-ndjson", true
+	}
+	return nil, "", false
+}
+
+type csvExportWriter struct {
+	w           *csv.Writer
+	wroteHeader bool
+}
+
+func (e *csvExportWriter) WriteRow(row dto.ProjectExportRow) error {
+	if err := e.writeHeader(); err != nil {
+		return err
+	}
+	record := exportRecord(row)
+	for i, cell := range record {
+		record[i] = escapeFormula(cell)
+	}
+	if err := e.w.Write(record); err != nil {
+		return err
+	}
+	e.w.Flush()
+	return e.w.Error()
+}
+
+func (e *csvExportWriter) Close() error {
+	if err := e.writeHeader(); err != nil {
+		return err
+	}
+	e.w.Flush()
+	return e.w.Error()
+}
+
+func (e *csvExportWriter) writeHeader() error {
+	if e.wroteHeader {
+		return nil
+	}
+	e.wroteHeader = true
+	return e.w.Write(exportColumns)
+}
+
+// escapeFormula keeps spreadsheets from running a cell as a formula by prefixing
+// cells that start like one with a quote. Only CSV exports are opened that way.
+func escapeFormula(cell string) string {
+	if cell != "" && strings.ContainsRune("=+-@", rune(cell[0])) {
+		return "'" + cell
+	}
+	return cell
+}
+
+// exportRecord flattens a row into the columns of exportColumns.
+func exportRecord(row dto.ProjectExportRow) []string {
+	forkedFrom := ""
+	if row.ForkedFromID != nil {
+		forkedFrom = strconv.FormatUint(uint64(*row.ForkedFromID), 10)
+	}
+	return []string{
+		strconv.FormatUint(uint64(row.ID), 10),
+		row.Title,
+		row.Description,
+		row.Category,
+		row.Status,
+		row.Visibility,
+		row.Version,
+		strconv.Itoa(row.Cost),
+		strconv.Itoa(row.Likes),
+		strconv.Itoa(row.Views),
+		row.GithubLink,
+		row.LiveURL,
+		row.Creator,
+		strings.Join(row.Tags, exportListSeparator),
+		strings.Join(row.Technologies, exportListSeparator),
+		strings.Join(row.Contributors, exportListSeparator),
+		forkedFrom,
+		row.CreatedAt.Format(time.RFC3339),
+		row.UpdatedAt.Format(time.RFC3339),
+	}
+}
+
+// jsonExportWriter writes one JSON array, element by element.
+type jsonExportWriter struct {
+	w     io.Writer
+	count int
+}
+
+func (e *jsonExportWriter) WriteRow(row dto.ProjectExportRow) error {
+	data, err := json.Marshal(row)
+	if err != nil {
+		return err
+	}
+	separator := ","
+	if e.count == 0 {
+		separator = "["
+	}
+	e.count++
+	if _, err := io.WriteString(e.w, separator); err != nil {
+		return err
+	}
+	_, err = e.w.Write(data)
+	return err
+}
+
+func (e *jsonExportWriter) Close() error {
+	end := "]"
+	if e.count == 0 {
+		end = "[]"
+	}
+	_, err := io.WriteString(e.w, end)
+	return err
+}
+
+// ndjsonExportWriter writes one JSON object per line.
+type ndjsonExportWriter struct {
+	w *json.Encoder
+}
+
+func (e *ndjsonExportWriter) WriteRow(row dto.ProjectExportRow) error {
+	return e.w.Encode(row)
+}
+
+func (e *ndjsonExportWriter) Close() error {
+	return nil
+}
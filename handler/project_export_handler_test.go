@@ -0,0 +1,68 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/csv"
+	"testing"
+	"time"
+
+	"github.com/aruncs31s/esdcprojectmodule/dto"
+)
+
+func TestEscapeFormula(t *testing.T) {
+	tests := []struct {
+		cell string
+		want string
+	}{
+		{"", ""},
+		{"Line follower", "Line follower"},
+		{"=HYPERLINK(\"http://evil\")", "'=HYPERLINK(\"http://evil\")"},
+		{"+1", "'+1"},
+		{"-2+3", "'-2+3"},
+		{"@SUM(A1)", "'@SUM(A1)"},
+		{"a=b", "a=b"},
+		{" =1", " =1"},
+	}
+	for _, tt := range tests {
+		if got := escapeFormula(tt.cell); got != tt.want {
+			t.Errorf("escapeFormula(%q) = %q, want %q", tt.cell, got, tt.want)
+		}
+	}
+}
+
+func TestCSVExportWriterEscapesFormulas(t *testing.T) {
+	var buf bytes.Buffer
+	writer, _, ok := newExportWriter(exportFormatCSV, &buf)
+	if !ok {
+		t.Fatal("no CSV writer")
+	}
+	row := dto.ProjectExportRow{
+		ID:          1,
+		Title:       "=cmd|' /C calc'!A0",
+		Description: "@risk",
+		Cost:        -5,
+		Tags:        []string{"-x", "y"},
+		CreatedAt:   time.Date(2026, time.March, 2, 0, 0, 0, 0, time.UTC),
+		UpdatedAt:   time.Date(2026, time.March, 2, 0, 0, 0, 0, time.UTC),
+	}
+	if err := writer.WriteRow(row); err != nil {
+		t.Fatal(err)
+	}
+	if err := writer.Close(); err != nil {
+		t.Fatal(err)
+	}
+	records, err := csv.NewReader(&buf).ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 2 {
+		t.Fatalf("got %d records, want the header and one row", len(records))
+	}
+	want := exportRecord(row)
+	want[1], want[2], want[7], want[13] = "'=cmd|' /C calc'!A0", "'@risk", "'-5", "'-x; y"
+	for i, cell := range records[1] {
+		if cell != want[i] {
+			t.Errorf("column %s = %q, want %q", exportColumns[i], cell, want[i])
+		}
+	}
+}
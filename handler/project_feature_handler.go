@@ -0,0 +1,141 @@
+package handler
+
+import (
+	"github.com/aruncs31s/esdcprojectmodule/dto"
+	"github.com/aruncs31s/esdcprojectmodule/interfaces/handler"
+	"github.com/aruncs31s/esdcprojectmodule/interfaces/service"
+	"github.com/aruncs31s/esdcsharedhelpersmodule/helper"
+	sharedHelper "github.com/aruncs31s/esdcsharedhelpersmodule/interface/helper"
+	"github.com/aruncs31s/esdcsharedhelpersmodule/utils"
+	"github.com/aruncs31s/responsehelper"
+	"github.com/gin-gonic/gin"
+)
+
+type projectFeatureHandler struct {
+	featureService service.ProjectFeatureService
+	requestHelper  sharedHelper.RequestHelper
+	responseHelper responsehelper.ResponseHelper
+	validator      sharedHelper.RequestValidator
+}
+
+func NewProjectFeatureHandler(featureService service.ProjectFeatureService) handler.ProjectFeatureHandler {
+	responseHelper, requestHelper, validator := getHelpers()
+	return &projectFeatureHandler{
+		featureService: featureService,
+		requestHelper:  requestHelper,
+		responseHelper: responseHelper,
+		validator:      validator,
+	}
+}
+
+func (h *projectFeatureHandler) GetValidator() sharedHelper.RequestValidator {
+	return h.validator
+}
+func (h *projectFeatureHandler) GetResponseHelper() responsehelper.ResponseHelper {
+	return h.responseHelper
+}
+
+// GetFeatured godoc
+// @Summary Featured projects
+// @Description Projects featured on the homepage right now, by position
+// @Tags projects
+// @Produce json
+// @Param page query int false "Page number"
+// @Param per-page query int false "Page size"
+// @Success 200 {array} dto.ProjectResponseForPublic "Featured projects"
+// @Router /public/projects/featured [get]
+func (h *projectFeatureHandler) GetFeatured(c *gin.Context) {
+	limit, offset := h.requestHelper.GetLimitAndOffset(c)
+	projects, err := h.featureService.GetFeatured(limit, offset)
+	if err != nil {
+		respondWithError(c, h.responseHelper, "Failed to retrieve featured projects", err)
+		return
+	}
+	h.responseHelper.Success(c, projects)
+}
+
+// GetFeatures godoc
+// @Summary Every featured project
+// @Description Including the features that have not started or have ended, by position
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Param page query int false "Page number"
+// @Param per-page query int false "Page size"
+// @Success 200 {array} dto.ProjectFeature "Features"
+// @Failure 401 {object} map[string]interface{} "Not an admin"
+// @Router /admin/projects/featured [get]
+func (h *projectFeatureHandler) GetFeatures(c *gin.Context) {
+	user, failed := h.requestHelper.GetAndValidateUsername(c, h)
+	if failed {
+		return
+	}
+	limit, offset := h.requestHelper.GetLimitAndOffset(c)
+	features, err := h.featureService.GetFeatures(user, limit, offset)
+	if err != nil {
+		respondWithError(c, h.responseHelper, "Failed to retrieve features", err)
+		return
+	}
+	h.responseHelper.Success(c, features)
+}
+
+// FeatureProject godoc
+// @Summary Feature a project
+// @Description Puts the project on the homepage for the date range, featuring it again replaces the range and position
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Project ID"
+// @Param body body dto.ProjectFeatureRequest true "Position and date range"
+// @Success 200 {object} dto.ProjectFeature "Feature"
+// @Failure 400 {object} map[string]interface{} "Invalid date range"
+// @Failure 401 {object} map[string]interface{} "Not an admin"
+// @Failure 404 {object} map[string]interface{} "Project not found"
+// @Router /admin/projects/{id}/feature [put]
+func (h *projectFeatureHandler) FeatureProject(c *gin.Context) {
+	user, failed := h.requestHelper.GetAndValidateUsername(c, h)
+	if failed {
+		return
+	}
+	projectID, failed := h.requestHelper.ValidateAndParseID(h, "id", c, utils.FixInvalidID)
+	if failed {
+		return
+	}
+	request, failed := helper.GetJSONDataFromRequest[dto.ProjectFeatureRequest](c, h.responseHelper)
+	if failed {
+		return
+	}
+	feature, err := h.featureService.FeatureProject(user, projectID, request)
+	if err != nil {
+		respondWithError(c, h.responseHelper, "Failed to feature project", err)
+		return
+	}
+	h.responseHelper.Success(c, feature)
+}
+
+// UnfeatureProject godoc
+// @Summary Take a project off the homepage
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Project ID"
+// @Success 200 {object} map[string]interface{} "Feature deleted"
+// @Failure 401 {object} map[string]interface{} "Not an admin"
+// @Failure 404 {object} map[string]interface{} "Project not featured"
+// @Router /admin/projects/{id}/feature [delete]
+func (h *projectFeatureHandler) UnfeatureProject(c *gin.Context) {
+	user, failed := h.requestHelper.GetAndValidateUsername(c, h)
+	if failed {
+		return
+	}
+	projectID, failed := h.requestHelper.ValidateAndParseID(h, "id", c, utils.FixInvalidID)
+	if failed {
+		return
+	}
+	if err := h.featureService.UnfeatureProject(user, projectID); err != nil {
+		respondWithError(c, h.responseHelper, "Failed to unfeature project", err)
+		return
+	}
+	h.responseHelper.Deleted(c, "Feature")
+}
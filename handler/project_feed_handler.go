@@ -0,0 +1,59 @@
+package handler
+
+import (
+	"github.com/aruncs31s/esdcprojectmodule/interfaces/handler"
+	"github.com/aruncs31s/esdcprojectmodule/interfaces/service"
+	sharedHelper "github.com/aruncs31s/esdcsharedhelpersmodule/interface/helper"
+	"github.com/aruncs31s/responsehelper"
+	"github.com/gin-gonic/gin"
+)
+
+type projectFeedHandler struct {
+	feedService    service.ProjectFeedService
+	requestHelper  sharedHelper.RequestHelper
+	responseHelper responsehelper.ResponseHelper
+	validator      sharedHelper.RequestValidator
+}
+
+func NewProjectFeedHandler(feedService service.ProjectFeedService) handler.ProjectFeedHandler {
+	responseHelper, requestHelper, validator := getHelpers()
+	return &projectFeedHandler{
+		feedService:    feedService,
+		requestHelper:  requestHelper,
+		responseHelper: responseHelper,
+		validator:      validator,
+	}
+}
+
+func (h *projectFeedHandler) GetValidator() sharedHelper.RequestValidator {
+	return h.validator
+}
+func (h *projectFeedHandler) GetResponseHelper() responsehelper.ResponseHelper {
+	return h.responseHelper
+}
+
+// GetFeed godoc
+// @Summary Personalized project feed
+// @Description Public projects ranked for the authenticated user
+// @Tags projects
+// @Produce json
+// @Security BearerAuth
+// @Param page query int false "Page number"
+// @Param per-page query int false "Page size"
+// @Success 200 {object} map[string]interface{} "Ranked projects"
+// @Failure 400 {object} map[string]interface{} "Invalid username"
+// @Failure 500 {object} map[string]interface{} "Failed to build the feed"
+// @Router /projects/feed [get]
+func (h *projectFeedHandler) GetFeed(c *gin.Context) {
+	user, failed := h.requestHelper.GetAndValidateUsername(c, h)
+	if failed {
+		return
+	}
+	limit, offset := h.requestHelper.GetLimitAndOffset(c)
+	projects, err := h.feedService.GetFeed(user, limit, offset)
+	if err != nil {
+		h.responseHelper.InternalError(c, "Failed to retrieve feed", err)
+		return
+	}
+	h.responseHelper.Success(c, projects)
+}
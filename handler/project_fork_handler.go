@@ -0,0 +1,86 @@
+package handler
+
+import (
+	"github.com/aruncs31s/esdcprojectmodule/interfaces/handler"
+	"github.com/aruncs31s/esdcprojectmodule/interfaces/service"
+	sharedHelper "github.com/aruncs31s/esdcsharedhelpersmodule/interface/helper"
+	"github.com/aruncs31s/esdcsharedhelpersmodule/utils"
+	"github.com/aruncs31s/responsehelper"
+	"github.com/gin-gonic/gin"
+)
+
+type projectForkHandler struct {
+	forkService    service.ProjectForkService
+	requestHelper  sharedHelper.RequestHelper
+	responseHelper responsehelper.ResponseHelper
+	validator      sharedHelper.RequestValidator
+}
+
+func NewProjectForkHandler(forkService service.ProjectForkService) handler.ProjectForkHandler {
+	responseHelper, requestHelper, validator := getHelpers()
+	return &projectForkHandler{
+		forkService:    forkService,
+		requestHelper:  requestHelper,
+		responseHelper: responseHelper,
+		validator:      validator,
+	}
+}
+
+func (h *projectForkHandler) GetValidator() sharedHelper.RequestValidator {
+	return h.validator
+}
+func (h *projectForkHandler) GetResponseHelper() responsehelper.ResponseHelper {
+	return h.responseHelper
+}
+
+// ForkProject godoc
+// @Summary Fork a public project
+// @Tags projects
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Project ID"
+// @Success 201 {object} map[string]interface{} "Forked project"
+// @Failure 404 {object} map[string]interface{} "Project not found"
+// @Router /projects/{id}/fork [post]
+func (h *projectForkHandler) ForkProject(c *gin.Context) {
+	user, failed := h.requestHelper.GetAndValidateUsername(c, h)
+	if failed {
+		return
+	}
+	projectID, failed := h.requestHelper.ValidateAndParseID(h, "id", c, utils.FixInvalidID)
+	if failed {
+		return
+	}
+	project, err := h.forkService.ForkProject(user, projectID)
+	if err != nil {
+		respondWithError(c, h.responseHelper, "Failed to fork project", err)
+		return
+	}
+	// The fork is the project the request created, the source is in its forked_from.
+	setAuditProject(c, project.ID)
+	h.responseHelper.Created(c, project)
+}
+
+// GetForks godoc
+// @Summary List the forks of a project
+// @Tags public
+// @Produce json
+// @Param id path int true "Project ID"
+// @Param page query int false "Page number"
+// @Param per-page query int false "Page size"
+// @Success 200 {object} map[string]interface{} "Forks"
+// @Failure 404 {object} map[string]interface{} "Project not found"
+// @Router /public/projects/{id}/forks [get]
+func (h *projectForkHandler) GetForks(c *gin.Context) {
+	projectID, failed := h.requestHelper.ValidateAndParseID(h, "id", c, utils.FixInvalidID)
+	if failed {
+		return
+	}
+	limit, offset := h.requestHelper.GetLimitAndOffset(c)
+	forks, err := h.forkService.GetForks(projectID, limit, offset)
+	if err != nil {
+		respondWithError(c, h.responseHelper, "Failed to retrieve forks", err)
+		return
+	}
+	h.responseHelper.Success(c, forks)
+}
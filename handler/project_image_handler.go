@@ -0,0 +1,102 @@
+package handler
+
+import (
+	"github.com/aruncs31s/esdcprojectmodule/interfaces/handler"
+	"github.com/aruncs31s/esdcprojectmodule/interfaces/service"
+	projectService "github.com/aruncs31s/esdcprojectmodule/service"
+	projectUtils "github.com/aruncs31s/esdcprojectmodule/utils"
+	sharedHelper "github.com/aruncs31s/esdcsharedhelpersmodule/interface/helper"
+	"github.com/aruncs31s/esdcsharedhelpersmodule/utils"
+	"github.com/aruncs31s/responsehelper"
+	"github.com/gin-gonic/gin"
+)
+
+type projectImageHandler struct {
+	imageService   service.ProjectImageService
+	requestHelper  sharedHelper.RequestHelper
+	responseHelper responsehelper.ResponseHelper
+	validator      sharedHelper.RequestValidator
+}
+
+func NewProjectImageHandler(imageService service.ProjectImageService) handler.ProjectImageHandler {
+	responseHelper, requestHelper, validator := getHelpers()
+	return &projectImageHandler{
+		imageService:   imageService,
+		requestHelper:  requestHelper,
+		responseHelper: responseHelper,
+		validator:      validator,
+	}
+}
+
+func (h *projectImageHandler) GetValidator() sharedHelper.RequestValidator {
+	return h.validator
+}
+func (h *projectImageHandler) GetResponseHelper() responsehelper.ResponseHelper {
+	return h.responseHelper
+}
+
+// UploadImage godoc
+// @Summary Upload the cover image of a project
+// @Description Accepts jpeg, png, gif and webp up to 5 MB, thumbnails and webp variants are generated
+// @Tags projects
+// @Accept multipart/form-data
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Project ID"
+// @Param image formData file true "Cover image"
+// @Success 201 {object} map[string]interface{} "URLs of every size"
+// @Failure 400 {object} map[string]interface{} "Unsupported or too large image"
+// @Router /projects/{id}/image [post]
+func (h *projectImageHandler) UploadImage(c *gin.Context) {
+	user, failed := h.requestHelper.GetAndValidateUsername(c, h)
+	if failed {
+		return
+	}
+	projectID, failed := h.requestHelper.ValidateAndParseID(h, "id", c, utils.FixInvalidID)
+	if failed {
+		return
+	}
+	fileHeader, err := c.FormFile("image")
+	if err != nil {
+		h.responseHelper.BadRequest(c, err.Error(), "Please upload the image in the image field.")
+		return
+	}
+	if fileHeader.Size > projectService.MaxImageBytes {
+		respondWithError(c, h.responseHelper, "Failed to upload image", projectUtils.ErrImageTooLarge)
+		return
+	}
+	file, err := fileHeader.Open()
+	if err != nil {
+		h.responseHelper.InternalError(c, "Failed to read image", err)
+		return
+	}
+	defer file.Close()
+	image, err := h.imageService.UploadImage(user, projectID, file, fileHeader.Header.Get("Content-Type"))
+	if err != nil {
+		respondWithError(c, h.responseHelper, "Failed to upload image", err)
+		return
+	}
+	h.responseHelper.Created(c, image)
+}
+
+// GetImage godoc
+// @Summary URLs of the cover image of a project
+// @Tags projects
+// @Produce json
+// @Param id path int true "Project ID"
+// @Success 200 {object} map[string]interface{} "URLs of every size"
+// @Failure 404 {object} map[string]interface{} "No image"
+// @Router /projects/{id}/image [get]
+func (h *projectImageHandler) GetImage(c *gin.Context) {
+	projectID, failed := h.requestHelper.ValidateAndParseID(h, "id", c, utils.FixInvalidID)
+	if failed {
+		return
+	}
+	// Anonymous on the public route, so only listed projects are returned there.
+	image, err := h.imageService.GetImage(c.GetString("username"), projectID)
+	if err != nil {
+		respondWithError(c, h.responseHelper, "Failed to retrieve image", err)
+		return
+	}
+	h.responseHelper.Success(c, image)
+}
@@ -0,0 +1,65 @@
+package handler
+
+import (
+	"github.com/aruncs31s/esdcprojectmodule/dto"
+	"github.com/aruncs31s/esdcprojectmodule/interfaces/handler"
+	"github.com/aruncs31s/esdcprojectmodule/interfaces/service"
+	"github.com/aruncs31s/esdcsharedhelpersmodule/helper"
+	sharedHelper "github.com/aruncs31s/esdcsharedhelpersmodule/interface/helper"
+	"github.com/aruncs31s/responsehelper"
+	"github.com/gin-gonic/gin"
+)
+
+type projectImportHandler struct {
+	importService  service.ProjectImportService
+	requestHelper  sharedHelper.RequestHelper
+	responseHelper responsehelper.ResponseHelper
+	validator      sharedHelper.RequestValidator
+}
+
+func NewProjectImportHandler(importService service.ProjectImportService) handler.ProjectImportHandler {
+	responseHelper, requestHelper, validator := getHelpers()
+	return &projectImportHandler{
+		importService:  importService,
+		requestHelper:  requestHelper,
+		responseHelper: responseHelper,
+		validator:      validator,
+	}
+}
+
+func (h *projectImportHandler) GetValidator() sharedHelper.RequestValidator {
+	return h.validator
+}
+func (h *projectImportHandler) GetResponseHelper() responsehelper.ResponseHelper {
+	return h.responseHelper
+}
+
+// ImportProject godoc
+// @Summary Import a project from a GitHub repository
+// @Description Creates a draft filled from the name, README or description, homepage, topics and languages of the repository, review it and publish it
+// @Tags projects
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param body body dto.ProjectImport true "Repository URL"
+// @Success 201 {object} dto.ProjectResponse "Draft project"
+// @Failure 400 {object} map[string]interface{} "Unsupported URL"
+// @Failure 404 {object} map[string]interface{} "Repository not found"
+// @Router /projects/import [post]
+func (h *projectImportHandler) ImportProject(c *gin.Context) {
+	user, failed := h.requestHelper.GetAndValidateUsername(c, h)
+	if failed {
+		return
+	}
+	request, failed := helper.GetJSONDataFromRequest[dto.ProjectImport](c, h.responseHelper)
+	if failed {
+		return
+	}
+	project, err := h.importService.Import(user, request.URL)
+	if err != nil {
+		respondWithError(c, h.responseHelper, "Failed to import project", err)
+		return
+	}
+	setAuditProject(c, project.ID)
+	h.responseHelper.Created(c, project)
+}
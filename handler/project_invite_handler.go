@@ -0,0 +1,193 @@
+package handler
+
+import (
+	"github.com/aruncs31s/esdcprojectmodule/dto"
+	"github.com/aruncs31s/esdcprojectmodule/interfaces/handler"
+	"github.com/aruncs31s/esdcprojectmodule/interfaces/service"
+	"github.com/aruncs31s/esdcsharedhelpersmodule/helper"
+	sharedHelper "github.com/aruncs31s/esdcsharedhelpersmodule/interface/helper"
+	"github.com/aruncs31s/esdcsharedhelpersmodule/utils"
+	"github.com/aruncs31s/responsehelper"
+	"github.com/gin-gonic/gin"
+)
+
+type projectInviteHandler struct {
+	inviteService  service.ProjectInviteService
+	requestHelper  sharedHelper.RequestHelper
+	responseHelper responsehelper.ResponseHelper
+	validator      sharedHelper.RequestValidator
+}
+
+func NewProjectInviteHandler(inviteService service.ProjectInviteService) handler.ProjectInviteHandler {
+	responseHelper, requestHelper, validator := getHelpers()
+	return &projectInviteHandler{
+		inviteService:  inviteService,
+		requestHelper:  requestHelper,
+		responseHelper: responseHelper,
+		validator:      validator,
+	}
+}
+
+func (h *projectInviteHandler) GetValidator() sharedHelper.RequestValidator {
+	return h.validator
+}
+func (h *projectInviteHandler) GetResponseHelper() responsehelper.ResponseHelper {
+	return h.responseHelper
+}
+
+// InviteContributors godoc
+// @Summary Invite contributors to a project
+// @Tags projects
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Project ID"
+// @Param body body dto.InviteContributors true "Usernames to invite"
+// @Success 201 {object} map[string]interface{} "Created invites"
+// @Router /projects/{id}/invites [post]
+func (h *projectInviteHandler) InviteContributors(c *gin.Context) {
+	user, failed := h.requestHelper.GetAndValidateUsername(c, h)
+	if failed {
+		return
+	}
+	projectID, failed := h.requestHelper.ValidateAndParseID(h, "id", c, utils.FixInvalidID)
+	if failed {
+		return
+	}
+	request, failed := helper.GetJSONDataFromRequest[dto.InviteContributors](c, h.responseHelper)
+	if failed {
+		return
+	}
+	result, err := h.inviteService.InviteContributors(user, projectID, request.Usernames, request.Role)
+	if err != nil {
+		respondWithError(c, h.responseHelper, "Failed to invite contributors", err)
+		return
+	}
+	h.responseHelper.Created(c, result)
+}
+
+// GetProjectInvites godoc
+// @Summary List the invites of a project
+// @Tags projects
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Project ID"
+// @Success 200 {object} map[string]interface{} "Invites"
+// @Router /projects/{id}/invites [get]
+func (h *projectInviteHandler) GetProjectInvites(c *gin.Context) {
+	user, failed := h.requestHelper.GetAndValidateUsername(c, h)
+	if failed {
+		return
+	}
+	projectID, failed := h.requestHelper.ValidateAndParseID(h, "id", c, utils.FixInvalidID)
+	if failed {
+		return
+	}
+	invites, err := h.inviteService.GetProjectInvites(user, projectID)
+	if err != nil {
+		respondWithError(c, h.responseHelper, "Failed to retrieve invites", err)
+		return
+	}
+	h.responseHelper.Success(c, invites)
+}
+
+// RevokeInvite godoc
+// @Summary Revoke a pending invite
+// @Tags projects
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Project ID"
+// @Param inviteId path int true "Invite ID"
+// @Success 200 {object} map[string]interface{} "Invite revoked"
+// @Router /projects/{id}/invites/{inviteId} [delete]
+func (h *projectInviteHandler) RevokeInvite(c *gin.Context) {
+	user, failed := h.requestHelper.GetAndValidateUsername(c, h)
+	if failed {
+		return
+	}
+	projectID, failed := h.requestHelper.ValidateAndParseID(h, "id", c, utils.FixInvalidID)
+	if failed {
+		return
+	}
+	inviteID, failed := h.requestHelper.ValidateAndParseID(h, "inviteId", c, utils.FixInvalidID)
+	if failed {
+		return
+	}
+	if err := h.inviteService.RevokeInvite(user, projectID, inviteID); err != nil {
+		respondWithError(c, h.responseHelper, "Failed to revoke invite", err)
+		return
+	}
+	h.responseHelper.Deleted(c, "Invite")
+}
+
+// GetMyInvites godoc
+// @Summary List my pending invites
+// @Tags projects
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} map[string]interface{} "Pending invites"
+// @Router /projects/invites [get]
+func (h *projectInviteHandler) GetMyInvites(c *gin.Context) {
+	user, failed := h.requestHelper.GetAndValidateUsername(c, h)
+	if failed {
+		return
+	}
+	invites, err := h.inviteService.GetMyInvites(user)
+	if err != nil {
+		respondWithError(c, h.responseHelper, "Failed to retrieve invites", err)
+		return
+	}
+	h.responseHelper.Success(c, invites)
+}
+
+// AcceptInvite godoc
+// @Summary Accept an invite
+// @Tags projects
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Invite ID"
+// @Success 200 {object} map[string]interface{} "Invite accepted"
+// @Router /projects/invites/{id}/accept [post]
+func (h *projectInviteHandler) AcceptInvite(c *gin.Context) {
+	user, failed := h.requestHelper.GetAndValidateUsername(c, h)
+	if failed {
+		return
+	}
+	inviteID, failed := h.requestHelper.ValidateAndParseID(h, "id", c, utils.FixInvalidID)
+	if failed {
+		return
+	}
+	if err := h.inviteService.AcceptInvite(user, inviteID); err != nil {
+		respondWithError(c, h.responseHelper, "Failed to accept invite", err)
+		return
+	}
+	h.responseHelper.Success(c, map[string]interface{}{
+		"message": "Invite accepted successfully",
+	})
+}
+
+// DeclineInvite godoc
+// @Summary Decline an invite
+// @Tags projects
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Invite ID"
+// @Success 200 {object} map[string]interface{} "Invite declined"
+// @Router /projects/invites/{id}/decline [post]
+func (h *projectInviteHandler) DeclineInvite(c *gin.Context) {
+	user, failed := h.requestHelper.GetAndValidateUsername(c, h)
+	if failed {
+		return
+	}
+	inviteID, failed := h.requestHelper.ValidateAndParseID(h, "id", c, utils.FixInvalidID)
+	if failed {
+		return
+	}
+	if err := h.inviteService.DeclineInvite(user, inviteID); err != nil {
+		respondWithError(c, h.responseHelper, "Failed to decline invite", err)
+		return
+	}
+	h.responseHelper.Success(c, map[string]interface{}{
+		"message": "Invite declined successfully",
+	})
+}
@@ -0,0 +1,109 @@
+package handler
+
+import (
+	"github.com/aruncs31s/esdcprojectmodule/interfaces/handler"
+	"github.com/aruncs31s/esdcprojectmodule/interfaces/service"
+	sharedHelper "github.com/aruncs31s/esdcsharedhelpersmodule/interface/helper"
+	"github.com/aruncs31s/esdcsharedhelpersmodule/utils"
+	"github.com/aruncs31s/responsehelper"
+	"github.com/gin-gonic/gin"
+)
+
+type projectLinkHandler struct {
+	linkService    service.ProjectLinkService
+	requestHelper  sharedHelper.RequestHelper
+	responseHelper responsehelper.ResponseHelper
+	validator      sharedHelper.RequestValidator
+}
+
+func NewProjectLinkHandler(linkService service.ProjectLinkService) handler.ProjectLinkHandler {
+	responseHelper, requestHelper, validator := getHelpers()
+	return &projectLinkHandler{
+		linkService:    linkService,
+		requestHelper:  requestHelper,
+		responseHelper: responseHelper,
+		validator:      validator,
+	}
+}
+
+func (h *projectLinkHandler) GetValidator() sharedHelper.RequestValidator {
+	return h.validator
+}
+func (h *projectLinkHandler) GetResponseHelper() responsehelper.ResponseHelper {
+	return h.responseHelper
+}
+
+// GetLinks godoc
+// @Summary Link health of a project
+// @Description Status, latency and time of the last check of the github link and live url
+// @Tags projects
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Project ID"
+// @Success 200 {array} dto.LinkHealth "Checks"
+// @Failure 401 {object} map[string]interface{} "Not allowed"
+// @Router /projects/{id}/links [get]
+func (h *projectLinkHandler) GetLinks(c *gin.Context) {
+	user, failed := h.requestHelper.GetAndValidateUsername(c, h)
+	if failed {
+		return
+	}
+	projectID, failed := h.requestHelper.ValidateAndParseID(h, "id", c, utils.FixInvalidID)
+	if failed {
+		return
+	}
+	links, err := h.linkService.GetLinks(user, projectID)
+	if err != nil {
+		respondWithError(c, h.responseHelper, "Failed to retrieve links", err)
+		return
+	}
+	h.responseHelper.Success(c, links)
+}
+
+// GetBrokenLinks godoc
+// @Summary Own projects with broken links
+// @Tags projects
+// @Produce json
+// @Security BearerAuth
+// @Param page query int false "Page number"
+// @Param per-page query int false "Page size"
+// @Success 200 {array} dto.ProjectBrokenLinks "Projects with broken links"
+// @Router /projects/links/broken [get]
+func (h *projectLinkHandler) GetBrokenLinks(c *gin.Context) {
+	user, failed := h.requestHelper.GetAndValidateUsername(c, h)
+	if failed {
+		return
+	}
+	limit, offset := h.requestHelper.GetLimitAndOffset(c)
+	projects, err := h.linkService.GetBrokenLinks(user, limit, offset)
+	if err != nil {
+		respondWithError(c, h.responseHelper, "Failed to retrieve broken links", err)
+		return
+	}
+	h.responseHelper.Success(c, projects)
+}
+
+// GetReport godoc
+// @Summary Broken link report
+// @Description Counts of checked and broken links and the broken links of every project, longest failing first
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Param page query int false "Page number"
+// @Param per-page query int false "Page size"
+// @Success 200 {object} dto.LinkReport "Report"
+// @Failure 401 {object} map[string]interface{} "Not an admin"
+// @Router /admin/projects/links [get]
+func (h *projectLinkHandler) GetReport(c *gin.Context) {
+	user, failed := h.requestHelper.GetAndValidateUsername(c, h)
+	if failed {
+		return
+	}
+	limit, offset := h.requestHelper.GetLimitAndOffset(c)
+	report, err := h.linkService.GetReport(user, limit, offset)
+	if err != nil {
+		respondWithError(c, h.responseHelper, "Failed to build link report", err)
+		return
+	}
+	h.responseHelper.Success(c, report)
+}
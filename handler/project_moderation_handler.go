@@ -0,0 +1,157 @@
+package handler
+
+import (
+	"github.com/aruncs31s/esdcprojectmodule/dto"
+	"github.com/aruncs31s/esdcprojectmodule/interfaces/handler"
+	"github.com/aruncs31s/esdcprojectmodule/interfaces/service"
+	"github.com/aruncs31s/esdcsharedhelpersmodule/helper"
+	sharedHelper "github.com/aruncs31s/esdcsharedhelpersmodule/interface/helper"
+	"github.com/aruncs31s/esdcsharedhelpersmodule/utils"
+	"github.com/aruncs31s/responsehelper"
+	"github.com/gin-gonic/gin"
+)
+
+type projectModerationHandler struct {
+	moderationService service.ProjectModerationService
+	requestHelper     sharedHelper.RequestHelper
+	responseHelper    responsehelper.ResponseHelper
+	validator         sharedHelper.RequestValidator
+}
+
+func NewProjectModerationHandler(moderationService service.ProjectModerationService) handler.ProjectModerationHandler {
+	responseHelper, requestHelper, validator := getHelpers()
+	return &projectModerationHandler{
+		moderationService: moderationService,
+		requestHelper:     requestHelper,
+		responseHelper:    responseHelper,
+		validator:         validator,
+	}
+}
+
+func (h *projectModerationHandler) GetValidator() sharedHelper.RequestValidator {
+	return h.validator
+}
+func (h *projectModerationHandler) GetResponseHelper() responsehelper.ResponseHelper {
+	return h.responseHelper
+}
+
+// ReportProject godoc
+// @Summary Report a project
+// @Description Reports a listed project to the moderators, it is hidden automatically after enough distinct reports
+// @Tags projects
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Project ID"
+// @Param body body dto.ProjectReportRequest true "Reason and details"
+// @Success 201 {object} dto.ProjectReport "Report"
+// @Failure 400 {object} map[string]interface{} "Invalid reason or already reported"
+// @Failure 404 {object} map[string]interface{} "Project not found"
+// @Router /projects/{id}/report [post]
+func (h *projectModerationHandler) ReportProject(c *gin.Context) {
+	user, failed := h.requestHelper.GetAndValidateUsername(c, h)
+	if failed {
+		return
+	}
+	projectID, failed := h.requestHelper.ValidateAndParseID(h, "id", c, utils.FixInvalidID)
+	if failed {
+		return
+	}
+	request, failed := helper.GetJSONDataFromRequest[dto.ProjectReportRequest](c, h.responseHelper)
+	if failed {
+		return
+	}
+	report, err := h.moderationService.ReportProject(user, projectID, request)
+	if err != nil {
+		respondWithError(c, h.responseHelper, "Failed to report project", err)
+		return
+	}
+	h.responseHelper.Created(c, report)
+}
+
+// GetQueue godoc
+// @Summary Moderation queue
+// @Description Projects with open reports, most reported first
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Param page query int false "Page number"
+// @Param per-page query int false "Page size"
+// @Success 200 {array} dto.ModerationQueueEntry "Reported projects"
+// @Failure 401 {object} map[string]interface{} "Not an admin"
+// @Router /admin/projects/moderation [get]
+func (h *projectModerationHandler) GetQueue(c *gin.Context) {
+	user, failed := h.requestHelper.GetAndValidateUsername(c, h)
+	if failed {
+		return
+	}
+	limit, offset := h.requestHelper.GetLimitAndOffset(c)
+	queue, err := h.moderationService.GetQueue(user, limit, offset)
+	if err != nil {
+		respondWithError(c, h.responseHelper, "Failed to retrieve moderation queue", err)
+		return
+	}
+	h.responseHelper.Success(c, queue)
+}
+
+// GetHistory godoc
+// @Summary Moderation history of a project
+// @Description Every report and decision on the project, newest first
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Project ID"
+// @Success 200 {object} dto.ProjectModerationHistory "Reports and decisions"
+// @Failure 401 {object} map[string]interface{} "Not an admin"
+// @Router /admin/projects/{id}/moderation [get]
+func (h *projectModerationHandler) GetHistory(c *gin.Context) {
+	user, failed := h.requestHelper.GetAndValidateUsername(c, h)
+	if failed {
+		return
+	}
+	projectID, failed := h.requestHelper.ValidateAndParseID(h, "id", c, utils.FixInvalidID)
+	if failed {
+		return
+	}
+	history, err := h.moderationService.GetHistory(user, projectID)
+	if err != nil {
+		respondWithError(c, h.responseHelper, "Failed to retrieve moderation history", err)
+		return
+	}
+	h.responseHelper.Success(c, history)
+}
+
+// Decide godoc
+// @Summary Decide on the reports of a project
+// @Description Hides, warns about, removes or dismisses the reports of the project, the owner is notified
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Project ID"
+// @Param body body dto.ModerationDecisionRequest true "Action and note"
+// @Success 201 {object} dto.ModerationDecision "Decision"
+// @Failure 400 {object} map[string]interface{} "Invalid action"
+// @Failure 401 {object} map[string]interface{} "Not an admin"
+// @Failure 404 {object} map[string]interface{} "Project not found"
+// @Router /admin/projects/{id}/moderation [post]
+func (h *projectModerationHandler) Decide(c *gin.Context) {
+	user, failed := h.requestHelper.GetAndValidateUsername(c, h)
+	if failed {
+		return
+	}
+	projectID, failed := h.requestHelper.ValidateAndParseID(h, "id", c, utils.FixInvalidID)
+	if failed {
+		return
+	}
+	request, failed := helper.GetJSONDataFromRequest[dto.ModerationDecisionRequest](c, h.responseHelper)
+	if failed {
+		return
+	}
+	decision, err := h.moderationService.Decide(user, projectID, request)
+	if err != nil {
+		respondWithError(c, h.responseHelper, "Failed to record decision", err)
+		return
+	}
+	h.responseHelper.Created(c, decision)
+}
@@ -0,0 +1,137 @@
+package handler
+
+import (
+	"github.com/aruncs31s/esdcprojectmodule/dto"
+	"github.com/aruncs31s/esdcprojectmodule/interfaces/handler"
+	"github.com/aruncs31s/esdcprojectmodule/interfaces/service"
+	"github.com/aruncs31s/esdcsharedhelpersmodule/helper"
+	sharedHelper "github.com/aruncs31s/esdcsharedhelpersmodule/interface/helper"
+	"github.com/aruncs31s/esdcsharedhelpersmodule/utils"
+	"github.com/aruncs31s/responsehelper"
+	"github.com/gin-gonic/gin"
+)
+
+type projectPinHandler struct {
+	pinService     service.ProjectPinService
+	requestHelper  sharedHelper.RequestHelper
+	responseHelper responsehelper.ResponseHelper
+	validator      sharedHelper.RequestValidator
+}
+
+func NewProjectPinHandler(pinService service.ProjectPinService) handler.ProjectPinHandler {
+	responseHelper, requestHelper, validator := getHelpers()
+	return &projectPinHandler{
+		pinService:     pinService,
+		requestHelper:  requestHelper,
+		responseHelper: responseHelper,
+		validator:      validator,
+	}
+}
+
+func (h *projectPinHandler) GetValidator() sharedHelper.RequestValidator {
+	return h.validator
+}
+func (h *projectPinHandler) GetResponseHelper() responsehelper.ResponseHelper {
+	return h.responseHelper
+}
+
+// GetPinned godoc
+// @Summary Own pinned projects
+// @Description Pinned projects come first in the profile list, by position
+// @Tags projects
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {array} dto.ProjectResponseForPublic "Pinned projects"
+// @Router /projects/pins [get]
+func (h *projectPinHandler) GetPinned(c *gin.Context) {
+	user, failed := h.requestHelper.GetAndValidateUsername(c, h)
+	if failed {
+		return
+	}
+	projects, err := h.pinService.GetPinned(user)
+	if err != nil {
+		respondWithError(c, h.responseHelper, "Failed to retrieve pinned projects", err)
+		return
+	}
+	h.responseHelper.Success(c, projects)
+}
+
+// PinProject godoc
+// @Summary Pin a project
+// @Description Pins one of the own projects after the other pins
+// @Tags projects
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Project ID"
+// @Success 200 {array} dto.ProjectResponseForPublic "Pinned projects"
+// @Failure 400 {object} map[string]interface{} "Too many pins"
+// @Failure 401 {object} map[string]interface{} "Not the owner"
+// @Router /projects/{id}/pin [post]
+func (h *projectPinHandler) PinProject(c *gin.Context) {
+	user, failed := h.requestHelper.GetAndValidateUsername(c, h)
+	if failed {
+		return
+	}
+	projectID, failed := h.requestHelper.ValidateAndParseID(h, "id", c, utils.FixInvalidID)
+	if failed {
+		return
+	}
+	projects, err := h.pinService.PinProject(user, projectID)
+	if err != nil {
+		respondWithError(c, h.responseHelper, "Failed to pin project", err)
+		return
+	}
+	h.responseHelper.Success(c, projects)
+}
+
+// UnpinProject godoc
+// @Summary Unpin a project
+// @Tags projects
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Project ID"
+// @Success 200 {object} map[string]interface{} "Pin deleted"
+// @Failure 404 {object} map[string]interface{} "Project not pinned"
+// @Router /projects/{id}/pin [delete]
+func (h *projectPinHandler) UnpinProject(c *gin.Context) {
+	user, failed := h.requestHelper.GetAndValidateUsername(c, h)
+	if failed {
+		return
+	}
+	projectID, failed := h.requestHelper.ValidateAndParseID(h, "id", c, utils.FixInvalidID)
+	if failed {
+		return
+	}
+	if err := h.pinService.UnpinProject(user, projectID); err != nil {
+		respondWithError(c, h.responseHelper, "Failed to unpin project", err)
+		return
+	}
+	h.responseHelper.Deleted(c, "Pin")
+}
+
+// ReorderPins godoc
+// @Summary Reorder the pinned projects
+// @Tags projects
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param body body dto.ProjectPinOrder true "Every pinned project ID in the new order"
+// @Success 200 {array} dto.ProjectResponseForPublic "Pinned projects in the new order"
+// @Failure 400 {object} map[string]interface{} "Invalid order"
+// @Router /projects/pins [put]
+func (h *projectPinHandler) ReorderPins(c *gin.Context) {
+	user, failed := h.requestHelper.GetAndValidateUsername(c, h)
+	if failed {
+		return
+	}
+	request, failed := helper.GetJSONDataFromRequest[dto.ProjectPinOrder](c, h.responseHelper)
+	if failed {
+		return
+	}
+	projects, err := h.pinService.ReorderPins(user, request.ProjectIDs)
+	if err != nil {
+		respondWithError(c, h.responseHelper, "Failed to reorder pinned projects", err)
+		return
+	}
+	h.responseHelper.Success(c, projects)
+}
@@ -0,0 +1,127 @@
+package handler
+
+import (
+	"bytes"
+	"net/http"
+	"strings"
+
+	"github.com/aruncs31s/esdcprojectmodule/imaging"
+	"github.com/aruncs31s/esdcprojectmodule/interfaces/handler"
+	"github.com/aruncs31s/esdcprojectmodule/interfaces/service"
+	projectService "github.com/aruncs31s/esdcprojectmodule/service"
+	sharedHelper "github.com/aruncs31s/esdcsharedhelpersmodule/interface/helper"
+	"github.com/aruncs31s/esdcsharedhelpersmodule/utils"
+	"github.com/aruncs31s/responsehelper"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	placeholderSVG = "svg"
+	placeholderPNG = "png"
+	// Covers follow the category, which can change, avatars only depend on the username.
+	coverCacheControl  = "public, max-age=3600"
+	avatarCacheControl = "public, max-age=86400"
+)
+
+type projectPlaceholderHandler struct {
+	placeholderService service.ProjectPlaceholderService
+	requestHelper      sharedHelper.RequestHelper
+	responseHelper     responsehelper.ResponseHelper
+	validator          sharedHelper.RequestValidator
+}
+
+func NewProjectPlaceholderHandler(placeholderService service.ProjectPlaceholderService) handler.ProjectPlaceholderHandler {
+	responseHelper, requestHelper, validator := getHelpers()
+	return &projectPlaceholderHandler{
+		placeholderService: placeholderService,
+		requestHelper:      requestHelper,
+		responseHelper:     responseHelper,
+		validator:          validator,
+	}
+}
+
+func (h *projectPlaceholderHandler) GetValidator() sharedHelper.RequestValidator {
+	return h.validator
+}
+func (h *projectPlaceholderHandler) GetResponseHelper() responsehelper.ResponseHelper {
+	return h.responseHelper
+}
+
+// GetCoverSVG godoc
+// @Summary Placeholder cover of a project as SVG
+// @Description Generated from the project ID and tinted by its category, the same project always gets the same cover
+// @Tags projects
+// @Produce image/svg+xml
+// @Param id path int true "Project ID"
+// @Success 200 {file} file "Cover"
+// @Router /public/projects/{id}/cover.svg [get]
+func (h *projectPlaceholderHandler) GetCoverSVG(c *gin.Context) {
+	h.getCover(c, placeholderSVG)
+}
+
+// GetCoverPNG godoc
+// @Summary Placeholder cover of a project as PNG
+// @Tags projects
+// @Produce image/png
+// @Param id path int true "Project ID"
+// @Success 200 {file} file "Cover"
+// @Router /public/projects/{id}/cover.png [get]
+func (h *projectPlaceholderHandler) GetCoverPNG(c *gin.Context) {
+	h.getCover(c, placeholderPNG)
+}
+
+func (h *projectPlaceholderHandler) getCover(c *gin.Context, format string) {
+	projectID, failed := h.requestHelper.ValidateAndParseID(h, "id", c, utils.FixInvalidID)
+	if failed {
+		return
+	}
+	icon, err := h.placeholderService.Cover(projectID)
+	if err != nil {
+		respondWithError(c, h.responseHelper, "Failed to generate cover", err)
+		return
+	}
+	h.writePlaceholder(c, icon, projectService.CoverWidth, projectService.CoverHeight, format, coverCacheControl)
+}
+
+// GetAvatar godoc
+// @Summary Placeholder avatar of a user
+// @Description Generated from the username, ask for username.svg or username.png
+// @Tags projects
+// @Produce image/svg+xml,image/png
+// @Param file path string true "Username with the .svg or .png extension"
+// @Success 200 {file} file "Avatar"
+// @Failure 400 {object} map[string]interface{} "Invalid username"
+// @Router /public/projects/avatars/{file} [get]
+func (h *projectPlaceholderHandler) GetAvatar(c *gin.Context) {
+	username, format := splitPlaceholderFile(c.Param("file"))
+	if err := h.validator.ValidateUsername(username); err != nil {
+		h.responseHelper.BadRequest(c, err.Error(), utils.FixInvalidUsername)
+		return
+	}
+	icon := h.placeholderService.Avatar(username)
+	h.writePlaceholder(c, icon, projectService.AvatarWidth, projectService.AvatarHeight, format, avatarCacheControl)
+}
+
+// splitPlaceholderFile splits "name.png" into the name and the format, SVG is used without an extension.
+func splitPlaceholderFile(file string) (string, string) {
+	if name, ok := strings.CutSuffix(file, "."+placeholderPNG); ok {
+		return name, placeholderPNG
+	}
+	return strings.TrimSuffix(file, "."+placeholderSVG), placeholderSVG
+}
+
+func (h *projectPlaceholderHandler) writePlaceholder(c *gin.Context, icon imaging.Identicon, width, height int, format, cacheControl string) {
+	if format == placeholderSVG {
+		c.Header("Cache-Control", cacheControl)
+		c.Data(http.StatusOK, "image/svg+xml", icon.SVG(width, height))
+		return
+	}
+	encoder := imaging.PNGEncoder{}
+	var buf bytes.Buffer
+	if err := encoder.Encode(&buf, icon.Image(width, height)); err != nil {
+		h.responseHelper.InternalError(c, "Failed to generate image", err)
+		return
+	}
+	c.Header("Cache-Control", cacheControl)
+	c.Data(http.StatusOK, encoder.ContentType(), buf.Bytes())
+}
@@ -0,0 +1,97 @@
+package handler
+
+import (
+	"github.com/aruncs31s/esdcprojectmodule/dto"
+	"github.com/aruncs31s/esdcprojectmodule/interfaces/handler"
+	"github.com/aruncs31s/esdcprojectmodule/interfaces/service"
+	"github.com/aruncs31s/esdcsharedhelpersmodule/helper"
+	sharedHelper "github.com/aruncs31s/esdcsharedhelpersmodule/interface/helper"
+	"github.com/aruncs31s/esdcsharedhelpersmodule/utils"
+	"github.com/aruncs31s/responsehelper"
+	"github.com/gin-gonic/gin"
+)
+
+type projectPublishHandler struct {
+	publishService service.ProjectPublishService
+	requestHelper  sharedHelper.RequestHelper
+	responseHelper responsehelper.ResponseHelper
+	validator      sharedHelper.RequestValidator
+}
+
+func NewProjectPublishHandler(publishService service.ProjectPublishService) handler.ProjectPublishHandler {
+	responseHelper, requestHelper, validator := getHelpers()
+	return &projectPublishHandler{
+		publishService: publishService,
+		requestHelper:  requestHelper,
+		responseHelper: responseHelper,
+		validator:      validator,
+	}
+}
+
+func (h *projectPublishHandler) GetValidator() sharedHelper.RequestValidator {
+	return h.validator
+}
+func (h *projectPublishHandler) GetResponseHelper() responsehelper.ResponseHelper {
+	return h.responseHelper
+}
+
+// PublishProject godoc
+// @Summary Publish a draft project
+// @Description Publishes right away, or at publish_at when it is given
+// @Tags projects
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Project ID"
+// @Param body body dto.PublishProject false "Publish time"
+// @Success 200 {object} map[string]interface{} "Published or scheduled"
+// @Failure 400 {object} map[string]interface{} "Not a draft or publish_at in the past"
+// @Router /projects/{id}/publish [post]
+func (h *projectPublishHandler) PublishProject(c *gin.Context) {
+	user, failed := h.requestHelper.GetAndValidateUsername(c, h)
+	if failed {
+		return
+	}
+	projectID, failed := h.requestHelper.ValidateAndParseID(h, "id", c, utils.FixInvalidID)
+	if failed {
+		return
+	}
+	// The body is optional, without it the draft is published right away.
+	var request dto.PublishProject
+	if c.Request.ContentLength != 0 {
+		request, failed = helper.GetJSONDataFromRequest[dto.PublishProject](c, h.responseHelper)
+		if failed {
+			return
+		}
+	}
+	publication, err := h.publishService.Publish(user, projectID, request.PublishAt)
+	if err != nil {
+		respondWithError(c, h.responseHelper, "Failed to publish project", err)
+		return
+	}
+	h.responseHelper.Success(c, publication)
+}
+
+// CancelScheduledPublish godoc
+// @Summary Cancel the scheduled publish of a draft
+// @Tags projects
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Project ID"
+// @Success 200 {object} map[string]interface{} "Schedule cancelled"
+// @Router /projects/{id}/publish [delete]
+func (h *projectPublishHandler) CancelScheduledPublish(c *gin.Context) {
+	user, failed := h.requestHelper.GetAndValidateUsername(c, h)
+	if failed {
+		return
+	}
+	projectID, failed := h.requestHelper.ValidateAndParseID(h, "id", c, utils.FixInvalidID)
+	if failed {
+		return
+	}
+	if err := h.publishService.CancelSchedule(user, projectID); err != nil {
+		respondWithError(c, h.responseHelper, "Failed to cancel scheduled publish", err)
+		return
+	}
+	h.responseHelper.Deleted(c, "Scheduled publish")
+}
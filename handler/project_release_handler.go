@@ -0,0 +1,97 @@
+package handler
+
+import (
+	"github.com/aruncs31s/esdcprojectmodule/dto"
+	"github.com/aruncs31s/esdcprojectmodule/interfaces/handler"
+	"github.com/aruncs31s/esdcprojectmodule/interfaces/service"
+	"github.com/aruncs31s/esdcsharedhelpersmodule/helper"
+	sharedHelper "github.com/aruncs31s/esdcsharedhelpersmodule/interface/helper"
+	"github.com/aruncs31s/esdcsharedhelpersmodule/utils"
+	"github.com/aruncs31s/responsehelper"
+	"github.com/gin-gonic/gin"
+)
+
+type projectReleaseHandler struct {
+	releaseService service.ProjectReleaseService
+	requestHelper  sharedHelper.RequestHelper
+	responseHelper responsehelper.ResponseHelper
+	validator      sharedHelper.RequestValidator
+}
+
+func NewProjectReleaseHandler(releaseService service.ProjectReleaseService) handler.ProjectReleaseHandler {
+	responseHelper, requestHelper, validator := getHelpers()
+	return &projectReleaseHandler{
+		releaseService: releaseService,
+		requestHelper:  requestHelper,
+		responseHelper: responseHelper,
+		validator:      validator,
+	}
+}
+
+func (h *projectReleaseHandler) GetValidator() sharedHelper.RequestValidator {
+	return h.validator
+}
+func (h *projectReleaseHandler) GetResponseHelper() responsehelper.ResponseHelper {
+	return h.responseHelper
+}
+
+// GetReleases godoc
+// @Summary Releases of a project
+// @Tags projects
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Project ID"
+// @Param page query int false "Page number"
+// @Param per-page query int false "Page size"
+// @Success 200 {object} map[string]interface{} "Releases"
+// @Router /projects/{id}/releases [get]
+func (h *projectReleaseHandler) GetReleases(c *gin.Context) {
+	user, failed := h.requestHelper.GetAndValidateUsername(c, h)
+	if failed {
+		return
+	}
+	projectID, failed := h.requestHelper.ValidateAndParseID(h, "id", c, utils.FixInvalidID)
+	if failed {
+		return
+	}
+	limit, offset := h.requestHelper.GetLimitAndOffset(c)
+	releases, err := h.releaseService.GetReleases(user, projectID, limit, offset)
+	if err != nil {
+		respondWithError(c, h.responseHelper, "Failed to retrieve releases", err)
+		return
+	}
+	h.responseHelper.Success(c, releases)
+}
+
+// CreateRelease godoc
+// @Summary Publish a new release of a project
+// @Description The version must be semver, greater than the latest release and not lower than the project version
+// @Tags projects
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Project ID"
+// @Param body body dto.ReleaseCreation true "Release"
+// @Success 201 {object} map[string]interface{} "Release created"
+// @Failure 400 {object} map[string]interface{} "Invalid version or artifacts"
+// @Router /projects/{id}/releases [post]
+func (h *projectReleaseHandler) CreateRelease(c *gin.Context) {
+	user, failed := h.requestHelper.GetAndValidateUsername(c, h)
+	if failed {
+		return
+	}
+	projectID, failed := h.requestHelper.ValidateAndParseID(h, "id", c, utils.FixInvalidID)
+	if failed {
+		return
+	}
+	request, failed := helper.GetJSONDataFromRequest[dto.ReleaseCreation](c, h.responseHelper)
+	if failed {
+		return
+	}
+	release, err := h.releaseService.CreateRelease(user, projectID, request)
+	if err != nil {
+		respondWithError(c, h.responseHelper, "Failed to create release", err)
+		return
+	}
+	h.responseHelper.Created(c, release)
+}
@@ -0,0 +1,111 @@
+package handler
+
+import (
+	"github.com/aruncs31s/esdcprojectmodule/interfaces/handler"
+	"github.com/aruncs31s/esdcprojectmodule/interfaces/service"
+	sharedHelper "github.com/aruncs31s/esdcsharedhelpersmodule/interface/helper"
+	"github.com/aruncs31s/esdcsharedhelpersmodule/utils"
+	"github.com/aruncs31s/responsehelper"
+	"github.com/gin-gonic/gin"
+)
+
+type projectRepoMetadataHandler struct {
+	metadataService service.ProjectRepoMetadataService
+	requestHelper   sharedHelper.RequestHelper
+	responseHelper  responsehelper.ResponseHelper
+	validator       sharedHelper.RequestValidator
+}
+
+func NewProjectRepoMetadataHandler(metadataService service.ProjectRepoMetadataService) handler.ProjectRepoMetadataHandler {
+	responseHelper, requestHelper, validator := getHelpers()
+	return &projectRepoMetadataHandler{
+		metadataService: metadataService,
+		requestHelper:   requestHelper,
+		responseHelper:  responseHelper,
+		validator:       validator,
+	}
+}
+
+func (h *projectRepoMetadataHandler) GetValidator() sharedHelper.RequestValidator {
+	return h.validator
+}
+func (h *projectRepoMetadataHandler) GetResponseHelper() responsehelper.ResponseHelper {
+	return h.responseHelper
+}
+
+// GetMetadata godoc
+// @Summary Repository metadata of a project
+// @Description Stars, forks, languages, license, last push and topics from the github link, synced periodically
+// @Tags projects
+// @Produce json
+// @Param id path int true "Project ID"
+// @Success 200 {object} dto.RepoMetadata "Synced metadata"
+// @Failure 404 {object} map[string]interface{} "Not synced yet"
+// @Router /projects/{id}/repository [get]
+func (h *projectRepoMetadataHandler) GetMetadata(c *gin.Context) {
+	projectID, failed := h.requestHelper.ValidateAndParseID(h, "id", c, utils.FixInvalidID)
+	if failed {
+		return
+	}
+	// Anonymous on the public route, so only listed projects are returned there.
+	metadata, err := h.metadataService.GetMetadata(c.GetString("username"), projectID)
+	if err != nil {
+		respondWithError(c, h.responseHelper, "Failed to retrieve repository metadata", err)
+		return
+	}
+	h.responseHelper.Success(c, metadata)
+}
+
+// SyncMetadata godoc
+// @Summary Sync the repository metadata of a project now
+// @Tags projects
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Project ID"
+// @Success 200 {object} dto.RepoMetadata "Synced metadata"
+// @Failure 400 {object} map[string]interface{} "No supported github link"
+// @Failure 404 {object} map[string]interface{} "Repository not found"
+// @Router /projects/{id}/repository/sync [post]
+func (h *projectRepoMetadataHandler) SyncMetadata(c *gin.Context) {
+	user, failed := h.requestHelper.GetAndValidateUsername(c, h)
+	if failed {
+		return
+	}
+	projectID, failed := h.requestHelper.ValidateAndParseID(h, "id", c, utils.FixInvalidID)
+	if failed {
+		return
+	}
+	metadata, err := h.metadataService.Sync(user, projectID)
+	if err != nil {
+		respondWithError(c, h.responseHelper, "Failed to sync repository metadata", err)
+		return
+	}
+	h.responseHelper.Success(c, metadata)
+}
+
+// GetSuggestions godoc
+// @Summary Technologies and tags suggested by the repository
+// @Description The repository languages as technologies and its topics as tags, leaving out the ones the project has
+// @Tags projects
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Project ID"
+// @Success 200 {object} dto.RepoSuggestions "Suggestions"
+// @Failure 404 {object} map[string]interface{} "Not synced yet"
+// @Router /projects/{id}/repository/suggestions [get]
+func (h *projectRepoMetadataHandler) GetSuggestions(c *gin.Context) {
+	user, failed := h.requestHelper.GetAndValidateUsername(c, h)
+	if failed {
+		return
+	}
+	projectID, failed := h.requestHelper.ValidateAndParseID(h, "id", c, utils.FixInvalidID)
+	if failed {
+		return
+	}
+	suggestions, err := h.metadataService.GetSuggestions(user, projectID)
+	if err != nil {
+		respondWithError(c, h.responseHelper, "Failed to suggest technologies and tags", err)
+		return
+	}
+	h.responseHelper.Success(c, suggestions)
+}
@@ -0,0 +1,129 @@
+package handler
+
+import (
+	"github.com/aruncs31s/esdcprojectmodule/interfaces/handler"
+	"github.com/aruncs31s/esdcprojectmodule/interfaces/service"
+	sharedHelper "github.com/aruncs31s/esdcsharedhelpersmodule/interface/helper"
+	"github.com/aruncs31s/esdcsharedhelpersmodule/utils"
+	"github.com/aruncs31s/responsehelper"
+	"github.com/gin-gonic/gin"
+)
+
+type projectRevisionHandler struct {
+	revisionService service.ProjectRevisionService
+	requestHelper   sharedHelper.RequestHelper
+	responseHelper  responsehelper.ResponseHelper
+	validator       sharedHelper.RequestValidator
+}
+
+func NewProjectRevisionHandler(revisionService service.ProjectRevisionService) handler.ProjectRevisionHandler {
+	responseHelper, requestHelper, validator := getHelpers()
+	return &projectRevisionHandler{
+		revisionService: revisionService,
+		requestHelper:   requestHelper,
+		responseHelper:  responseHelper,
+		validator:       validator,
+	}
+}
+
+func (h *projectRevisionHandler) GetValidator() sharedHelper.RequestValidator {
+	return h.validator
+}
+func (h *projectRevisionHandler) GetResponseHelper() responsehelper.ResponseHelper {
+	return h.responseHelper
+}
+
+// GetRevisions godoc
+// @Summary Revision history of a project
+// @Tags projects
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Project ID"
+// @Param page query int false "Page number"
+// @Param per-page query int false "Page size"
+// @Success 200 {object} map[string]interface{} "Revisions"
+// @Router /projects/{id}/revisions [get]
+func (h *projectRevisionHandler) GetRevisions(c *gin.Context) {
+	user, failed := h.requestHelper.GetAndValidateUsername(c, h)
+	if failed {
+		return
+	}
+	projectID, failed := h.requestHelper.ValidateAndParseID(h, "id", c, utils.FixInvalidID)
+	if failed {
+		return
+	}
+	limit, offset := h.requestHelper.GetLimitAndOffset(c)
+	revisions, err := h.revisionService.GetRevisions(user, projectID, limit, offset)
+	if err != nil {
+		respondWithError(c, h.responseHelper, "Failed to retrieve revisions", err)
+		return
+	}
+	h.responseHelper.Success(c, revisions)
+}
+
+// DiffRevisions godoc
+// @Summary Field by field diff between two revisions
+// @Tags projects
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Project ID"
+// @Param from query int true "Older revision"
+// @Param to query int true "Newer revision"
+// @Success 200 {object} map[string]interface{} "Changed fields"
+// @Router /projects/{id}/revisions/diff [get]
+func (h *projectRevisionHandler) DiffRevisions(c *gin.Context) {
+	user, failed := h.requestHelper.GetAndValidateUsername(c, h)
+	if failed {
+		return
+	}
+	projectID, failed := h.requestHelper.ValidateAndParseID(h, "id", c, utils.FixInvalidID)
+	if failed {
+		return
+	}
+	from, err := h.validator.ValidateIDAndParse(c.Query("from"))
+	if err != nil {
+		h.responseHelper.BadRequest(c, err.Error(), "Please provide a valid from revision.")
+		return
+	}
+	to, err := h.validator.ValidateIDAndParse(c.Query("to"))
+	if err != nil {
+		h.responseHelper.BadRequest(c, err.Error(), "Please provide a valid to revision.")
+		return
+	}
+	diff, err := h.revisionService.DiffRevisions(user, projectID, int(from), int(to))
+	if err != nil {
+		respondWithError(c, h.responseHelper, "Failed to compare revisions", err)
+		return
+	}
+	h.responseHelper.Success(c, diff)
+}
+
+// RestoreRevision godoc
+// @Summary Restore an old revision of a project
+// @Tags projects
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Project ID"
+// @Param rev path int true "Revision number"
+// @Success 200 {object} map[string]interface{} "Restored project"
+// @Router /projects/{id}/revisions/{rev}/restore [post]
+func (h *projectRevisionHandler) RestoreRevision(c *gin.Context) {
+	user, failed := h.requestHelper.GetAndValidateUsername(c, h)
+	if failed {
+		return
+	}
+	projectID, failed := h.requestHelper.ValidateAndParseID(h, "id", c, utils.FixInvalidID)
+	if failed {
+		return
+	}
+	revision, failed := h.requestHelper.ValidateAndParseID(h, "rev", c, "Please provide a valid revision.")
+	if failed {
+		return
+	}
+	project, err := h.revisionService.RestoreRevision(user, projectID, int(revision))
+	if err != nil {
+		respondWithError(c, h.responseHelper, "Failed to restore revision", err)
+		return
+	}
+	h.responseHelper.Success(c, project)
+}
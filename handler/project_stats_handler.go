@@ -0,0 +1,55 @@
+package handler
+
+import (
+	"github.com/aruncs31s/esdcprojectmodule/interfaces/handler"
+	"github.com/aruncs31s/esdcprojectmodule/interfaces/service"
+	sharedHelper "github.com/aruncs31s/esdcsharedhelpersmodule/interface/helper"
+	"github.com/aruncs31s/responsehelper"
+	"github.com/gin-gonic/gin"
+)
+
+type projectStatsHandler struct {
+	statsService   service.ProjectStatsService
+	requestHelper  sharedHelper.RequestHelper
+	responseHelper responsehelper.ResponseHelper
+	validator      sharedHelper.RequestValidator
+}
+
+func NewProjectStatsHandler(statsService service.ProjectStatsService) handler.ProjectStatsHandler {
+	responseHelper, requestHelper, validator := getHelpers()
+	return &projectStatsHandler{
+		statsService:   statsService,
+		requestHelper:  requestHelper,
+		responseHelper: responseHelper,
+		validator:      validator,
+	}
+}
+
+func (h *projectStatsHandler) GetValidator() sharedHelper.RequestValidator {
+	return h.validator
+}
+func (h *projectStatsHandler) GetResponseHelper() responsehelper.ResponseHelper {
+	return h.responseHelper
+}
+
+// GetStats godoc
+// @Summary Statistics of every project
+// @Description Totals by status, visibility and category, new projects per week, the top technologies, tags and creators and the average likes and views. The stats are cached for a minute.
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} dto.ProjectStats "Stats"
+// @Failure 401 {object} map[string]interface{} "Not an admin"
+// @Router /admin/projects/stats [get]
+func (h *projectStatsHandler) GetStats(c *gin.Context) {
+	user, failed := h.requestHelper.GetAndValidateUsername(c, h)
+	if failed {
+		return
+	}
+	stats, err := h.statsService.GetStats(user)
+	if err != nil {
+		respondWithError(c, h.responseHelper, "Failed to retrieve project stats", err)
+		return
+	}
+	h.responseHelper.Success(c, stats)
+}
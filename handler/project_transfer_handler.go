@@ -0,0 +1,192 @@
+package handler
+
+import (
+	"github.com/aruncs31s/esdcprojectmodule/dto"
+	"github.com/aruncs31s/esdcprojectmodule/interfaces/handler"
+	"github.com/aruncs31s/esdcprojectmodule/interfaces/service"
+	"github.com/aruncs31s/esdcsharedhelpersmodule/helper"
+	sharedHelper "github.com/aruncs31s/esdcsharedhelpersmodule/interface/helper"
+	"github.com/aruncs31s/esdcsharedhelpersmodule/utils"
+	"github.com/aruncs31s/responsehelper"
+	"github.com/gin-gonic/gin"
+)
+
+type projectTransferHandler struct {
+	transferService service.ProjectTransferService
+	requestHelper   sharedHelper.RequestHelper
+	responseHelper  responsehelper.ResponseHelper
+	validator       sharedHelper.RequestValidator
+}
+
+func NewProjectTransferHandler(transferService service.ProjectTransferService) handler.ProjectTransferHandler {
+	responseHelper, requestHelper, validator := getHelpers()
+	return &projectTransferHandler{
+		transferService: transferService,
+		requestHelper:   requestHelper,
+		responseHelper:  responseHelper,
+		validator:       validator,
+	}
+}
+
+func (h *projectTransferHandler) GetValidator() sharedHelper.RequestValidator {
+	return h.validator
+}
+func (h *projectTransferHandler) GetResponseHelper() responsehelper.ResponseHelper {
+	return h.responseHelper
+}
+
+// RequestTransfer godoc
+// @Summary Propose a new owner for a project
+// @Tags projects
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Project ID"
+// @Param body body dto.TransferOwnership true "New owner"
+// @Success 201 {object} map[string]interface{} "Transfer created"
+// @Router /projects/{id}/transfer [post]
+func (h *projectTransferHandler) RequestTransfer(c *gin.Context) {
+	user, failed := h.requestHelper.GetAndValidateUsername(c, h)
+	if failed {
+		return
+	}
+	projectID, failed := h.requestHelper.ValidateAndParseID(h, "id", c, utils.FixInvalidID)
+	if failed {
+		return
+	}
+	request, failed := helper.GetJSONDataFromRequest[dto.TransferOwnership](c, h.responseHelper)
+	if failed {
+		return
+	}
+	if err := h.validator.ValidateUsername(request.Username); err != nil {
+		h.responseHelper.BadRequest(c, err.Error(), utils.FixInvalidUsername)
+		return
+	}
+	transfer, err := h.transferService.RequestTransfer(user, projectID, request.Username, request.Force)
+	if err != nil {
+		respondWithError(c, h.responseHelper, "Failed to transfer project", err)
+		return
+	}
+	h.responseHelper.Created(c, transfer)
+}
+
+// CancelTransfer godoc
+// @Summary Cancel the pending transfer of a project
+// @Tags projects
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Project ID"
+// @Success 200 {object} map[string]interface{} "Transfer cancelled"
+// @Router /projects/{id}/transfer [delete]
+func (h *projectTransferHandler) CancelTransfer(c *gin.Context) {
+	user, failed := h.requestHelper.GetAndValidateUsername(c, h)
+	if failed {
+		return
+	}
+	projectID, failed := h.requestHelper.ValidateAndParseID(h, "id", c, utils.FixInvalidID)
+	if failed {
+		return
+	}
+	if err := h.transferService.CancelTransfer(user, projectID); err != nil {
+		respondWithError(c, h.responseHelper, "Failed to cancel transfer", err)
+		return
+	}
+	h.responseHelper.Deleted(c, "Transfer")
+}
+
+// GetProjectTransfers godoc
+// @Summary Ownership history of a project
+// @Tags projects
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Project ID"
+// @Success 200 {object} map[string]interface{} "Transfers"
+// @Router /projects/{id}/transfers [get]
+func (h *projectTransferHandler) GetProjectTransfers(c *gin.Context) {
+	user, failed := h.requestHelper.GetAndValidateUsername(c, h)
+	if failed {
+		return
+	}
+	projectID, failed := h.requestHelper.ValidateAndParseID(h, "id", c, utils.FixInvalidID)
+	if failed {
+		return
+	}
+	transfers, err := h.transferService.GetProjectTransfers(user, projectID)
+	if err != nil {
+		respondWithError(c, h.responseHelper, "Failed to retrieve transfers", err)
+		return
+	}
+	h.responseHelper.Success(c, transfers)
+}
+
+// GetMyTransfers godoc
+// @Summary List the transfers offered to me
+// @Tags projects
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} map[string]interface{} "Pending transfers"
+// @Router /projects/transfers [get]
+func (h *projectTransferHandler) GetMyTransfers(c *gin.Context) {
+	user, failed := h.requestHelper.GetAndValidateUsername(c, h)
+	if failed {
+		return
+	}
+	transfers, err := h.transferService.GetMyTransfers(user)
+	if err != nil {
+		respondWithError(c, h.responseHelper, "Failed to retrieve transfers", err)
+		return
+	}
+	h.responseHelper.Success(c, transfers)
+}
+
+// AcceptTransfer godoc
+// @Summary Accept a project transfer
+// @Tags projects
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Transfer ID"
+// @Success 200 {object} map[string]interface{} "Transfer accepted"
+// @Router /projects/transfers/{id}/accept [post]
+func (h *projectTransferHandler) AcceptTransfer(c *gin.Context) {
+	user, failed := h.requestHelper.GetAndValidateUsername(c, h)
+	if failed {
+		return
+	}
+	transferID, failed := h.requestHelper.ValidateAndParseID(h, "id", c, utils.FixInvalidID)
+	if failed {
+		return
+	}
+	if err := h.transferService.AcceptTransfer(user, transferID); err != nil {
+		respondWithError(c, h.responseHelper, "Failed to accept transfer", err)
+		return
+	}
+	h.responseHelper.Success(c, map[string]interface{}{
+		"message": "Transfer accepted successfully",
+	})
+}
+
+// DeclineTransfer godoc
+// @Summary Decline a project transfer
+// @Tags projects
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Transfer ID"
+// @Success 200 {object} map[string]interface{} "Transfer declined"
+// @Router /projects/transfers/{id}/decline [post]
+func (h *projectTransferHandler) DeclineTransfer(c *gin.Context) {
+	user, failed := h.requestHelper.GetAndValidateUsername(c, h)
+	if failed {
+		return
+	}
+	transferID, failed := h.requestHelper.ValidateAndParseID(h, "id", c, utils.FixInvalidID)
+	if failed {
+		return
+	}
+	if err := h.transferService.DeclineTransfer(user, transferID); err != nil {
+		respondWithError(c, h.responseHelper, "Failed to decline transfer", err)
+		return
+	}
+	h.responseHelper.Success(c, map[string]interface{}{
+		"message": "Transfer declined successfully",
+	})
+}
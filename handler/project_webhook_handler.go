@@ -0,0 +1,152 @@
+package handler
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/aruncs31s/esdcprojectmodule/interfaces/handler"
+	"github.com/aruncs31s/esdcprojectmodule/interfaces/service"
+	sharedHelper "github.com/aruncs31s/esdcsharedhelpersmodule/interface/helper"
+	"github.com/aruncs31s/esdcsharedhelpersmodule/utils"
+	"github.com/aruncs31s/responsehelper"
+	"github.com/gin-gonic/gin"
+)
+
+// maxWebhookPayloadBytes is the largest delivery that is read, GitHub caps payloads at 25 MB
+// but push and release payloads are far smaller.
+const maxWebhookPayloadBytes = 5 << 20
+
+type projectWebhookHandler struct {
+	webhookService service.ProjectWebhookService
+	requestHelper  sharedHelper.RequestHelper
+	responseHelper responsehelper.ResponseHelper
+	validator      sharedHelper.RequestValidator
+}
+
+func NewProjectWebhookHandler(webhookService service.ProjectWebhookService) handler.ProjectWebhookHandler {
+	responseHelper, requestHelper, validator := getHelpers()
+	return &projectWebhookHandler{
+		webhookService: webhookService,
+		requestHelper:  requestHelper,
+		responseHelper: responseHelper,
+		validator:      validator,
+	}
+}
+
+func (h *projectWebhookHandler) GetValidator() sharedHelper.RequestValidator {
+	return h.validator
+}
+func (h *projectWebhookHandler) GetResponseHelper() responsehelper.ResponseHelper {
+	return h.responseHelper
+}
+
+// GetWebhook godoc
+// @Summary Webhook of a project
+// @Description The payload URL and the latest deliveries, the secret is not returned
+// @Tags projects
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Project ID"
+// @Success 200 {object} dto.ProjectWebhook "Webhook"
+// @Failure 404 {object} map[string]interface{} "No webhook"
+// @Router /projects/{id}/webhook [get]
+func (h *projectWebhookHandler) GetWebhook(c *gin.Context) {
+	user, failed := h.requestHelper.GetAndValidateUsername(c, h)
+	if failed {
+		return
+	}
+	projectID, failed := h.requestHelper.ValidateAndParseID(h, "id", c, utils.FixInvalidID)
+	if failed {
+		return
+	}
+	webhook, err := h.webhookService.GetWebhook(user, projectID)
+	if err != nil {
+		respondWithError(c, h.responseHelper, "Failed to retrieve webhook", err)
+		return
+	}
+	h.responseHelper.Success(c, webhook)
+}
+
+// CreateWebhook godoc
+// @Summary Create the webhook of a project or rotate its secret
+// @Description Add the payload URL and secret to the GitHub repository, with the push and release events
+// @Tags projects
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Project ID"
+// @Success 201 {object} dto.ProjectWebhook "Webhook with its secret"
+// @Failure 400 {object} map[string]interface{} "No GitHub link"
+// @Router /projects/{id}/webhook [post]
+func (h *projectWebhookHandler) CreateWebhook(c *gin.Context) {
+	user, failed := h.requestHelper.GetAndValidateUsername(c, h)
+	if failed {
+		return
+	}
+	projectID, failed := h.requestHelper.ValidateAndParseID(h, "id", c, utils.FixInvalidID)
+	if failed {
+		return
+	}
+	webhook, err := h.webhookService.CreateWebhook(user, projectID)
+	if err != nil {
+		respondWithError(c, h.responseHelper, "Failed to create webhook", err)
+		return
+	}
+	h.responseHelper.Created(c, webhook)
+}
+
+// DeleteWebhook godoc
+// @Summary Delete the webhook of a project
+// @Tags projects
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Project ID"
+// @Success 200 {object} map[string]interface{} "Deleted"
+// @Failure 404 {object} map[string]interface{} "No webhook"
+// @Router /projects/{id}/webhook [delete]
+func (h *projectWebhookHandler) DeleteWebhook(c *gin.Context) {
+	user, failed := h.requestHelper.GetAndValidateUsername(c, h)
+	if failed {
+		return
+	}
+	projectID, failed := h.requestHelper.ValidateAndParseID(h, "id", c, utils.FixInvalidID)
+	if failed {
+		return
+	}
+	if err := h.webhookService.DeleteWebhook(user, projectID); err != nil {
+		respondWithError(c, h.responseHelper, "Failed to delete webhook", err)
+		return
+	}
+	h.responseHelper.Deleted(c, "Webhook")
+}
+
+// ReceiveGitHub godoc
+// @Summary Receive a GitHub webhook delivery
+// @Description Verified with the X-Hub-Signature-256 header against the secret of each project linked to the repository
+// @Tags hooks
+// @Accept json
+// @Produce json
+// @Param X-GitHub-Event header string true "Event name"
+// @Param X-GitHub-Delivery header string true "Delivery ID"
+// @Param X-Hub-Signature-256 header string true "HMAC of the payload"
+// @Success 200 {object} dto.WebhookDelivery "Projects the delivery was applied to"
+// @Failure 401 {object} map[string]interface{} "Signature does not match or no project has a webhook for the repository"
+// @Router /hooks/github [post]
+func (h *projectWebhookHandler) ReceiveGitHub(c *gin.Context) {
+	// The signature covers the raw bytes, so the body is not bound.
+	payload, err := io.ReadAll(http.MaxBytesReader(c.Writer, c.Request.Body, maxWebhookPayloadBytes))
+	if err != nil {
+		h.responseHelper.BadRequest(c, "Failed to read payload", err.Error())
+		return
+	}
+	delivery, err := h.webhookService.ReceiveGitHub(
+		c.GetHeader("X-GitHub-Event"),
+		c.GetHeader("X-GitHub-Delivery"),
+		c.GetHeader("X-Hub-Signature-256"),
+		payload,
+	)
+	if err != nil {
+		respondWithError(c, h.responseHelper, "Failed to receive delivery", err)
+		return
+	}
+	h.responseHelper.Success(c, delivery)
+}
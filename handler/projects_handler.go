@@ -24,7 +24,9 @@ type ProjectHandler interface {
 	// ToggleLikeProject is used to like or unlike a project.
 	ToggleLikeProject(c *gin.Context)
 	// UpdateProject is used to update an existing project.
-	// UpdateProject(c *gin.Context)
+	//
+	// Requires authentication, only the fields sent in the request are changed.
+	UpdateProject(c *gin.Context)
 	// // DeleteProject is used to delete a project.
 	// DeleteProject(c *gin.Context)
 }
@@ -75,6 +77,7 @@ func (h *projectHandler) CreateProject(c *gin.Context) {
 		h.responseHelper.InternalError(c, "Failed to create project", err)
 		return
 	}
+	setAuditProject(c, createdProject.ID)
 	h.responseHelper.Created(c, createdProject)
 }
 func (h *projectHandler) GetAllProjects(c *gin.Context) {
@@ -108,6 +111,41 @@ func (h *projectHandler) GetProject(c *gin.Context) {
 	h.responseHelper.Success(c, project)
 }
 
+// UpdateProject godoc
+// @Summary Update a project
+// @Description Change the fields that are sent, every update is recorded as a revision
+// @Tags projects
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Project ID"
+// @Param body body dto.ProjectUpdate true "Fields to change"
+// @Success 200 {object} map[string]interface{} "Updated project"
+// @Failure 400 {object} map[string]interface{} "Invalid request"
+// @Failure 401 {object} map[string]interface{} "Not allowed"
+// @Failure 404 {object} map[string]interface{} "Project not found"
+// @Router /projects/{id} [put]
+func (h *projectHandler) UpdateProject(c *gin.Context) {
+	user, failed := h.requestHelper.GetAndValidateUsername(c, h)
+	if failed {
+		return
+	}
+	id, failed := h.requestHelper.ValidateAndParseID(h, "id", c, "please provide an id.")
+	if failed {
+		return
+	}
+	projectData, failed := helper.GetJSONDataFromRequest[dto.ProjectUpdate](c, h.responseHelper)
+	if failed {
+		return
+	}
+	project, err := h.projectService.UpdateProject(user, id, projectData)
+	if err != nil {
+		respondWithError(c, h.responseHelper, "Failed to update project", err)
+		return
+	}
+	h.responseHelper.Success(c, project)
+}
+
 // ToggleLikeProject godoc
 // @Summary Toggle like on a project
 // @Description Like or unlike a project
@@ -132,7 +170,7 @@ func (h *projectHandler) ToggleLikeProject(c *gin.Context) {
 	}
 	liked, err := h.projectService.ToggleLikeProject(user, id)
 	if err != nil {
-		h.responseHelper.InternalError(c, "Failed to toggle like", err)
+		respondWithError(c, h.responseHelper, "Failed to toggle like", err)
 		return
 	}
 	response := map[string]interface{}{
@@ -0,0 +1,151 @@
+package imaging
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"math"
+	"strings"
+)
+
+// identiconGrid is the number of cells on each side of the pattern.
+const identiconGrid = 5
+
+// Identicon is a symmetric pattern of cells derived from a seed, used as a
+// placeholder when a project or user has no image of its own.
+//
+// The same seed and tint always give the same pattern and colours.
+type Identicon struct {
+	cells      [identiconGrid][identiconGrid]bool
+	Foreground color.NRGBA
+	Background color.NRGBA
+}
+
+// NewIdenticon derives the pattern from seed and the colour from tint.
+//
+// An empty tint takes the colour from the seed as well, so every seed gets its own hue.
+func NewIdenticon(seed, tint string) Identicon {
+	sum := sha256.Sum256([]byte(seed))
+	var icon Identicon
+	// Only the left half and the middle column are random, the right half mirrors them.
+	bit := 0
+	for col := 0; col < (identiconGrid+1)/2; col++ {
+		for row := 0; row < identiconGrid; row++ {
+			filled := sum[bit/8]>>(bit%8)&1 == 1
+			icon.cells[row][col] = filled
+			icon.cells[row][identiconGrid-1-col] = filled
+			bit++
+		}
+	}
+	if icon.empty() {
+		icon.cells[identiconGrid/2][identiconGrid/2] = true
+	}
+
+	hueSum := sum
+	if tint = strings.ToLower(strings.TrimSpace(tint)); tint != "" {
+		hueSum = sha256.Sum256([]byte(tint))
+	}
+	hue := float64(int(hueSum[0])<<8|int(hueSum[1])) / 65536 * 360
+	// Saturation and lightness still follow the seed, so a category keeps its hue
+	// while its projects can be told apart.
+	saturation := 0.45 + float64(sum[30])/255*0.25
+	lightness := 0.40 + float64(sum[31])/255*0.15
+	icon.Foreground = hslColor(hue, saturation, lightness)
+	icon.Background = hslColor(hue, saturation*0.6, 0.93)
+	return icon
+}
+
+func (i Identicon) empty() bool {
+	for _, row := range i.cells {
+		for _, filled := range row {
+			if filled {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// SVG draws the pattern centred on a width by height background.
+func (i Identicon) SVG(width, height int) []byte {
+	side, left, top := i.layout(width, height)
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d" shape-rendering="crispEdges">`, width, height, width, height)
+	fmt.Fprintf(&buf, `<rect width="%d" height="%d" fill="%s"/>`, width, height, hexColor(i.Background))
+	fmt.Fprintf(&buf, `<g fill="%s">`, hexColor(i.Foreground))
+	for row := 0; row < identiconGrid; row++ {
+		for col := 0; col < identiconGrid; col++ {
+			if !i.cells[row][col] {
+				continue
+			}
+			x0, x1 := left+col*side/identiconGrid, left+(col+1)*side/identiconGrid
+			y0, y1 := top+row*side/identiconGrid, top+(row+1)*side/identiconGrid
+			fmt.Fprintf(&buf, `<rect x="%d" y="%d" width="%d" height="%d"/>`, x0, y0, x1-x0, y1-y0)
+		}
+	}
+	buf.WriteString(`</g></svg>`)
+	return buf.Bytes()
+}
+
+// Image draws the pattern the same way as SVG, for encoders that need pixels.
+func (i Identicon) Image(width, height int) image.Image {
+	img := image.NewNRGBA(image.Rect(0, 0, width, height))
+	draw.Draw(img, img.Bounds(), &image.Uniform{C: i.Background}, image.Point{}, draw.Src)
+	foreground := &image.Uniform{C: i.Foreground}
+	side, left, top := i.layout(width, height)
+	for row := 0; row < identiconGrid; row++ {
+		for col := 0; col < identiconGrid; col++ {
+			if !i.cells[row][col] {
+				continue
+			}
+			cell := image.Rect(
+				left+col*side/identiconGrid, top+row*side/identiconGrid,
+				left+(col+1)*side/identiconGrid, top+(row+1)*side/identiconGrid,
+			)
+			draw.Draw(img, cell, foreground, image.Point{}, draw.Src)
+		}
+	}
+	return img
+}
+
+// layout returns the side of the pattern and its top left corner.
+// The pattern takes five sixths of the shorter side, leaving a margin of half a cell.
+func (i Identicon) layout(width, height int) (side, left, top int) {
+	side = min(width, height) * identiconGrid / (identiconGrid + 1)
+	return side, (width - side) / 2, (height - side) / 2
+}
+
+func hexColor(c color.NRGBA) string {
+	return fmt.Sprintf("#%02x%02x%02x", c.R, c.G, c.B)
+}
+
+// hslColor converts hue in degrees, saturation and lightness in [0, 1] to an opaque colour.
+func hslColor(hue, saturation, lightness float64) color.NRGBA {
+	chroma := (1 - math.Abs(2*lightness-1)) * saturation
+	sector := hue / 60
+	x := chroma * (1 - math.Abs(math.Mod(sector, 2)-1))
+	var r, g, b float64
+	switch {
+	case sector < 1:
+		r, g = chroma, x
+	case sector < 2:
+		r, g = x, chroma
+	case sector < 3:
+		g, b = chroma, x
+	case sector < 4:
+		g, b = x, chroma
+	case sector < 5:
+		r, b = x, chroma
+	default:
+		r, b = chroma, x
+	}
+	m := lightness - chroma/2
+	return color.NRGBA{R: channel(r + m), G: channel(g + m), B: channel(b + m), A: 0xff}
+}
+
+func channel(v float64) uint8 {
+	return uint8(min(max(v*255+0.5, 0), 255))
+}
@@ -0,0 +1,78 @@
+// Package imaging decodes uploaded images, resizes them and encodes the variants.
+package imaging
+
+import (
+	"image"
+	"image/jpeg"
+	"image/png"
+	"io"
+
+	// Decoders for the accepted upload formats.
+	_ "image/gif"
+
+	_ "golang.org/x/image/webp"
+
+	"golang.org/x/image/draw"
+)
+
+// Encoder writes images in one format.
+type Encoder interface {
+	// Format is the short name of the format, used as the key in responses.
+	Format() string
+	Extension() string
+	ContentType() string
+	Encode(w io.Writer, img image.Image) error
+}
+
+// JPEGEncoder encodes opaque images.
+type JPEGEncoder struct {
+	Quality int
+}
+
+func (JPEGEncoder) Format() string      { return "jpeg" }
+func (JPEGEncoder) Extension() string   { return ".jpg" }
+func (JPEGEncoder) ContentType() string { return "image/jpeg" }
+func (e JPEGEncoder) Encode(w io.Writer, img image.Image) error {
+	return jpeg.Encode(w, img, &jpeg.Options{Quality: e.Quality})
+}
+
+// PNGEncoder encodes images that may have transparency.
+type PNGEncoder struct{}
+
+func (PNGEncoder) Format() string      { return "png" }
+func (PNGEncoder) Extension() string   { return ".png" }
+func (PNGEncoder) ContentType() string { return "image/png" }
+func (PNGEncoder) Encode(w io.Writer, img image.Image) error {
+	return png.Encode(w, img)
+}
+
+// WebPEncoder encodes lossless WebP with EncodeWebP.
+type WebPEncoder struct{}
+
+func (WebPEncoder) Format() string      { return "webp" }
+func (WebPEncoder) Extension() string   { return ".webp" }
+func (WebPEncoder) ContentType() string { return "image/webp" }
+func (WebPEncoder) Encode(w io.Writer, img image.Image) error {
+	return EncodeWebP(w, img)
+}
+
+// Fit scales the image down so its longest side is at most maxSide.
+//
+// Images that already fit are returned as they are, they are never scaled up.
+func Fit(img image.Image, maxSide int) image.Image {
+	bounds := img.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+	if width <= maxSide && height <= maxSide {
+		return img
+	}
+	if width >= height {
+		height = max(1, height*maxSide/width)
+		width = maxSide
+	} else {
+		width = max(1, width*maxSide/height)
+		height = maxSide
+	}
+	resized := image.NewNRGBA(image.Rect(0, 0, width, height))
+	draw.CatmullRom.Scale(resized, resized.Bounds(), img, bounds, draw.Src, nil)
+	return resized
+}
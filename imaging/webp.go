@@ -0,0 +1,366 @@
+package imaging
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"image"
+	"image/color"
+	"io"
+	"sort"
+)
+
+// maxWebPSide is the largest width or height a VP8L image can have.
+const maxWebPSide = 1 << 14
+
+const (
+	transformPredictor     = 0
+	transformSubtractGreen = 2
+	// predictorSizeBits gives 512x512 predictor blocks, all of them use the same mode.
+	predictorSizeBits = 9
+	predictorLeft     = 1
+)
+
+// codeLengthOrder is the order the code length code lengths are written in.
+var codeLengthOrder = [19]int{17, 18, 0, 1, 2, 3, 4, 5, 16, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}
+
+// EncodeWebP writes the image as a lossless WebP (VP8L).
+//
+// Only the subtract green and a single left predictor transform are used, and no
+// backward references, every residual is written as Huffman coded literals. That
+// keeps the encoder small, the files are larger than what libwebp produces but
+// fine for thumbnails.
+func EncodeWebP(w io.Writer, img image.Image) error {
+	bounds := img.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+	if width < 1 || height < 1 || width > maxWebPSide || height > maxWebPSide {
+		return errors.New("imaging: image size not supported by webp")
+	}
+	pixels := make([][4]uint8, 0, width*height)
+	hasAlpha := false
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			// VP8L stores straight alpha, like NRGBA.
+			c := color.NRGBAModel.Convert(img.At(x, y)).(color.NRGBA)
+			if c.A != 0xff {
+				hasAlpha = true
+			}
+			// Ordered as green, red, blue, alpha, the order they are coded in.
+			pixels = append(pixels, [4]uint8{c.G, c.R, c.B, c.A})
+		}
+	}
+
+	bw := &bitWriter{}
+	bw.writeBits(0x2f, 8)
+	bw.writeBits(uint32(width-1), 14)
+	bw.writeBits(uint32(height-1), 14)
+	if hasAlpha {
+		bw.writeBits(1, 1)
+	} else {
+		bw.writeBits(0, 1)
+	}
+	bw.writeBits(0, 3) // version
+
+	// Subtract green, then predict every pixel from its left neighbour. Both
+	// are cheap and make the residuals of photos much easier to compress.
+	bw.writeBits(1, 1)
+	bw.writeBits(transformSubtractGreen, 2)
+	for i := range pixels {
+		pixels[i][1] -= pixels[i][0]
+		pixels[i][2] -= pixels[i][0]
+	}
+	bw.writeBits(1, 1)
+	bw.writeBits(transformPredictor, 2)
+	bw.writeBits(predictorSizeBits-2, 3)
+	blocksWide := (width + 1<<predictorSizeBits - 1) >> predictorSizeBits
+	blocksHigh := (height + 1<<predictorSizeBits - 1) >> predictorSizeBits
+	modes := make([][4]uint8, blocksWide*blocksHigh)
+	for i := range modes {
+		modes[i][0] = predictorLeft
+	}
+	writeEntropyCodedImage(bw, modes, false)
+	pixels = predictLeft(pixels, width)
+	bw.writeBits(0, 1) // no more transforms
+
+	writeEntropyCodedImage(bw, pixels, true)
+
+	data := bw.bytes()
+	chunkSize := len(data)
+	padding := chunkSize & 1
+	header := make([]byte, 20)
+	copy(header[0:4], "RIFF")
+	binary.LittleEndian.PutUint32(header[4:8], uint32(4+8+chunkSize+padding))
+	copy(header[8:12], "WEBP")
+	copy(header[12:16], "VP8L")
+	binary.LittleEndian.PutUint32(header[16:20], uint32(chunkSize))
+	var out bytes.Buffer
+	out.Write(header)
+	out.Write(data)
+	if padding == 1 {
+		out.WriteByte(0)
+	}
+	_, err := w.Write(out.Bytes())
+	return err
+}
+
+// writeEntropyCodedImage writes pixels, ordered green, red, blue, alpha, as Huffman coded literals.
+func writeEntropyCodedImage(bw *bitWriter, pixels [][4]uint8, main bool) {
+	bw.writeBits(0, 1) // no color cache
+	if main {
+		bw.writeBits(0, 1) // no meta prefix codes
+	}
+	// Green has room for the 24 length prefixes that are never used here.
+	alphabetSizes := [4]int{256 + 24, 256, 256, 256}
+	codes := make([]prefixCode, 4)
+	for channel := 0; channel < 4; channel++ {
+		histogram := make([]int, alphabetSizes[channel])
+		for _, pixel := range pixels {
+			histogram[pixel[channel]]++
+		}
+		codes[channel] = writePrefixCode(bw, histogram)
+	}
+	// The distance code is never used.
+	writePrefixCode(bw, make([]int, 40))
+
+	for _, pixel := range pixels {
+		for channel := 0; channel < 4; channel++ {
+			codes[channel].write(bw, int(pixel[channel]))
+		}
+	}
+}
+
+// predictLeft replaces the pixels by their residuals to the left predictor.
+//
+// As the format requires, the top left pixel is predicted from opaque black and
+// the rest of the first column from the pixel above.
+func predictLeft(pixels [][4]uint8, width int) [][4]uint8 {
+	residuals := make([][4]uint8, len(pixels))
+	for i, pixel := range pixels {
+		var predicted [4]uint8
+		switch {
+		case i == 0:
+			predicted = [4]uint8{0, 0, 0, 0xff}
+		case i%width == 0:
+			predicted = pixels[i-width]
+		default:
+			predicted = pixels[i-1]
+		}
+		for channel := range pixel {
+			residuals[i][channel] = pixel[channel] - predicted[channel]
+		}
+	}
+	return residuals
+}
+
+// bitWriter packs bits least significant first, the way VP8L reads them.
+type bitWriter struct {
+	buf   []byte
+	acc   uint64
+	nbits uint
+}
+
+func (bw *bitWriter) writeBits(value uint32, n uint) {
+	bw.acc |= uint64(value) << bw.nbits
+	bw.nbits += n
+	for bw.nbits >= 8 {
+		bw.buf = append(bw.buf, byte(bw.acc))
+		bw.acc >>= 8
+		bw.nbits -= 8
+	}
+}
+
+func (bw *bitWriter) bytes() []byte {
+	if bw.nbits > 0 {
+		bw.buf = append(bw.buf, byte(bw.acc))
+		bw.acc, bw.nbits = 0, 0
+	}
+	return bw.buf
+}
+
+// prefixCode is a canonical Huffman code, codes are stored bit reversed
+// so they can be written least significant bit first.
+type prefixCode struct {
+	lengths []int
+	codes   []uint32
+}
+
+func (p prefixCode) write(bw *bitWriter, symbol int) {
+	if p.lengths[symbol] > 0 {
+		bw.writeBits(p.codes[symbol], uint(p.lengths[symbol]))
+	}
+}
+
+// writePrefixCode writes the code for the histogram and returns it.
+//
+// One or two used symbols below 256 use the simple code, the rest use the
+// normal code with the code lengths written one by one.
+func writePrefixCode(bw *bitWriter, histogram []int) prefixCode {
+	used := make([]int, 0, 2)
+	for symbol, count := range histogram {
+		if count > 0 {
+			used = append(used, symbol)
+			if len(used) > 2 {
+				break
+			}
+		}
+	}
+	if len(used) == 0 {
+		used = append(used, 0)
+	}
+	if len(used) <= 2 && used[len(used)-1] < 256 {
+		bw.writeBits(1, 1) // simple code
+		bw.writeBits(uint32(len(used)-1), 1)
+		bw.writeBits(1, 1) // 8 bit first symbol
+		bw.writeBits(uint32(used[0]), 8)
+		if len(used) == 2 {
+			bw.writeBits(uint32(used[1]), 8)
+		}
+		lengths := make([]int, len(histogram))
+		if len(used) == 2 {
+			lengths[used[0]], lengths[used[1]] = 1, 1
+		}
+		return newPrefixCode(lengths)
+	}
+
+	lengths := huffmanLengths(histogram, 15)
+	lengthHistogram := make([]int, 19)
+	for _, length := range lengths {
+		lengthHistogram[length]++
+	}
+	codeLengthLengths := huffmanLengths(lengthHistogram, 7)
+	count := 19
+	for count > 4 && codeLengthLengths[codeLengthOrder[count-1]] == 0 {
+		count--
+	}
+	bw.writeBits(0, 1) // normal code
+	bw.writeBits(uint32(count-4), 4)
+	for i := 0; i < count; i++ {
+		bw.writeBits(uint32(codeLengthLengths[codeLengthOrder[i]]), 3)
+	}
+	bw.writeBits(0, 1) // every symbol has a length written
+	codeLengthCode := newPrefixCode(codeLengthLengths)
+	for _, length := range lengths {
+		codeLengthCode.write(bw, length)
+	}
+	return newPrefixCode(lengths)
+}
+
+// huffmanLengths returns code lengths no longer than maxLength for the histogram.
+//
+// At least two symbols always get a length, so the code is complete. Counts are
+// flattened until the lengths fit.
+func huffmanLengths(histogram []int, maxLength int) []int {
+	counts := make([]int, len(histogram))
+	copy(counts, histogram)
+	nonZero := 0
+	for _, count := range counts {
+		if count > 0 {
+			nonZero++
+		}
+	}
+	for symbol := 0; nonZero < 2 && symbol < len(counts); symbol++ {
+		if counts[symbol] == 0 {
+			counts[symbol] = 1
+			nonZero++
+		}
+	}
+	for {
+		lengths := buildHuffmanLengths(counts)
+		longest := 0
+		for _, length := range lengths {
+			longest = max(longest, length)
+		}
+		if longest <= maxLength {
+			return lengths
+		}
+		for symbol, count := range counts {
+			if count > 0 {
+				counts[symbol] = (count + 1) / 2
+			}
+		}
+	}
+}
+
+func buildHuffmanLengths(counts []int) []int {
+	type node struct {
+		count  int
+		symbol int
+		left   int
+		right  int
+	}
+	nodes := make([]node, 0, 2*len(counts))
+	active := make([]int, 0, len(counts))
+	for symbol, count := range counts {
+		if count > 0 {
+			nodes = append(nodes, node{count: count, symbol: symbol, left: -1, right: -1})
+			active = append(active, len(nodes)-1)
+		}
+	}
+	for len(active) > 1 {
+		sort.Slice(active, func(i, j int) bool {
+			a, b := nodes[active[i]], nodes[active[j]]
+			if a.count != b.count {
+				return a.count < b.count
+			}
+			return active[i] < active[j]
+		})
+		nodes = append(nodes, node{
+			count:  nodes[active[0]].count + nodes[active[1]].count,
+			symbol: -1,
+			left:   active[0],
+			right:  active[1],
+		})
+		active = append(active[2:], len(nodes)-1)
+	}
+	lengths := make([]int, len(counts))
+	var walk func(index, depth int)
+	walk = func(index, depth int) {
+		n := nodes[index]
+		if n.symbol >= 0 {
+			lengths[n.symbol] = depth
+			return
+		}
+		walk(n.left, depth+1)
+		walk(n.right, depth+1)
+	}
+	walk(active[0], 0)
+	return lengths
+}
+
+// newPrefixCode assigns canonical codes to the lengths.
+func newPrefixCode(lengths []int) prefixCode {
+	maxLength := 0
+	for _, length := range lengths {
+		maxLength = max(maxLength, length)
+	}
+	lengthCount := make([]uint32, maxLength+1)
+	for _, length := range lengths {
+		if length > 0 {
+			lengthCount[length]++
+		}
+	}
+	nextCode := make([]uint32, maxLength+1)
+	code := uint32(0)
+	for length := 1; length <= maxLength; length++ {
+		code = (code + lengthCount[length-1]) << 1
+		nextCode[length] = code
+	}
+	codes := make([]uint32, len(lengths))
+	for symbol, length := range lengths {
+		if length == 0 {
+			continue
+		}
+		codes[symbol] = reverseBits(nextCode[length], length)
+		nextCode[length]++
+	}
+	return prefixCode{lengths: lengths, codes: codes}
+}
+
+func reverseBits(code uint32, length int) uint32 {
+	reversed := uint32(0)
+	for i := 0; i < length; i++ {
+		reversed = (reversed << 1) | (code & 1)
+		code >>= 1
+	}
+	return reversed
+}
@@ -0,0 +1,137 @@
+package imaging
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"math/rand"
+	"testing"
+
+	"golang.org/x/image/webp"
+)
+
+func TestEncodeWebPRoundTrip(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+
+	noise := image.NewNRGBA(image.Rect(0, 0, 123, 77))
+	rnd.Read(noise.Pix)
+
+	opaque := image.NewRGBA(image.Rect(0, 0, 301, 199))
+	for y := 0; y < 199; y++ {
+		for x := 0; x < 301; x++ {
+			opaque.Set(x, y, color.RGBA{uint8(x), uint8(y), uint8(x * y), 0xff})
+		}
+	}
+
+	// Mostly one colour, so a few symbols get very long Huffman codes.
+	skewed := image.NewNRGBA(image.Rect(0, 0, 257, 131))
+	for y := 0; y < 131; y++ {
+		for x := 0; x < 257; x++ {
+			v := uint8(0)
+			if rnd.Intn(500) == 0 {
+				v = uint8(rnd.Intn(256))
+			}
+			skewed.SetNRGBA(x, y, color.NRGBA{v, v / 2, 0xff - v, 0xff - v/3})
+		}
+	}
+
+	// Wider and taller than one predictor block.
+	large := image.NewNRGBA(image.Rect(0, 0, 1031, 517))
+	for i := range large.Pix {
+		large.Pix[i] = uint8(i / 7)
+	}
+
+	translucent := image.NewNRGBA(image.Rect(0, 0, 33, 17))
+	for y := 0; y < 17; y++ {
+		for x := 0; x < 33; x++ {
+			translucent.SetNRGBA(x, y, color.NRGBA{uint8(x * 7), uint8(y * 15), 0x80, uint8(x * y)})
+		}
+	}
+
+	gray := image.NewGray(image.Rect(0, 0, 9, 3))
+	for i := range gray.Pix {
+		gray.Pix[i] = uint8(i * 9)
+	}
+
+	offset := image.NewRGBA(image.Rect(5, 7, 24, 18))
+	for y := 7; y < 18; y++ {
+		for x := 5; x < 24; x++ {
+			offset.Set(x, y, color.RGBA{uint8(x * 10), 0x40, uint8(y * 10), 0xff})
+		}
+	}
+
+	single := image.NewNRGBA(image.Rect(0, 0, 1, 1))
+	single.SetNRGBA(0, 0, color.NRGBA{1, 2, 3, 4})
+
+	row := image.NewNRGBA(image.Rect(0, 0, 999, 1))
+	rnd.Read(row.Pix)
+
+	column := image.NewNRGBA(image.Rect(0, 0, 1, 999))
+	rnd.Read(column.Pix)
+
+	tests := []struct {
+		name string
+		img  image.Image
+	}{
+		{"random pixels and alpha", noise},
+		{"opaque gradient", opaque},
+		{"skewed histogram", skewed},
+		{"larger than a predictor block", large},
+		{"translucent", translucent},
+		{"gray", gray},
+		{"offset bounds", offset},
+		{"single pixel", single},
+		{"single row", row},
+		{"single column", column},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := EncodeWebP(&buf, tt.img); err != nil {
+				t.Fatal(err)
+			}
+			decoded, err := webp.Decode(&buf)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assertSamePixels(t, tt.img, decoded)
+		})
+	}
+}
+
+func TestEncodeWebPRejectsUnsupportedSizes(t *testing.T) {
+	tests := []struct {
+		name string
+		rect image.Rectangle
+	}{
+		{"empty", image.Rect(0, 0, 0, 0)},
+		{"too wide", image.Rect(0, 0, maxWebPSide+1, 1)},
+		{"too tall", image.Rect(0, 0, 1, maxWebPSide+1)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := EncodeWebP(&bytes.Buffer{}, image.NewAlpha(tt.rect)); err == nil {
+				t.Fatal("EncodeWebP() error = nil")
+			}
+		})
+	}
+}
+
+// assertSamePixels compares straight alpha colours, a lossless encoding must
+// keep the colour of fully transparent pixels too.
+func assertSamePixels(t *testing.T, want, got image.Image) {
+	t.Helper()
+	wb, gb := want.Bounds(), got.Bounds()
+	if wb.Dx() != gb.Dx() || wb.Dy() != gb.Dy() {
+		t.Fatalf("decoded size = %dx%d, want %dx%d", gb.Dx(), gb.Dy(), wb.Dx(), wb.Dy())
+	}
+	for y := 0; y < wb.Dy(); y++ {
+		for x := 0; x < wb.Dx(); x++ {
+			w := color.NRGBAModel.Convert(want.At(wb.Min.X+x, wb.Min.Y+y))
+			g := color.NRGBAModel.Convert(got.At(gb.Min.X+x, gb.Min.Y+y))
+			if w != g {
+				t.Fatalf("pixel (%d, %d) = %v, want %v", x, y, g, w)
+			}
+		}
+	}
+}
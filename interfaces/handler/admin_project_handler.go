@@ -0,0 +1,19 @@
+package handler
+
+import "github.com/gin-gonic/gin"
+
+// AdminProjectHandler handles the management of every project by admins.
+//
+// Requires authentication and an admin, see service.AdminProjectService.
+type AdminProjectHandler interface {
+	// GetProjects lists the projects, filtered by the "creator", "status" and "visibility" queries.
+	GetProjects(c *gin.Context)
+	// UpdateProject changes the project given by the "id" param.
+	UpdateProject(c *gin.Context)
+	// SetVisibility makes the project public or private.
+	SetVisibility(c *gin.Context)
+	// SetStatus changes the status of the project.
+	SetStatus(c *gin.Context)
+	// DeleteProject removes the project.
+	DeleteProject(c *gin.Context)
+}
@@ -0,0 +1,22 @@
+package handler
+
+import "github.com/gin-gonic/gin"
+
+// ProjectAttachmentHandler handles the gallery images and file attachments of a project.
+//
+// All methods require authentication and work on the project given by the "id" param.
+type ProjectAttachmentHandler interface {
+	// GetAttachments lists the gallery and the files, only for contributors.
+	GetAttachments(c *gin.Context)
+	// UploadAttachment stores the multipart "file" field with an optional "caption" field.
+	UploadAttachment(c *gin.Context)
+	// DownloadAttachment serves the file of the attachment given by the "attachmentId" param,
+	// or one of its image sizes, only for contributors.
+	DownloadAttachment(c *gin.Context)
+	// UpdateCaption changes the caption of the attachment given by the "attachmentId" param.
+	UpdateCaption(c *gin.Context)
+	// ReorderAttachments sets the order of every attachment of the project.
+	ReorderAttachments(c *gin.Context)
+	// DeleteAttachment removes the attachment given by the "attachmentId" param.
+	DeleteAttachment(c *gin.Context)
+}
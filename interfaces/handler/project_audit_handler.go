@@ -0,0 +1,13 @@
+package handler
+
+import "github.com/gin-gonic/gin"
+
+// ProjectAuditHandler writes and serves the audit log.
+type ProjectAuditHandler interface {
+	// Audit returns a middleware that records the request under action once it succeeded.
+	Audit(action string) gin.HandlerFunc
+	// GetEntries returns the audit entries matching the query filters.
+	//
+	// Requires authentication, admins only.
+	GetEntries(c *gin.Context)
+}
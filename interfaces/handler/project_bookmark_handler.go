@@ -0,0 +1,23 @@
+package handler
+
+import "github.com/gin-gonic/gin"
+
+// ProjectBookmarkHandler handles the bookmarks of the authenticated user.
+//
+// Requires authentication.
+type ProjectBookmarkHandler interface {
+	// GetBookmarks returns the bookmarks, of one folder when the "folder_id" query is set.
+	GetBookmarks(c *gin.Context)
+	// Bookmark saves a project or moves its bookmark to another folder.
+	Bookmark(c *gin.Context)
+	// RemoveBookmark removes the bookmark of the project given by the "id" param.
+	RemoveBookmark(c *gin.Context)
+	// GetFolders returns the bookmark folders.
+	GetFolders(c *gin.Context)
+	// CreateFolder adds a bookmark folder.
+	CreateFolder(c *gin.Context)
+	// RenameFolder renames the folder given by the "id" param.
+	RenameFolder(c *gin.Context)
+	// DeleteFolder removes the folder given by the "id" param.
+	DeleteFolder(c *gin.Context)
+}
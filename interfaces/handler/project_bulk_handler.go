@@ -0,0 +1,11 @@
+package handler
+
+import "github.com/gin-gonic/gin"
+
+// ProjectBulkHandler handles the operations admins run on many projects at once.
+type ProjectBulkHandler interface {
+	// Run applies one operation to the projects selected in the body.
+	//
+	// Requires authentication, admins only.
+	Run(c *gin.Context)
+}
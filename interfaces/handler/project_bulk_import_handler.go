@@ -0,0 +1,11 @@
+package handler
+
+import "github.com/gin-gonic/gin"
+
+// ProjectBulkImportHandler handles the import of many projects from a CSV or JSON file.
+type ProjectBulkImportHandler interface {
+	// ImportProjects creates the projects of the CSV or JSON body.
+	//
+	// Requires authentication, admins only.
+	ImportProjects(c *gin.Context)
+}
@@ -0,0 +1,35 @@
+package handler
+
+import "github.com/gin-gonic/gin"
+
+// ProjectCollectionHandler handles the collections of projects.
+type ProjectCollectionHandler interface {
+	// GetPublicCollections returns the public collections.
+	GetPublicCollections(c *gin.Context)
+	// GetPublicCollection returns the public collection given by the "slug" param.
+	GetPublicCollection(c *gin.Context)
+	// GetCollections returns the collections the user can change.
+	//
+	// Requires authentication.
+	GetCollections(c *gin.Context)
+	// GetCollection returns the collection given by the "slug" param.
+	//
+	// Requires authentication.
+	GetCollection(c *gin.Context)
+	// CreateCollection adds a collection.
+	//
+	// Requires authentication.
+	CreateCollection(c *gin.Context)
+	// UpdateCollection changes the collection given by the "slug" param.
+	//
+	// Requires authentication.
+	UpdateCollection(c *gin.Context)
+	// DeleteCollection removes the collection given by the "slug" param.
+	//
+	// Requires authentication.
+	DeleteCollection(c *gin.Context)
+	// SetProjects replaces the projects of the collection given by the "slug" param.
+	//
+	// Requires authentication.
+	SetProjects(c *gin.Context)
+}
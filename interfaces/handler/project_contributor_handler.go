@@ -0,0 +1,15 @@
+package handler
+
+import "github.com/gin-gonic/gin"
+
+// ProjectContributorHandler handles the contributors of a project and their roles.
+//
+// All methods require authentication.
+type ProjectContributorHandler interface {
+	// GetContributors lists the contributors of the project given by the "id" param with their roles.
+	GetContributors(c *gin.Context)
+	// UpdateRole changes the role of the contributor given by the "userId" param.
+	UpdateRole(c *gin.Context)
+	// RemoveContributor removes the contributor given by the "userId" param from the project.
+	RemoveContributor(c *gin.Context)
+}
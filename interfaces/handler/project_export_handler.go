@@ -0,0 +1,15 @@
+package handler
+
+import "github.com/gin-gonic/gin"
+
+// ProjectExportHandler streams projects as CSV, JSON or NDJSON.
+type ProjectExportHandler interface {
+	// ExportProjects exports every project matching the filters of the admin list.
+	//
+	// Requires authentication, admins only.
+	ExportProjects(c *gin.Context)
+	// ExportMyProjects exports the projects of the user.
+	//
+	// Requires authentication.
+	ExportMyProjects(c *gin.Context)
+}
@@ -0,0 +1,21 @@
+package handler
+
+import "github.com/gin-gonic/gin"
+
+// ProjectFeatureHandler handles the projects featured on the homepage.
+type ProjectFeatureHandler interface {
+	// GetFeatured returns the projects featured now.
+	GetFeatured(c *gin.Context)
+	// GetFeatures returns every feature.
+	//
+	// Requires authentication, admins only.
+	GetFeatures(c *gin.Context)
+	// FeatureProject features the project given by the "id" param.
+	//
+	// Requires authentication, admins only.
+	FeatureProject(c *gin.Context)
+	// UnfeatureProject takes the project given by the "id" param off the homepage.
+	//
+	// Requires authentication, admins only.
+	UnfeatureProject(c *gin.Context)
+}
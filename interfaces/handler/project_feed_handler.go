@@ -0,0 +1,12 @@
+package handler
+
+import "github.com/gin-gonic/gin"
+
+type ProjectFeedHandler interface {
+	// GetFeed returns the "for you" feed of the authenticated user.
+	//
+	// Requires authentication.
+	// Public projects are ranked using the user's likes, the technologies used in
+	// the user's own projects and the people the user has collaborated with.
+	GetFeed(c *gin.Context)
+}
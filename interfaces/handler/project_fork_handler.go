@@ -0,0 +1,14 @@
+package handler
+
+import "github.com/gin-gonic/gin"
+
+type ProjectForkHandler interface {
+	// ForkProject creates a copy of the public project given by the "id" param for the authenticated user.
+	//
+	// Requires authentication.
+	ForkProject(c *gin.Context)
+	// GetForks lists the public forks of the project given by the "id" param.
+	//
+	// Public route, does not require authentication.
+	GetForks(c *gin.Context)
+}
@@ -0,0 +1,13 @@
+package handler
+
+import "github.com/gin-gonic/gin"
+
+// ProjectImageHandler handles the uploaded cover image of a project.
+type ProjectImageHandler interface {
+	// UploadImage stores the multipart "image" file as the cover of the project given by the "id" param.
+	//
+	// Requires authentication.
+	UploadImage(c *gin.Context)
+	// GetImage returns the URLs of every size of the cover of the project given by the "id" param.
+	GetImage(c *gin.Context)
+}
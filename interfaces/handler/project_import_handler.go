@@ -0,0 +1,11 @@
+package handler
+
+import "github.com/gin-gonic/gin"
+
+// ProjectImportHandler creates projects from repositories on a code host.
+type ProjectImportHandler interface {
+	// ImportProject creates a draft project prefilled from the repository url in the body.
+	//
+	// Requires authentication.
+	ImportProject(c *gin.Context)
+}
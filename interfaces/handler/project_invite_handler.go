@@ -0,0 +1,23 @@
+package handler
+
+import "github.com/gin-gonic/gin"
+
+// ProjectInviteHandler handles contributor invitations.
+//
+// All methods require authentication.
+type ProjectInviteHandler interface {
+	// InviteContributors invites users to the project given by the "id" param.
+	// Only owners and maintainers can invite.
+	InviteContributors(c *gin.Context)
+	// GetProjectInvites lists every invite of the project given by the "id" param.
+	// Only owners and maintainers can list them.
+	GetProjectInvites(c *gin.Context)
+	// RevokeInvite cancels the pending invite given by the "inviteId" param.
+	RevokeInvite(c *gin.Context)
+	// GetMyInvites lists the pending invites of the authenticated user.
+	GetMyInvites(c *gin.Context)
+	// AcceptInvite accepts the invite given by the "id" param.
+	AcceptInvite(c *gin.Context)
+	// DeclineInvite declines the invite given by the "id" param.
+	DeclineInvite(c *gin.Context)
+}
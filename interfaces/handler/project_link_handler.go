@@ -0,0 +1,19 @@
+package handler
+
+import "github.com/gin-gonic/gin"
+
+// ProjectLinkHandler handles the results of the link checks of projects.
+type ProjectLinkHandler interface {
+	// GetLinks returns the last check of every link of the project given by the "id" param.
+	//
+	// Requires authentication.
+	GetLinks(c *gin.Context)
+	// GetBrokenLinks returns the projects of the authenticated user with broken links.
+	//
+	// Requires authentication.
+	GetBrokenLinks(c *gin.Context)
+	// GetReport returns the broken links of every project.
+	//
+	// Requires authentication, admins only.
+	GetReport(c *gin.Context)
+}
@@ -0,0 +1,23 @@
+package handler
+
+import "github.com/gin-gonic/gin"
+
+// ProjectModerationHandler handles abuse reports and the moderation queue.
+type ProjectModerationHandler interface {
+	// ReportProject reports the project given by the "id" param.
+	//
+	// Requires authentication.
+	ReportProject(c *gin.Context)
+	// GetQueue returns the projects with open reports.
+	//
+	// Requires authentication, admins only.
+	GetQueue(c *gin.Context)
+	// GetHistory returns the reports and decisions of the project given by the "id" param.
+	//
+	// Requires authentication, admins only.
+	GetHistory(c *gin.Context)
+	// Decide records a decision on the project given by the "id" param.
+	//
+	// Requires authentication, admins only.
+	Decide(c *gin.Context)
+}
@@ -0,0 +1,17 @@
+package handler
+
+import "github.com/gin-gonic/gin"
+
+// ProjectPinHandler handles the pinned projects of the authenticated user.
+//
+// Requires authentication.
+type ProjectPinHandler interface {
+	// GetPinned returns the pinned projects.
+	GetPinned(c *gin.Context)
+	// PinProject pins the project given by the "id" param.
+	PinProject(c *gin.Context)
+	// UnpinProject removes the pin of the project given by the "id" param.
+	UnpinProject(c *gin.Context)
+	// ReorderPins changes the order of the pinned projects.
+	ReorderPins(c *gin.Context)
+}
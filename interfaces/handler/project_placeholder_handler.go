@@ -0,0 +1,16 @@
+package handler
+
+import "github.com/gin-gonic/gin"
+
+// ProjectPlaceholderHandler serves the generated placeholder artwork.
+//
+// Public routes, they do not require authentication.
+type ProjectPlaceholderHandler interface {
+	// GetCoverSVG returns the placeholder cover of the project given by the "id" param as SVG.
+	GetCoverSVG(c *gin.Context)
+	// GetCoverPNG returns the placeholder cover of the project given by the "id" param as PNG.
+	GetCoverPNG(c *gin.Context)
+	// GetAvatar returns the placeholder avatar of the user given by the "file" param,
+	// the username followed by ".svg" or ".png".
+	GetAvatar(c *gin.Context)
+}
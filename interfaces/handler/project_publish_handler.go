@@ -0,0 +1,14 @@
+package handler
+
+import "github.com/gin-gonic/gin"
+
+// ProjectPublishHandler handles publishing drafts.
+//
+// All methods require authentication.
+type ProjectPublishHandler interface {
+	// PublishProject publishes the draft given by the "id" param, or schedules it
+	// when the body has a publish_at.
+	PublishProject(c *gin.Context)
+	// CancelScheduledPublish drops the publish time of the draft given by the "id" param.
+	CancelScheduledPublish(c *gin.Context)
+}
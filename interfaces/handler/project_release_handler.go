@@ -0,0 +1,13 @@
+package handler
+
+import "github.com/gin-gonic/gin"
+
+// ProjectReleaseHandler handles the releases of a project.
+//
+// All methods require authentication.
+type ProjectReleaseHandler interface {
+	// GetReleases lists the releases of the project given by the "id" param, latest first.
+	GetReleases(c *gin.Context)
+	// CreateRelease publishes a new release of the project given by the "id" param.
+	CreateRelease(c *gin.Context)
+}
@@ -0,0 +1,17 @@
+package handler
+
+import "github.com/gin-gonic/gin"
+
+// ProjectRepoMetadataHandler handles the metadata synced from the repository in the github link of a project.
+type ProjectRepoMetadataHandler interface {
+	// GetMetadata returns the synced metadata of the project given by the "id" param.
+	GetMetadata(c *gin.Context)
+	// SyncMetadata fetches the metadata of the project given by the "id" param right away.
+	//
+	// Requires authentication.
+	SyncMetadata(c *gin.Context)
+	// GetSuggestions returns the repository languages and topics the project does not list yet.
+	//
+	// Requires authentication.
+	GetSuggestions(c *gin.Context)
+}
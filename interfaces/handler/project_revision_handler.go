@@ -0,0 +1,15 @@
+package handler
+
+import "github.com/gin-gonic/gin"
+
+// ProjectRevisionHandler handles the revision history of a project.
+//
+// All methods require authentication.
+type ProjectRevisionHandler interface {
+	// GetRevisions lists the revisions of the project given by the "id" param, newest first.
+	GetRevisions(c *gin.Context)
+	// DiffRevisions compares the revisions given by the "from" and "to" query params.
+	DiffRevisions(c *gin.Context)
+	// RestoreRevision writes the revision given by the "rev" param back to the project.
+	RestoreRevision(c *gin.Context)
+}
@@ -0,0 +1,11 @@
+package handler
+
+import "github.com/gin-gonic/gin"
+
+// ProjectStatsHandler handles the admin dashboard statistics.
+type ProjectStatsHandler interface {
+	// GetStats returns the overview of every project.
+	//
+	// Requires authentication, admins only.
+	GetStats(c *gin.Context)
+}
@@ -0,0 +1,22 @@
+package handler
+
+import "github.com/gin-gonic/gin"
+
+// ProjectTransferHandler handles ownership transfers between users.
+//
+// All methods require authentication.
+type ProjectTransferHandler interface {
+	// RequestTransfer proposes a new owner for the project given by the "id" param.
+	// Admins can force the transfer.
+	RequestTransfer(c *gin.Context)
+	// CancelTransfer withdraws the pending transfer of the project given by the "id" param.
+	CancelTransfer(c *gin.Context)
+	// GetProjectTransfers lists the ownership history of the project given by the "id" param.
+	GetProjectTransfers(c *gin.Context)
+	// GetMyTransfers lists the pending transfers offered to the authenticated user.
+	GetMyTransfers(c *gin.Context)
+	// AcceptTransfer accepts the transfer given by the "id" param.
+	AcceptTransfer(c *gin.Context)
+	// DeclineTransfer declines the transfer given by the "id" param.
+	DeclineTransfer(c *gin.Context)
+}
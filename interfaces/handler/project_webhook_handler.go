@@ -0,0 +1,23 @@
+package handler
+
+import "github.com/gin-gonic/gin"
+
+// ProjectWebhookHandler handles the webhooks code hosts call when a project repository changes.
+type ProjectWebhookHandler interface {
+	// GetWebhook returns the webhook of the project given by the "id" param and its latest events.
+	//
+	// Requires authentication.
+	GetWebhook(c *gin.Context)
+	// CreateWebhook creates the webhook of the project given by the "id" param or rotates its secret.
+	//
+	// Requires authentication.
+	CreateWebhook(c *gin.Context)
+	// DeleteWebhook removes the webhook of the project given by the "id" param.
+	//
+	// Requires authentication.
+	DeleteWebhook(c *gin.Context)
+	// ReceiveGitHub accepts GitHub deliveries, they are authenticated by their signature.
+	//
+	// Public route, must not be behind the jwt middleware.
+	ReceiveGitHub(c *gin.Context)
+}
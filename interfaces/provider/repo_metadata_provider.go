@@ -0,0 +1,39 @@
+package provider
+
+import (
+	"context"
+	"time"
+)
+
+// RepoMetadata is what a code host reports about a repository.
+type RepoMetadata struct {
+	// URL is the canonical address of the repository on the host.
+	URL         string
+	Name        string
+	Description string
+	Homepage    string
+	Stars       int
+	Forks       int
+	Language    string
+	// License is the SPDX identifier when the host knows it, otherwise its name.
+	License  string
+	PushedAt *time.Time
+	Topics   []string
+	// Languages are ordered by the amount of code, largest first.
+	Languages []string
+}
+
+// RepoMetadataProvider fetches the metadata of repositories hosted on one code host.
+type RepoMetadataProvider interface {
+	// Name identifies the provider, it is stored with the synced metadata.
+	Name() string
+	// Supports reports whether the link points at a repository on this host.
+	Supports(link string) bool
+	// Fetch returns the metadata of the repository the link points at.
+	//
+	// Returns utils.ErrRepoNotFound when the host does not know the repository and
+	// utils.ErrRepoRateLimited when the host refuses more requests for now.
+	Fetch(ctx context.Context, link string) (*RepoMetadata, error)
+	// FetchReadme returns the README of the repository as written, an empty string when it has none.
+	FetchReadme(ctx context.Context, link string) (string, error)
+}
@@ -0,0 +1,20 @@
+package repository
+
+import "github.com/aruncs31s/esdcprojectmodule/models"
+
+type ProjectAttachmentRepository interface {
+	// GetByProject retrieves the attachments of a project ordered by position.
+	GetByProject(projectID uint) ([]models.ProjectAttachment, error)
+	// GetByID retrieves an attachment of a project.
+	//
+	// Returns gorm.ErrRecordNotFound when it does not belong to the project.
+	GetByID(projectID, id uint) (*models.ProjectAttachment, error)
+	// Create stores the attachment after the last one of the project.
+	Create(attachment *models.ProjectAttachment) error
+	// UpdateCaption changes the caption of an attachment.
+	UpdateCaption(id uint, caption string) error
+	// Reorder sets the positions of the attachments of a project in the given order.
+	Reorder(projectID uint, ids []uint) error
+	// Delete removes an attachment.
+	Delete(id uint) error
+}
@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/aruncs31s/esdcprojectmodule/models"
+)
+
+// ProjectAuditRepository stores the audit log, entries can be added and pruned but never changed.
+type ProjectAuditRepository interface {
+	Create(entry *models.ProjectAuditEntry) error
+	// Get retrieves the entries matching the filter, newest first.
+	Get(filter models.ProjectAuditFilter, limit, offset int) ([]models.ProjectAuditEntry, error)
+	// DeleteBefore removes the entries created before t and returns how many were removed.
+	DeleteBefore(t time.Time) (int64, error)
+}
@@ -0,0 +1,31 @@
+package repository
+
+import (
+	model "github.com/aruncs31s/esdcmodels"
+	"github.com/aruncs31s/esdcprojectmodule/models"
+)
+
+type ProjectBookmarkRepository interface {
+	// GetBookmarks retrieves the bookmarks of the user with their folder, newest first.
+	//
+	// A nil folderID returns the bookmarks of every folder.
+	GetBookmarks(userID uint, folderID *uint, limit, offset int) ([]models.ProjectBookmark, error)
+	// GetProjects retrieves the projects that still exist among projectIDs, including private ones.
+	GetProjects(projectIDs []uint) ([]model.Project, error)
+	// Save adds the bookmark, or moves it to its folder when the project is already bookmarked.
+	Save(bookmark *models.ProjectBookmark) error
+	// Delete removes the bookmark of the project.
+	//
+	// Returns gorm.ErrRecordNotFound when the user did not bookmark it.
+	Delete(userID, projectID uint) error
+	// GetFolders retrieves the folders of the user by name.
+	GetFolders(userID uint) ([]models.ProjectBookmarkFolder, error)
+	// GetFolder retrieves a folder of the user.
+	GetFolder(userID, folderID uint) (models.ProjectBookmarkFolder, error)
+	// FolderNameExists reports whether the user has a folder other than exceptID with the name.
+	FolderNameExists(userID uint, name string, exceptID uint) (bool, error)
+	CreateFolder(folder *models.ProjectBookmarkFolder) error
+	UpdateFolder(folder *models.ProjectBookmarkFolder) error
+	// DeleteFolder removes the folder, its bookmarks are kept without a folder.
+	DeleteFolder(userID, folderID uint) error
+}
@@ -0,0 +1,14 @@
+package repository
+
+import "github.com/aruncs31s/esdcprojectmodule/models"
+
+// ProjectBulkImportRepository creates the projects of a bulk import.
+type ProjectBulkImportRepository interface {
+	// CreateAll creates the projects with their owner, first revision, invites and
+	// schedule in one transaction, setting the ID of each created project.
+	//
+	// Each project is created in a savepoint and the error of a project that failed
+	// is returned under its index. When atomic is set the first failure rolls back
+	// every project and is returned as the error as well.
+	CreateAll(projects []models.NewProject, atomic bool) (map[int]error, error)
+}
@@ -0,0 +1,22 @@
+package repository
+
+import (
+	model "github.com/aruncs31s/esdcmodels"
+	"github.com/aruncs31s/esdcprojectmodule/models"
+)
+
+// ProjectBulkRepository applies one admin operation to many projects.
+type ProjectBulkRepository interface {
+	// GetIDs returns the IDs of the projects matching the filter in ID order, at most limit.
+	GetIDs(filter models.ProjectFilter, limit int) ([]uint, error)
+	// GetProjects retrieves the projects with their tags, whatever their visibility
+	// or status. IDs without a project are left out.
+	GetProjects(ids []uint) ([]model.Project, error)
+	// Apply runs the operation on the projects in one transaction and writes a revision
+	// for each changed project.
+	//
+	// Each project is changed in a savepoint, so a project that fails is rolled back
+	// alone and its error is returned under its ID. A returned error means the
+	// transaction failed and none of the projects were changed.
+	Apply(operation models.BulkOperation, projectIDs []uint) (map[uint]error, error)
+}
@@ -0,0 +1,29 @@
+package repository
+
+import (
+	model "github.com/aruncs31s/esdcmodels"
+	"github.com/aruncs31s/esdcprojectmodule/models"
+)
+
+type ProjectCollectionRepository interface {
+	// GetPublic retrieves the public collections, newest first.
+	GetPublic(limit, offset int) ([]models.ProjectCollection, error)
+	// GetManaged retrieves the collections owned by the user, with the ones run by
+	// the admins when includeAdminOwned is true, newest first.
+	GetManaged(userID uint, includeAdminOwned bool, limit, offset int) ([]models.ProjectCollection, error)
+	// GetBySlug retrieves a collection with its owner.
+	GetBySlug(slug string) (models.ProjectCollection, error)
+	// SlugExists reports whether a collection other than exceptID uses the slug.
+	SlugExists(slug string, exceptID uint) (bool, error)
+	// GetProjects retrieves every project of the collection, in order.
+	GetProjects(collectionID uint) ([]model.Project, error)
+	// GetListedProjects retrieves the projects of the collection everyone can see, in order.
+	GetListedProjects(collectionID uint) ([]model.Project, error)
+	// Create adds the collection with the projects, in order.
+	Create(collection *models.ProjectCollection, projectIDs []uint) error
+	Update(collection *models.ProjectCollection) error
+	// Delete removes the collection with its items.
+	Delete(collectionID uint) error
+	// SetProjects replaces the projects of the collection, in order.
+	SetProjects(collectionID uint, projectIDs []uint) error
+}
@@ -0,0 +1,26 @@
+package repository
+
+import (
+	model "github.com/aruncs31s/esdcmodels"
+	"github.com/aruncs31s/esdcprojectmodule/models"
+)
+
+type ProjectContributorRepository interface {
+	// GetRole returns the role of a contributor.
+	//
+	// Returns gorm.ErrRecordNotFound if the user does not contribute to the project.
+	GetRole(projectID, userID uint) (string, error)
+	// GetContributors retrieves the contributors of a project with their roles.
+	//
+	// Returns:
+	//   - []models.ProjectContributor: The join rows, in the same order as the users.
+	//   - []model.User: The contributing users.
+	//   - error: An error object if any error occurs during the database operation.
+	GetContributors(projectID uint) ([]models.ProjectContributor, []model.User, error)
+	// Add adds a user to the project contributors, or updates the role if already present.
+	Add(projectID, userID uint, role string) error
+	// SetRole changes the role of an existing contributor.
+	SetRole(projectID, userID uint, role string) error
+	// Remove removes a user from the project contributors.
+	Remove(projectID, userID uint) error
+}
@@ -0,0 +1,15 @@
+package repository
+
+import (
+	model "github.com/aruncs31s/esdcmodels"
+	"github.com/aruncs31s/esdcprojectmodule/models"
+)
+
+// ProjectExportRepository reads projects for exports without loading them all at once.
+type ProjectExportRepository interface {
+	// EachProject calls fn with every project matching the filter in ID order, whatever
+	// its visibility or status, with the creator, tags, technologies and contributors loaded.
+	//
+	// Projects are read batchSize at a time, it stops at the first error returned by fn.
+	EachProject(filter models.ProjectFilter, batchSize int, fn func(project model.Project) error) error
+}
@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"time"
+
+	model "github.com/aruncs31s/esdcmodels"
+	"github.com/aruncs31s/esdcprojectmodule/models"
+)
+
+type ProjectFeatureRepository interface {
+	// GetActive retrieves the listed projects featured at now, by position.
+	GetActive(now time.Time, limit, offset int) (*[]model.Project, error)
+	// GetAll retrieves every feature with its project, by position, including
+	// the ones that have not started or have ended.
+	GetAll(limit, offset int) ([]models.ProjectFeature, error)
+	// Save creates the feature of a project or replaces the existing one.
+	Save(feature *models.ProjectFeature) error
+	// Delete removes the feature of a project.
+	//
+	// Returns gorm.ErrRecordNotFound when the project is not featured.
+	Delete(projectID uint) error
+}
@@ -0,0 +1,37 @@
+package repository
+
+import (
+	model "github.com/aruncs31s/esdcmodels"
+)
+
+type ProjectFeedRepository interface {
+	// GetLikedProjects retrieves the projects liked by a user.
+	//
+	// Params:
+	//   - userID: uint - The ID of the user.
+	//
+	// Returns:
+	//   - []model.Project: The liked projects with their tags, technologies and contributors.
+	//   - error: An error object if any error occurs during the database operation.
+	GetLikedProjects(userID uint) ([]model.Project, error)
+	// GetInvolvedProjects retrieves the projects a user created or contributes to.
+	//
+	// Params:
+	//   - userID: uint - The ID of the user.
+	//
+	// Returns:
+	//   - []model.Project: The projects with their tags, technologies and contributors.
+	//   - error: An error object if any error occurs during the database operation.
+	GetInvolvedProjects(userID uint) ([]model.Project, error)
+	// GetFeedCandidates retrieves public projects that can be ranked into a user's feed.
+	//
+	// Params:
+	//   - userID: uint - The ID of the user, projects created by this user are skipped.
+	//   - excludeIDs: []uint - Project IDs that must not be returned.
+	//   - limit: int - The maximum number of candidates to retrieve.
+	//
+	// Returns:
+	//   - []model.Project: The candidate projects.
+	//   - error: An error object if any error occurs during the database operation.
+	GetFeedCandidates(userID uint, excludeIDs []uint, limit int) ([]model.Project, error)
+}
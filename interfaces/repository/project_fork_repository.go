@@ -0,0 +1,19 @@
+package repository
+
+import (
+	model "github.com/aruncs31s/esdcmodels"
+)
+
+type ProjectForkRepository interface {
+	// CreateFork stores the fork with its first revision, makes its creator the owner and increases the fork count of the source.
+	//
+	// fork.ForkedFrom must point to the source project. The fork is stored with fork.Visibility,
+	// public included. All changes are made in a single transaction.
+	CreateFork(fork *model.Project) error
+	// GetForks retrieves the public forks of a project with pagination.
+	GetForks(sourceID uint, limit, offset int) (*[]model.Project, error)
+	// GetForkCounts returns the fork count of each of the given projects.
+	//
+	// Projects that were never forked are missing from the map.
+	GetForkCounts(projectIDs []uint) (map[uint]int, error)
+}
@@ -0,0 +1,15 @@
+package repository
+
+import "github.com/aruncs31s/esdcprojectmodule/models"
+
+type ProjectImageRepository interface {
+	// GetByProject retrieves the cover image of a project.
+	//
+	// Returns gorm.ErrRecordNotFound when the project has none.
+	GetByProject(projectID uint) (*models.ProjectImage, error)
+	// Replace stores the new cover image and points the image column of the project
+	// at coverURL, in a single transaction.
+	//
+	// The replaced image is returned so its files can be deleted, nil when there was none.
+	Replace(image *models.ProjectImage, coverURL string) (*models.ProjectImage, error)
+}
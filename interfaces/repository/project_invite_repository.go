@@ -0,0 +1,24 @@
+package repository
+
+import (
+	"github.com/aruncs31s/esdcprojectmodule/models"
+)
+
+type ProjectInviteRepository interface {
+	// Create stores new invites.
+	Create(invites []models.ProjectInvite) error
+	// GetByID retrieves an invite with its project, invitee and inviter.
+	GetByID(id uint) (*models.ProjectInvite, error)
+	// GetPendingForUser retrieves the pending invites sent to a user.
+	GetPendingForUser(userID uint) ([]models.ProjectInvite, error)
+	// GetByProject retrieves all invites of a project, newest first.
+	GetByProject(projectID uint) ([]models.ProjectInvite, error)
+	// GetPendingInviteeIDs returns the IDs of users that already have a pending invite to the project.
+	GetPendingInviteeIDs(projectID uint) ([]uint, error)
+	// Accept marks the invite as accepted and adds the invitee to the project contributors.
+	//
+	// Both changes are made in a single transaction.
+	Accept(invite *models.ProjectInvite) error
+	// UpdateStatus changes the status of a pending invite.
+	UpdateStatus(id uint, status string) error
+}
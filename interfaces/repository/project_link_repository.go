@@ -0,0 +1,27 @@
+package repository
+
+import (
+	"time"
+
+	model "github.com/aruncs31s/esdcmodels"
+	"github.com/aruncs31s/esdcprojectmodule/models"
+)
+
+// ProjectLinkRepository keeps the results of the link checks of projects.
+type ProjectLinkRepository interface {
+	// GetUnchecked retrieves the projects with a github link or live url that were
+	// not checked since before. Only the ID and the links are loaded.
+	GetUnchecked(before time.Time, limit int) ([]model.Project, error)
+	// GetChecks retrieves the checks of the links of a project.
+	GetChecks(projectID uint) ([]models.ProjectLinkCheck, error)
+	// SaveCheck creates or replaces the check of one link of a project.
+	SaveCheck(check *models.ProjectLinkCheck) error
+	// DeleteCheck removes the check of a link the project no longer has.
+	DeleteCheck(projectID uint, kind string) error
+	// GetBroken retrieves the failing checks whose url is still the current link of
+	// the project, with the project loaded. A non zero createdBy keeps only the
+	// projects of that user.
+	GetBroken(createdBy uint, limit, offset int) ([]models.ProjectLinkCheck, error)
+	// CountChecks returns the number of checked links and how many of them are broken.
+	CountChecks() (checked, broken int64, err error)
+}
@@ -0,0 +1,33 @@
+package repository
+
+import (
+	model "github.com/aruncs31s/esdcmodels"
+	"github.com/aruncs31s/esdcprojectmodule/models"
+)
+
+type ProjectModerationRepository interface {
+	// HasOpenReport reports whether the user has an open report on the project.
+	HasOpenReport(projectID, reporterID uint) (bool, error)
+	// CreateReport stores a report and returns how many distinct users have an
+	// open report on the project, this one included.
+	CreateReport(report *models.ProjectReport) (int, error)
+	// GetQueue retrieves the projects with open reports, most reported first.
+	GetQueue(limit, offset int) ([]models.ProjectReportSummary, error)
+	// GetOpenReports retrieves the open reports of the projects, newest first.
+	GetOpenReports(projectIDs []uint) ([]models.ProjectReport, error)
+	// GetReports retrieves every report of a project with its reporter, newest first.
+	GetReports(projectID uint) ([]models.ProjectReport, error)
+	// GetDecisions retrieves the decisions on a project with their moderator, newest first.
+	GetDecisions(projectID uint) ([]models.ProjectModerationDecision, error)
+	// GetLastHide retrieves the latest hide decision on a project.
+	//
+	// Returns gorm.ErrRecordNotFound when the project was never hidden.
+	GetLastHide(projectID uint) (*models.ProjectModerationDecision, error)
+	// Decide stores the decision, sets the status of the project unless status is
+	// empty and notifies the owner, in a single transaction.
+	//
+	// The status change is written as a revision by the moderator, automatic decisions
+	// leave the modifier of the revision at 0. The open reports of the project are
+	// resolved by the decision unless it is automatic.
+	Decide(decision *models.ProjectModerationDecision, status string, notification *model.Notification) error
+}
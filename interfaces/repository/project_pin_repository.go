@@ -0,0 +1,16 @@
+package repository
+
+import model "github.com/aruncs31s/esdcmodels"
+
+type ProjectPinRepository interface {
+	// GetPinned retrieves the projects the user pinned and still owns, by position.
+	GetPinned(userID uint) ([]model.Project, error)
+	// Pin adds the project after the last pin of the user, pinning it again does nothing.
+	Pin(userID, projectID uint) error
+	// Unpin removes the pin of the project.
+	//
+	// Returns gorm.ErrRecordNotFound when the user did not pin it.
+	Unpin(userID, projectID uint) error
+	// Reorder sets the positions of the pins of the user in the given order.
+	Reorder(userID uint, projectIDs []uint) error
+}
@@ -0,0 +1,26 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/aruncs31s/esdcprojectmodule/models"
+)
+
+// ProjectPublishRepository publishes drafts and keeps their publish schedules.
+type ProjectPublishRepository interface {
+	// Publish makes a draft active and drops its schedule in a single transaction,
+	// a revision is written for the change.
+	//
+	// Returns utils.ErrNotDraft when the project is not a draft.
+	Publish(projectID, publishedBy uint) error
+	// Schedule sets or moves the publish time of a draft.
+	Schedule(schedule *models.ProjectSchedule) error
+	// GetSchedule retrieves the schedule of a project.
+	//
+	// Returns gorm.ErrRecordNotFound when there is none.
+	GetSchedule(projectID uint) (*models.ProjectSchedule, error)
+	// CancelSchedule removes the schedule of a project, if any.
+	CancelSchedule(projectID uint) error
+	// GetDue retrieves the schedules whose publish time is not after now, oldest first.
+	GetDue(now time.Time, limit int) ([]models.ProjectSchedule, error)
+}
@@ -0,0 +1,19 @@
+package repository
+
+import "github.com/aruncs31s/esdcprojectmodule/models"
+
+type ProjectReleaseRepository interface {
+	// Create stores a new release and makes its version the version of the project,
+	// recording the change as a new revision.
+	//
+	// The version is checked against the latest release and the current version of the
+	// project inside the same transaction. utils.ErrVersionNotIncreased is returned when
+	// it is not greater than the latest release or lower than the project version.
+	Create(release *models.ProjectRelease) error
+	// GetReleases retrieves the releases of a project, latest first.
+	GetReleases(projectID uint, limit, offset int) ([]models.ProjectRelease, error)
+	// GetLatest retrieves the latest release of a project.
+	//
+	// Returns gorm.ErrRecordNotFound when the project has no releases.
+	GetLatest(projectID uint) (*models.ProjectRelease, error)
+}
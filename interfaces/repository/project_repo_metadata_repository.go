@@ -0,0 +1,26 @@
+package repository
+
+import (
+	"time"
+
+	model "github.com/aruncs31s/esdcmodels"
+	"github.com/aruncs31s/esdcprojectmodule/models"
+)
+
+// ProjectRepoMetadataRepository keeps the synced metadata of project repositories.
+type ProjectRepoMetadataRepository interface {
+	// Get retrieves the metadata of a project.
+	//
+	// Returns gorm.ErrRecordNotFound when it was never synced.
+	Get(projectID uint) (*models.ProjectRepoMetadata, error)
+	// Save creates or replaces the metadata of a project.
+	Save(metadata *models.ProjectRepoMetadata) error
+	// GetStale retrieves the projects with a github link that was not synced since before,
+	// or whose link changed after the last sync. Only the ID and the link are loaded.
+	GetStale(before time.Time, limit int) ([]model.Project, error)
+	// FindLinked retrieves the projects whose github link mentions the "owner/name"
+	// of a repository, in any case. Only the ID and the link are loaded.
+	//
+	// The match is loose, the caller compares the parsed links.
+	FindLinked(fullName string) ([]model.Project, error)
+}
@@ -2,6 +2,7 @@ package repository
 
 import (
 	model "github.com/aruncs31s/esdcmodels"
+	"github.com/aruncs31s/esdcprojectmodule/models"
 )
 
 type ProjectRepository interface {
@@ -24,7 +25,7 @@ type ProjectRepositoryReader interface {
 	// Used By Public Routes and Homepage
 	// GetPublicProjects retrieves public projects with pagination.
 	//
-	// It fetches projects that are marked as public (visibility = 0).
+	// It fetches projects that are marked as public (visibility = 0) and are not drafts.
 	//
 	// Params:
 	//   - limit: int - The maximum number of projects to retrieve.
@@ -37,7 +38,7 @@ type ProjectRepositoryReader interface {
 
 	// GetUserProjects retrieves projects associated with a specific user with pagination.
 	//
-	// It fetches projects created by the user or public projects.
+	// It fetches projects created by the user or public projects that are not drafts.
 	//
 	// Params:
 	//   - userID: uint - The ID of the user whose projects are to be retrieved.
@@ -54,9 +55,11 @@ type ProjectRepositoryReader interface {
 	// Warning Only For Admin , because it fetches all projects including private ones
 	// GetEssentialInfo retrieves essential information of projects with pagination.
 	//
-	// It selects only the essential fields defined in the Project model.
+	// It selects only the essential fields defined in the Project model, and the creator.
+	// Projects of every visibility and status are included, newest first.
 	//
 	// Params:
+	//   - filter: models.ProjectFilter - The creator, status and visibility to match.
 	//   - limit: int - The maximum number of projects to retrieve.
 	//   - offset: int - The number of projects to skip before starting to collect the result set.
 	//
@@ -66,8 +69,13 @@ type ProjectRepositoryReader interface {
 	//
 	// Used By:
 	// Used By Admin.
-	GetEssentialInfo(limit, offset int) (*[]model.Project, error)
+	GetEssentialInfo(filter models.ProjectFilter, limit, offset int) (*[]model.Project, error)
+	// GetByID retrieves a project by ID, private projects and drafts are reported as gorm.ErrRecordNotFound.
 	GetByID(id uint) (model.Project, error)
+	// GetByIDIncludingPrivate retrieves a project by ID regardless of its visibility.
+	//
+	// Only use it for ownership and permission checks, never to send the project to a client directly.
+	GetByIDIncludingPrivate(id uint) (model.Project, error)
 	//
 	GetProjectsCount() (int, error)
 	// IsLiked checks if a project is liked by a user.
@@ -102,14 +110,39 @@ type ProjectRepositoryMixed interface {
 	FindOrCreateTechnology(name string) (*model.Technologies, error)
 }
 type ProjectRepositoryWriter interface {
-	// Create creates a new project in the database.
+	// Create creates a new project in the database together with its first revision,
+	// owner, schedule and invites in a single transaction, setting the ID of project.Project.
+	//
+	// Params:
+	//   - project: *models.NewProject - A pointer to the project to be created.
+	//
+	// Returns:
+	//   - error: An error object if any error occurs during the database operation.
+	Create(project *models.NewProject) error
+	// Update writes changes to the fields of a project.
+	//
+	// A revision snapshot is written in the same transaction. The version is bumped
+	// only while the project has no releases, after that it follows the latest release.
+	//
+	// Params:
+	//   - projectID: uint - The ID of the project to update.
+	//   - changes: models.ProjectChanges - The new field values, tags and technologies.
+	//
+	// Returns:
+	//   - error: An error object if any error occurs during the database operation.
+	Update(projectID uint, changes models.ProjectChanges) error
+	// Delete removes a project together with the rows the module keeps for it,
+	// its tags, technologies, likes and views.
+	//
+	// Forks of the project are kept as projects of their own, and the fork count
+	// of the project it was forked from goes down.
 	//
 	// Params:
-	//   - project: *commonModules.Project - A pointer to the Project object to be created.
+	//   - projectID: uint - The ID of the project to delete.
 	//
 	// Returns:
 	//   - error: An error object if any error occurs during the database operation.
-	Create(project *model.Project) error
+	Delete(projectID uint) error
 	// LikeProject adds a like from a user to a project.
 
 	LikeProject(userID uint, projectID uint) error
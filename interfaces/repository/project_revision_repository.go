@@ -0,0 +1,14 @@
+package repository
+
+import "github.com/aruncs31s/esdcprojectmodule/models"
+
+// ProjectRevisionRepository reads the revision history of projects.
+//
+// Revisions are written by the project writes themselves, inside the same transaction,
+// and are never changed afterwards.
+type ProjectRevisionRepository interface {
+	// GetRevisions retrieves the revisions of a project, newest first.
+	GetRevisions(projectID uint, limit, offset int) ([]models.ProjectRevision, error)
+	// GetRevision retrieves one revision of a project by its number.
+	GetRevision(projectID uint, revision int) (*models.ProjectRevision, error)
+}
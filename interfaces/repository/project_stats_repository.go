@@ -0,0 +1,29 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/aruncs31s/esdcprojectmodule/models"
+)
+
+// ProjectStatsRepository aggregates every project, whatever its visibility or status,
+// for the admin dashboard.
+type ProjectStatsRepository interface {
+	// GetTotals returns the number of projects and their average likes and views.
+	GetTotals() (*models.ProjectTotals, error)
+	// CountByStatus returns the number of projects of each status.
+	CountByStatus() ([]models.ProjectStatCount, error)
+	// CountByVisibility returns the number of projects of each stored visibility.
+	CountByVisibility() ([]models.ProjectStatCount, error)
+	// CountByCategory returns the number of projects of each category.
+	CountByCategory() ([]models.ProjectStatCount, error)
+	// CountCreatedPerDay returns the number of projects created on each day since since,
+	// days without new projects are left out.
+	CountCreatedPerDay(since time.Time) ([]models.ProjectDayCount, error)
+	// GetTopTags returns the tags used by the most projects, most used first.
+	GetTopTags(limit int) ([]models.ProjectStatCount, error)
+	// GetTopTechnologies returns the technologies used by the most projects, most used first.
+	GetTopTechnologies(limit int) ([]models.ProjectStatCount, error)
+	// GetTopCreators returns the users who created the most projects, most projects first.
+	GetTopCreators(limit int) ([]models.ProjectCreatorStat, error)
+}
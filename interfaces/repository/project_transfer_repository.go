@@ -0,0 +1,25 @@
+package repository
+
+import "github.com/aruncs31s/esdcprojectmodule/models"
+
+type ProjectTransferRepository interface {
+	// Create stores a new transfer.
+	Create(transfer *models.ProjectTransfer) error
+	// GetByID retrieves a transfer with its project and users.
+	GetByID(id uint) (*models.ProjectTransfer, error)
+	// GetPendingForUser retrieves the pending transfers offered to a user.
+	GetPendingForUser(userID uint) ([]models.ProjectTransfer, error)
+	// GetPendingForProject retrieves the pending transfer of a project.
+	//
+	// Returns gorm.ErrRecordNotFound when there is none.
+	GetPendingForProject(projectID uint) (*models.ProjectTransfer, error)
+	// GetByProject retrieves every transfer of a project, newest first.
+	GetByProject(projectID uint) ([]models.ProjectTransfer, error)
+	// UpdateStatus changes the status of a pending transfer.
+	UpdateStatus(id uint, status string) error
+	// Complete accepts the transfer and hands the project over in a single transaction.
+	//
+	// created_by is set to the new owner, the new owner gets the owner role and the old owner
+	// stays on as a maintainer.
+	Complete(transfer *models.ProjectTransfer) error
+}
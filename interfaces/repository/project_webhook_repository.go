@@ -0,0 +1,30 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/aruncs31s/esdcprojectmodule/models"
+)
+
+// ProjectWebhookRepository keeps the webhook secrets of projects and the deliveries they received.
+type ProjectWebhookRepository interface {
+	// Get retrieves the webhook of a project.
+	//
+	// Returns gorm.ErrRecordNotFound when the project has none.
+	Get(projectID uint) (*models.ProjectWebhook, error)
+	// Save creates the webhook of a project or replaces its secret.
+	Save(webhook *models.ProjectWebhook) error
+	// Delete removes the webhook of a project, the recorded events are kept.
+	Delete(projectID uint) error
+	// FindByRepo retrieves the webhooks of projects whose github link mentions the
+	// repository, with the project loaded. The links still have to be compared exactly.
+	FindByRepo(fullName string) ([]models.ProjectWebhook, error)
+	// HasEvent reports whether the delivery was already recorded for the project.
+	HasEvent(projectID uint, deliveryID string) (bool, error)
+	// RecordEvent stores an accepted delivery.
+	RecordEvent(event *models.ProjectWebhookEvent) error
+	// GetEvents retrieves the recorded deliveries of a project, newest first.
+	GetEvents(projectID uint, limit, offset int) ([]models.ProjectWebhookEvent, error)
+	// TouchActivity moves the last activity of the project to at, unless it is already later.
+	TouchActivity(projectID uint, at time.Time) error
+}
@@ -5,7 +5,7 @@ import (
 )
 
 type PublicProjectRepository interface {
-	// GetAllProjects retrieves all public projects.
+	// GetAllProjects retrieves all public projects, drafts are left out.
 	//
 	// Params:
 	//  - limit: int - The maximum number of projects to retrieve.
@@ -15,6 +15,8 @@ type PublicProjectRepository interface {
 	// - error - An error if the retrieval fails.
 	GetAllProjects(limit, offset int) (*[]model.Project, error)
 
+	// GetUserProjects retrieves the projects of the user and the listed projects,
+	// the projects the user pinned first.
 	GetUserProjects(user uint, limit, offset int) (*[]model.Project, error)
 	// CreateProject is used to create a new project with the provided details.
 	//
@@ -26,4 +28,6 @@ type PublicProjectRepository interface {
 	// - *commonModules.Project - The created project.
 	// - error - An error if the creation fails.
 	GetProject(id uint) (*model.Project, error)
+	// GetCategory returns the category of a listed project without loading its relations.
+	GetCategory(id uint) (string, error)
 }
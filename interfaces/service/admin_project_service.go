@@ -0,0 +1,20 @@
+package service
+
+import "github.com/aruncs31s/esdcprojectmodule/dto"
+
+// AdminProjectService manages every project, whoever owns it and whatever its visibility or status.
+//
+// Admins only. The routes are meant to sit behind an admin middleware, the role is checked here as well.
+type AdminProjectService interface {
+	// GetProjects lists the projects matching the filter, newest first.
+	GetProjects(username string, filter dto.AdminProjectFilter, limit, offset int) ([]dto.ProjectsEssentialInfo, error)
+	// UpdateProject changes the fields that are set in the request without
+	// the permission checks of the owner, including moving drafts out of draft.
+	UpdateProject(username string, projectID uint, project dto.ProjectUpdate) (*dto.ProjectsEssentialInfo, error)
+	// SetVisibility makes a project public or private.
+	SetVisibility(username string, projectID uint, visibility string) (*dto.ProjectsEssentialInfo, error)
+	// SetStatus changes the status of a project.
+	SetStatus(username string, projectID uint, status string) (*dto.ProjectsEssentialInfo, error)
+	// DeleteProject removes a project and its uploaded files.
+	DeleteProject(username string, projectID uint) error
+}
@@ -0,0 +1,30 @@
+package service
+
+import (
+	"io"
+
+	"github.com/aruncs31s/esdcprojectmodule/dto"
+)
+
+// ProjectAttachmentService manages the gallery images and files of projects.
+//
+// Listing and downloading need a contributor role, changes need the edit permission.
+type ProjectAttachmentService interface {
+	// GetAttachments returns the gallery and the files of a project.
+	GetAttachments(username string, projectID uint) (*dto.ProjectAttachments, error)
+	// UploadAttachment stores a file, its kind is worked out from the name and the content.
+	//
+	// Each kind has its own size limit.
+	UploadAttachment(username string, projectID uint, fileName, caption string, file io.Reader) (*dto.ProjectAttachment, error)
+	// OpenAttachment opens the file of an attachment for download, it needs the same role as listing.
+	//
+	// An empty size opens the uploaded file, otherwise the image size in format,
+	// any format when format is empty.
+	OpenAttachment(username string, projectID, attachmentID uint, size, format string) (*dto.AttachmentFile, error)
+	// UpdateCaption changes the caption of an attachment.
+	UpdateCaption(username string, projectID, attachmentID uint, caption string) (*dto.ProjectAttachment, error)
+	// ReorderAttachments sets the order of every attachment of the project.
+	ReorderAttachments(username string, projectID uint, ids []uint) (*dto.ProjectAttachments, error)
+	// DeleteAttachment removes an attachment and its stored files.
+	DeleteAttachment(username string, projectID, attachmentID uint) error
+}
@@ -0,0 +1,25 @@
+package service
+
+import (
+	"time"
+
+	"github.com/aruncs31s/esdcprojectmodule/dto"
+	"github.com/aruncs31s/esdcprojectmodule/models"
+)
+
+// ProjectAuditService keeps the append-only audit log of the changes made through the module.
+type ProjectAuditService interface {
+	// Snapshot returns the state of the project to keep in an entry, nil when it does not exist.
+	Snapshot(projectID uint) (*models.ProjectAuditSnapshot, error)
+	// Record adds an entry, the actor is looked up from entry.Actor.
+	Record(entry *models.ProjectAuditEntry) error
+	// RecordSystem adds an entry for a change the module made on its own, like
+	// publishing a scheduled draft. before is the snapshot taken ahead of the change.
+	RecordSystem(action string, projectID uint, before *models.ProjectAuditSnapshot) error
+	// GetEntries returns the entries matching the filter, newest first.
+	//
+	// Admins only.
+	GetEntries(username string, filter dto.ProjectAuditFilter, limit, offset int) ([]dto.ProjectAuditEntry, error)
+	// Prune removes the entries older than the retention at now and returns how many were removed.
+	Prune(now time.Time) (int64, error)
+}
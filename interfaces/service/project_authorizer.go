@@ -0,0 +1,17 @@
+package service
+
+import "github.com/aruncs31s/esdcprojectmodule/models"
+
+// ProjectAuthorizer answers "can user U do action A on project P".
+//
+// Every service method that changes a project must ask it before writing.
+type ProjectAuthorizer interface {
+	// RoleOf returns the effective role of the user on the project.
+	//
+	// The creator is always the owner. Returns an empty string when the user does not contribute.
+	RoleOf(userID, projectID uint) (string, error)
+	// Can reports whether the user can do the action on the project.
+	Can(userID, projectID uint, action models.ProjectAction) (bool, error)
+	// Authorize returns utils.ErrActionNotAllowed when the user can not do the action on the project.
+	Authorize(userID, projectID uint, action models.ProjectAction) error
+}
@@ -0,0 +1,23 @@
+package service
+
+import "github.com/aruncs31s/esdcprojectmodule/dto"
+
+// ProjectBookmarkService saves projects for later in folders of the user.
+//
+// Bookmarks are private to the user, they are not counted anywhere.
+type ProjectBookmarkService interface {
+	// GetBookmarks returns the bookmarks of the user, newest first, with the state of each project.
+	//
+	// A nil folderID returns the bookmarks of every folder.
+	GetBookmarks(username string, folderID *uint, limit, offset int) ([]dto.ProjectBookmark, error)
+	// Bookmark saves a project the user can see, or moves the bookmark to another folder.
+	Bookmark(username string, request dto.ProjectBookmarkRequest) (*dto.ProjectBookmark, error)
+	// RemoveBookmark removes the bookmark of a project.
+	RemoveBookmark(username string, projectID uint) error
+	// GetFolders returns the folders of the user by name.
+	GetFolders(username string) ([]dto.ProjectBookmarkFolder, error)
+	CreateFolder(username, name string) (*dto.ProjectBookmarkFolder, error)
+	RenameFolder(username string, folderID uint, name string) (*dto.ProjectBookmarkFolder, error)
+	// DeleteFolder removes a folder, its bookmarks are kept without a folder.
+	DeleteFolder(username string, folderID uint) error
+}
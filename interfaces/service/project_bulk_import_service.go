@@ -0,0 +1,11 @@
+package service
+
+import "github.com/aruncs31s/esdcprojectmodule/dto"
+
+type ProjectBulkImportService interface {
+	// ImportProjects checks each row with the rules of project creation and creates
+	// the projects for their creators. The contributors of a row are invited.
+	//
+	// Admins only.
+	ImportProjects(username string, rows []dto.ProjectImportRow, options dto.ProjectImportOptions) (*dto.ProjectImportResult, error)
+}
@@ -0,0 +1,12 @@
+package service
+
+import "github.com/aruncs31s/esdcprojectmodule/dto"
+
+type ProjectBulkService interface {
+	// Run applies the operation to every selected project, in chunks of one
+	// transaction each, and reports the outcome for each project. A dry run
+	// checks the projects without changing them.
+	//
+	// Admins only.
+	Run(username string, request dto.BulkProjectRequest) (*dto.BulkProjectResult, error)
+}
@@ -0,0 +1,24 @@
+package service
+
+import "github.com/aruncs31s/esdcprojectmodule/dto"
+
+// ProjectCollectionService groups projects into named, ordered collections.
+//
+// Collections only show the projects the viewer can see, projects that are made
+// private, hidden or deleted drop out of them.
+type ProjectCollectionService interface {
+	// GetPublicCollections returns the public collections, newest first.
+	GetPublicCollections(limit, offset int) ([]dto.ProjectCollection, error)
+	// GetPublicCollection returns a public collection with its listed projects.
+	GetPublicCollection(slug string) (*dto.ProjectCollectionDetail, error)
+	// GetCollections returns the collections the user can change, newest first.
+	GetCollections(username string, limit, offset int) ([]dto.ProjectCollection, error)
+	// GetCollection returns a collection with the projects the user can see.
+	GetCollection(username, slug string) (*dto.ProjectCollectionDetail, error)
+	// CreateCollection adds a collection owned by the user, or by the admins when an admin asks for it.
+	CreateCollection(username string, request dto.ProjectCollectionRequest) (*dto.ProjectCollectionDetail, error)
+	UpdateCollection(username, slug string, request dto.ProjectCollectionUpdate) (*dto.ProjectCollectionDetail, error)
+	DeleteCollection(username, slug string) error
+	// SetProjects replaces the projects of the collection, in order.
+	SetProjects(username, slug string, projectIDs []uint) (*dto.ProjectCollectionDetail, error)
+}
@@ -0,0 +1,16 @@
+package service
+
+import "github.com/aruncs31s/esdcprojectmodule/dto"
+
+type ProjectContributorService interface {
+	// GetContributors returns the contributors of a project with their roles.
+	GetContributors(username string, projectID uint) ([]dto.ProjectContributor, error)
+	// UpdateRole changes the role of a contributor.
+	//
+	// Requires the manage contributors permission, and the user can only grant or change roles below their own.
+	UpdateRole(username string, projectID, contributorID uint, role string) error
+	// RemoveContributor removes a contributor from the project.
+	//
+	// Contributors can always remove themselves, the owner can not be removed.
+	RemoveContributor(username string, projectID, contributorID uint) error
+}
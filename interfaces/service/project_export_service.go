@@ -0,0 +1,15 @@
+package service
+
+import "github.com/aruncs31s/esdcprojectmodule/dto"
+
+// ProjectExportService reads projects one by one for exports, so large exports
+// can be written out as they are read.
+type ProjectExportService interface {
+	// ExportProjects calls each with every project matching the filter of the admin list.
+	//
+	// Admins only, the admin check fails before each is called.
+	ExportProjects(username string, filter dto.AdminProjectFilter, each func(row dto.ProjectExportRow) error) error
+	// ExportMyProjects calls each with every project created by the user matching
+	// the status and visibility of the filter, the creator of the filter is ignored.
+	ExportMyProjects(username string, filter dto.AdminProjectFilter, each func(row dto.ProjectExportRow) error) error
+}
@@ -0,0 +1,20 @@
+package service
+
+import "github.com/aruncs31s/esdcprojectmodule/dto"
+
+type ProjectFeatureService interface {
+	// GetFeatured returns the listed projects featured now, by position.
+	GetFeatured(limit, offset int) ([]dto.ProjectResponseForPublic, error)
+	// GetFeatures returns every feature, including the ones that have not started or have ended.
+	//
+	// Admins only.
+	GetFeatures(username string, limit, offset int) ([]dto.ProjectFeature, error)
+	// FeatureProject features a project or changes its position and date range.
+	//
+	// Admins only.
+	FeatureProject(username string, projectID uint, request dto.ProjectFeatureRequest) (*dto.ProjectFeature, error)
+	// UnfeatureProject takes a project off the homepage.
+	//
+	// Admins only.
+	UnfeatureProject(username string, projectID uint) error
+}
@@ -0,0 +1,10 @@
+package service
+
+import "github.com/aruncs31s/esdcprojectmodule/dto"
+
+type ProjectFeedService interface {
+	// GetFeed returns public projects ranked for the given user.
+	//
+	// Projects the user created, contributes to or already liked are never included.
+	GetFeed(username string, limit, offset int) ([]*dto.ProjectResponse, error)
+}
@@ -0,0 +1,12 @@
+package service
+
+import "github.com/aruncs31s/esdcprojectmodule/dto"
+
+type ProjectForkService interface {
+	// ForkProject creates a copy of a public project owned by the user.
+	//
+	// The title (with a suffix), description, tags, technologies and category are copied.
+	ForkProject(username string, projectID uint) (*dto.ProjectResponse, error)
+	// GetForks returns the public forks of a public project.
+	GetForks(projectID uint, limit, offset int) (*[]dto.ProjectResponseForPublic, error)
+}
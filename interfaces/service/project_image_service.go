@@ -0,0 +1,18 @@
+package service
+
+import (
+	"io"
+
+	"github.com/aruncs31s/esdcprojectmodule/dto"
+)
+
+type ProjectImageService interface {
+	// UploadImage stores a new cover image for the project with its resized and WebP variants.
+	//
+	// contentType is the type the client declared, the file itself is checked as well.
+	UploadImage(username string, projectID uint, file io.Reader, contentType string) (*dto.ProjectImage, error)
+	// GetImage returns the URLs of the cover image of a project.
+	//
+	// Without a username only listed projects are allowed.
+	GetImage(username string, projectID uint) (*dto.ProjectImage, error)
+}
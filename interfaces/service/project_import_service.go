@@ -0,0 +1,14 @@
+package service
+
+import "github.com/aruncs31s/esdcprojectmodule/dto"
+
+type ProjectImportService interface {
+	// Import creates a draft project prefilled from the repository at url.
+	//
+	// The name, description or README, homepage, topics and languages of the repository
+	// become the title, description, live url, tags and technologies. The user reviews
+	// the draft and publishes it.
+	//
+	// Returns utils.ErrRepoAlreadyLinked when a project the user can view already links to the repository.
+	Import(username, url string) (*dto.ProjectResponse, error)
+}
@@ -0,0 +1,22 @@
+package service
+
+import "github.com/aruncs31s/esdcprojectmodule/dto"
+
+type ProjectInviteService interface {
+	// InviteContributors creates pending invites for the given usernames with the given role.
+	//
+	// Requires the manage contributors permission, and only roles below the inviter's own can be given.
+	// Unknown usernames are reported instead of failing the request,
+	// users that already contribute or have a pending invite are skipped.
+	InviteContributors(username string, projectID uint, usernames []string, role string) (*dto.InviteContributorsResult, error)
+	// GetMyInvites returns the pending invites sent to the user.
+	GetMyInvites(username string) ([]dto.ProjectInvite, error)
+	// GetProjectInvites returns every invite of a project. Requires the manage contributors permission.
+	GetProjectInvites(username string, projectID uint) ([]dto.ProjectInvite, error)
+	// AcceptInvite adds the user to the project contributors.
+	AcceptInvite(username string, inviteID uint) error
+	// DeclineInvite declines a pending invite sent to the user.
+	DeclineInvite(username string, inviteID uint) error
+	// RevokeInvite cancels a pending invite. Requires the manage contributors permission.
+	RevokeInvite(username string, projectID, inviteID uint) error
+}
@@ -0,0 +1,21 @@
+package service
+
+import (
+	"time"
+
+	"github.com/aruncs31s/esdcprojectmodule/dto"
+)
+
+type ProjectLinkService interface {
+	// GetLinks returns the last check of every link of a project.
+	GetLinks(username string, projectID uint) ([]dto.LinkHealth, error)
+	// GetBrokenLinks returns the projects of the user with a link that failed its last check.
+	GetBrokenLinks(username string, limit, offset int) ([]dto.ProjectBrokenLinks, error)
+	// GetReport returns the broken links of every project.
+	//
+	// Admins only.
+	GetReport(username string, limit, offset int) (*dto.LinkReport, error)
+	// CheckStale checks the links of every project that was not checked within the max
+	// age of the service and returns how many projects were checked.
+	CheckStale(now time.Time) (int, error)
+}
@@ -0,0 +1,24 @@
+package service
+
+import "github.com/aruncs31s/esdcprojectmodule/dto"
+
+type ProjectModerationService interface {
+	// ReportProject files a report against a listed project.
+	//
+	// Once enough distinct users have an open report on the project it is hidden
+	// until a moderator reviews it.
+	ReportProject(username string, projectID uint, report dto.ProjectReportRequest) (*dto.ProjectReport, error)
+	// GetQueue returns the projects with open reports, most reported first.
+	//
+	// Admins only.
+	GetQueue(username string, limit, offset int) ([]dto.ModerationQueueEntry, error)
+	// GetHistory returns every report and decision on a project, also after it was removed.
+	//
+	// Admins only.
+	GetHistory(username string, projectID uint) (*dto.ProjectModerationHistory, error)
+	// Decide hides, warns about, removes or dismisses the reports of a project,
+	// resolving its open reports and notifying the owner.
+	//
+	// Admins only.
+	Decide(username string, projectID uint, decision dto.ModerationDecisionRequest) (*dto.ModerationDecision, error)
+}
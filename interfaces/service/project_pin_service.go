@@ -0,0 +1,15 @@
+package service
+
+import "github.com/aruncs31s/esdcprojectmodule/dto"
+
+// ProjectPinService pins projects of a user so they come first in their profile list.
+type ProjectPinService interface {
+	// GetPinned returns the pinned projects of the user, by position.
+	GetPinned(username string) ([]dto.ProjectResponseForPublic, error)
+	// PinProject pins a project owned by the user after the other pins.
+	PinProject(username string, projectID uint) ([]dto.ProjectResponseForPublic, error)
+	// UnpinProject removes the pin of a project.
+	UnpinProject(username string, projectID uint) error
+	// ReorderPins sets the order of the pins, projectIDs must list every pinned project once.
+	ReorderPins(username string, projectIDs []uint) ([]dto.ProjectResponseForPublic, error)
+}
@@ -0,0 +1,13 @@
+package service
+
+import "github.com/aruncs31s/esdcprojectmodule/imaging"
+
+// ProjectPlaceholderService generates the artwork shown when a project or user has no image.
+type ProjectPlaceholderService interface {
+	// Cover returns the placeholder cover of a project, tinted by its category.
+	//
+	// Projects that are not listed get the untinted cover, so nothing about them is revealed.
+	Cover(projectID uint) (imaging.Identicon, error)
+	// Avatar returns the placeholder avatar of a user.
+	Avatar(username string) imaging.Identicon
+}
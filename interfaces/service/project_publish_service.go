@@ -0,0 +1,20 @@
+package service
+
+import (
+	"time"
+
+	"github.com/aruncs31s/esdcprojectmodule/dto"
+)
+
+type ProjectPublishService interface {
+	// Publish makes a draft live, or schedules it when publishAt is given.
+	//
+	// Scheduling again moves the publish time.
+	Publish(username string, projectID uint, publishAt *time.Time) (*dto.ProjectPublication, error)
+	// CancelSchedule keeps the project a draft and drops its publish time.
+	CancelSchedule(username string, projectID uint) error
+	// PublishDue publishes every draft whose publish time is not after now.
+	//
+	// Returns the number of projects published, it is called periodically by a background job of the module.
+	PublishDue(now time.Time) (int, error)
+}
@@ -0,0 +1,10 @@
+package service
+
+import "github.com/aruncs31s/esdcprojectmodule/dto"
+
+type ProjectReleaseService interface {
+	// GetReleases returns the releases of a project, latest first.
+	GetReleases(username string, projectID uint, limit, offset int) ([]dto.ProjectRelease, error)
+	// CreateRelease publishes a new release, its version becomes the version of the project.
+	CreateRelease(username string, projectID uint, release dto.ReleaseCreation) (*dto.ProjectRelease, error)
+}
@@ -0,0 +1,22 @@
+package service
+
+import (
+	"time"
+
+	"github.com/aruncs31s/esdcprojectmodule/dto"
+)
+
+type ProjectRepoMetadataService interface {
+	// GetMetadata returns the synced metadata of the repository of a project.
+	//
+	// Without a username only listed projects are allowed.
+	GetMetadata(username string, projectID uint) (*dto.RepoMetadata, error)
+	// Sync fetches the metadata of the repository of a project right away.
+	Sync(username string, projectID uint) (*dto.RepoMetadata, error)
+	// GetSuggestions returns the languages and topics of the repository as technologies
+	// and tags, leaving out the ones the project already has.
+	GetSuggestions(username string, projectID uint) (*dto.RepoSuggestions, error)
+	// SyncStale syncs every project whose metadata is older than the max age of the service and returns
+	// how many were synced. It stops early when the code host rate limits the requests.
+	SyncStale(now time.Time) (int, error)
+}
@@ -0,0 +1,15 @@
+package service
+
+import "github.com/aruncs31s/esdcprojectmodule/dto"
+
+type ProjectRevisionService interface {
+	// GetRevisions returns the revision history of a project, newest first.
+	GetRevisions(username string, projectID uint, limit, offset int) ([]dto.ProjectRevision, error)
+	// DiffRevisions returns the fields that changed between two revisions.
+	DiffRevisions(username string, projectID uint, from, to int) (*dto.RevisionDiff, error)
+	// RestoreRevision writes the content of an old revision back to the project.
+	//
+	// Restoring is a write like any other, so it adds a new revision and bumps the version
+	// of projects that have no releases yet.
+	RestoreRevision(username string, projectID uint, revision int) (*dto.ProjectResponse, error)
+}
@@ -14,6 +14,9 @@ type ProjectService interface {
 	// Requires authentication
 	GetUserProjects(limit, offset int, username string) ([]*dto.ProjectResponse, error)
 	ToggleLikeProject(username string, projectID uint) (bool, error) // Returns true if liked, false if unliked
-	// UpdateProject(id int, project model.Project) (model.Project, error)
+	// UpdateProject changes the fields that are set in the request.
+	//
+	// Requires the edit permission, and the change visibility permission when the visibility changes.
+	UpdateProject(username string, projectID uint, project dto.ProjectUpdate) (*dto.ProjectResponse, error)
 	// DeleteProject(id int) error
 }
@@ -0,0 +1,10 @@
+package service
+
+import "github.com/aruncs31s/esdcprojectmodule/dto"
+
+type ProjectStatsService interface {
+	// GetStats returns the overview of every project for the admin dashboard.
+	//
+	// Admins only.
+	GetStats(username string) (*dto.ProjectStats, error)
+}
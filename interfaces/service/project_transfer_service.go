@@ -0,0 +1,21 @@
+package service
+
+import "github.com/aruncs31s/esdcprojectmodule/dto"
+
+type ProjectTransferService interface {
+	// RequestTransfer proposes a new owner for the project.
+	//
+	// Only the owner can propose, the new owner has to accept. Admins can force the
+	// transfer, which completes it at once.
+	RequestTransfer(username string, projectID uint, newOwner string, force bool) (*dto.ProjectTransfer, error)
+	// GetMyTransfers returns the pending transfers offered to the user.
+	GetMyTransfers(username string) ([]dto.ProjectTransfer, error)
+	// GetProjectTransfers returns the ownership history of the project.
+	GetProjectTransfers(username string, projectID uint) ([]dto.ProjectTransfer, error)
+	// AcceptTransfer makes the user the owner of the project.
+	AcceptTransfer(username string, transferID uint) error
+	// DeclineTransfer declines a transfer offered to the user.
+	DeclineTransfer(username string, transferID uint) error
+	// CancelTransfer withdraws the pending transfer of the project.
+	CancelTransfer(username string, projectID uint) error
+}
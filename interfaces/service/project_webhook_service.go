@@ -0,0 +1,20 @@
+package service
+
+import "github.com/aruncs31s/esdcprojectmodule/dto"
+
+type ProjectWebhookService interface {
+	// GetWebhook returns the webhook of a project with its latest events, without the secret.
+	GetWebhook(username string, projectID uint) (*dto.ProjectWebhook, error)
+	// CreateWebhook creates the webhook of a project, or rotates its secret.
+	//
+	// The project needs a github link, the secret is only returned here.
+	CreateWebhook(username string, projectID uint) (*dto.ProjectWebhook, error)
+	// DeleteWebhook removes the webhook of a project, deliveries for it are refused afterwards.
+	DeleteWebhook(username string, projectID uint) error
+	// ReceiveGitHub applies a GitHub delivery to every project whose github link is the
+	// repository of the delivery and whose secret matches the signature.
+	//
+	// Push events move the last activity of the projects, published releases create a
+	// project release. A delivery that was already received is not applied again.
+	ReceiveGitHub(event, deliveryID, signature string, payload []byte) (*dto.WebhookDelivery, error)
+}
@@ -0,0 +1,20 @@
+package storage
+
+import "io"
+
+// BlobStore stores uploaded files.
+//
+// Keys are slash separated paths like "projects/12/cover/thumb.webp", the
+// store decides where the bytes end up and how they are served.
+type BlobStore interface {
+	// Put stores the data under the key, replacing what was there.
+	Put(key string, data io.Reader, contentType string) error
+	// Open reads the data stored under the key, the caller closes it.
+	//
+	// A missing key returns an error wrapping fs.ErrNotExist.
+	Open(key string) (io.ReadCloser, error)
+	// Delete removes the key, deleting a missing key is not an error.
+	Delete(key string) error
+	// URL returns the address clients can fetch the key from.
+	URL(key string) string
+}
@@ -0,0 +1,46 @@
+package models
+
+import (
+	model "github.com/aruncs31s/esdcmodels"
+	"gorm.io/gorm"
+)
+
+// AutoMigrate creates or updates the tables owned by the project module.
+//
+// The shared tables (projects, users, tags ...) are migrated by the host app.
+func AutoMigrate(db *gorm.DB) error {
+	// Let the Contributors association use the join model with the role column,
+	// otherwise GORM migrates its own project_contributors table without it.
+	if err := db.SetupJoinTable(&model.Project{}, "Contributors", &ProjectContributor{}); err != nil {
+		return err
+	}
+	if err := db.SetupJoinTable(&model.User{}, "Projects", &ProjectContributor{}); err != nil {
+		return err
+	}
+	return db.AutoMigrate(
+		&ProjectInvite{},
+		&ProjectContributor{},
+		&ProjectTransfer{},
+		&ProjectCounter{},
+		&ProjectRevision{},
+		&ProjectRelease{},
+		&ProjectSchedule{},
+		&ProjectImage{},
+		&ProjectAttachment{},
+		&ProjectRepoMetadata{},
+		&ProjectWebhook{},
+		&ProjectWebhookEvent{},
+		&ProjectActivity{},
+		&ProjectLinkCheck{},
+		&ProjectReport{},
+		&ProjectModerationDecision{},
+		&ProjectFeature{},
+		&ProjectPin{},
+		&ProjectCollection{},
+		&ProjectCollectionItem{},
+		&ProjectBookmarkFolder{},
+		&ProjectBookmark{},
+		&ProjectAuditEntry{},
+		&ProjectTrash{},
+	)
+}
@@ -0,0 +1,17 @@
+package models
+
+// ProjectAction is something a user can do on a project.
+type ProjectAction string
+
+const (
+	ActionView               ProjectAction = "view"
+	ActionLike               ProjectAction = "like"
+	ActionEdit               ProjectAction = "edit"
+	ActionManageContributors ProjectAction = "manage_contributors"
+	ActionChangeVisibility   ProjectAction = "change_visibility"
+	ActionDelete             ProjectAction = "delete"
+	ActionTransferOwnership  ProjectAction = "transfer_ownership"
+	ActionPublishRelease     ProjectAction = "publish_release"
+	// ActionViewAttachments is only granted by a role, public projects do not open it up.
+	ActionViewAttachments ProjectAction = "view_attachments"
+)
@@ -0,0 +1,47 @@
+package models
+
+import (
+	"time"
+
+	model "github.com/aruncs31s/esdcmodels"
+)
+
+// Kinds of project attachments.
+const (
+	AttachmentImage    = "image"
+	AttachmentDocument = "document"
+	AttachmentFirmware = "firmware"
+	AttachmentCAD      = "cad"
+	AttachmentArchive  = "archive"
+)
+
+// ProjectAttachment is a file uploaded to a project.
+//
+// Images make up the gallery and get resized variants, everything else is
+// stored as uploaded. Position orders the attachments of a project.
+type ProjectAttachment struct {
+	ID          uint           `gorm:"primaryKey"`
+	ProjectID   uint           `gorm:"column:project_id;not null;index"`
+	Kind        string         `gorm:"column:kind;not null"`
+	Name        string         `gorm:"column:name;not null"`
+	Caption     string         `gorm:"column:caption"`
+	ContentType string         `gorm:"column:content_type"`
+	Size        int64          `gorm:"column:size;not null"`
+	Checksum    string         `gorm:"column:checksum;not null"`
+	Key         string         `gorm:"column:key;not null"`
+	Variants    []ImageVariant `gorm:"column:variants;serializer:json"`
+	Position    int            `gorm:"column:position;not null;default:0"`
+	UploadedBy  uint           `gorm:"column:uploaded_by;not null"`
+	CreatedAt   time.Time      `gorm:"column:created_at;autoCreateTime"`
+
+	Uploader model.User `gorm:"foreignKey:UploadedBy;references:ID"`
+}
+
+func (ProjectAttachment) TableName() string {
+	return "project_attachments"
+}
+
+// IsImage reports whether the attachment belongs to the gallery.
+func (a ProjectAttachment) IsImage() bool {
+	return a.Kind == AttachmentImage
+}
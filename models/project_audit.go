@@ -0,0 +1,118 @@
+package models
+
+import "time"
+
+// ProjectAuditEntry records a change made through the module, entries are never changed.
+//
+// Before and After are snapshots of the project the request changed, either one
+// is nil when the project did not exist at that point or the request did not
+// target a single project. Path tells what else was targeted, like an invite.
+type ProjectAuditEntry struct {
+	ID        uint   `gorm:"primaryKey"`
+	RequestID string `gorm:"column:request_id;index"`
+	// ActorID is nil for requests without a user, like webhook deliveries, and
+	// for changes the module made on its own, whose Actor is AuditSystemActor.
+	ActorID   *uint                 `gorm:"column:actor_id;index"`
+	Actor     string                `gorm:"column:actor"`
+	Action    string                `gorm:"column:action;not null;index"`
+	ProjectID *uint                 `gorm:"column:project_id;index"`
+	Method    string                `gorm:"column:method"`
+	Path      string                `gorm:"column:path"`
+	Status    int                   `gorm:"column:status"`
+	Before    *ProjectAuditSnapshot `gorm:"column:before;serializer:json"`
+	After     *ProjectAuditSnapshot `gorm:"column:after;serializer:json"`
+	CreatedAt time.Time             `gorm:"column:created_at;autoCreateTime;index"`
+}
+
+func (ProjectAuditEntry) TableName() string {
+	return "project_audit_entries"
+}
+
+// ProjectAuditSnapshot is the state of a project kept in the audit log.
+type ProjectAuditSnapshot struct {
+	Title        string   `json:"title"`
+	Description  string   `json:"description"`
+	Image        *string  `json:"image"`
+	GithubLink   string   `json:"github_link"`
+	LiveURL      *string  `json:"live_url"`
+	Category     string   `json:"category"`
+	Status       string   `json:"status"`
+	Visibility   string   `json:"visibility"`
+	Cost         int      `json:"cost"`
+	Version      string   `json:"version"`
+	Likes        int      `json:"likes"`
+	CreatedBy    uint     `json:"created_by"`
+	ForkedFrom   *uint    `json:"forked_from"`
+	Tags         []string `json:"tags"`
+	Technologies []string `json:"technologies"`
+	// Contributors maps the username of each contributor to their role.
+	Contributors map[string]string `json:"contributors"`
+}
+
+// ProjectAuditFilter limits the audit entries returned, zero values do not filter.
+type ProjectAuditFilter struct {
+	ActorID *uint
+	// System only matches the changes the module made on its own.
+	System    bool
+	Action    string
+	ProjectID uint
+	RequestID string
+	From      *time.Time
+	To        *time.Time
+}
+
+// AuditSystemActor is the actor of the changes the module makes on its own,
+// like publishing a scheduled draft.
+const AuditSystemActor = "system"
+
+// Actions of the audit log.
+const (
+	AuditProjectCreate      = "project.create"
+	AuditProjectImport      = "project.import"
+	AuditProjectUpdate      = "project.update"
+	AuditProjectVisibility  = "project.visibility_change"
+	AuditProjectLike        = "project.like"
+	AuditProjectFork        = "project.fork"
+	AuditProjectRestore     = "project.revision_restore"
+	AuditProjectPublish     = "project.publish"
+	AuditProjectUnschedule  = "project.publish_cancel"
+	AuditProjectAutoPublish = "project.scheduled_publish"
+	AuditProjectRelease     = "project.release"
+	AuditProjectSync        = "project.repository_sync"
+	AuditProjectImage       = "project.image_upload"
+	AuditProjectReport      = "project.report"
+	AuditProjectAutoHide    = "project.auto_hide"
+	AuditProjectPin         = "project.pin"
+	AuditProjectUnpin       = "project.unpin"
+	AuditPinReorder         = "pin.reorder"
+	AuditAttachmentUpload   = "attachment.upload"
+	AuditAttachmentReorder  = "attachment.reorder"
+	AuditAttachmentUpdate   = "attachment.update"
+	AuditAttachmentDelete   = "attachment.delete"
+	AuditContributorRole    = "contributor.role_change"
+	AuditContributorRemove  = "contributor.remove"
+	AuditInviteSend         = "invite.send"
+	AuditInviteRevoke       = "invite.revoke"
+	AuditInviteAccept       = "invite.accept"
+	AuditInviteDecline      = "invite.decline"
+	AuditTransferRequest    = "transfer.request"
+	AuditTransferCancel     = "transfer.cancel"
+	AuditTransferAccept     = "transfer.accept"
+	AuditTransferDecline    = "transfer.decline"
+	AuditWebhookCreate      = "webhook.create"
+	AuditWebhookDelete      = "webhook.delete"
+	AuditWebhookDelivery    = "webhook.delivery"
+	AuditCollectionCreate   = "collection.create"
+	AuditCollectionUpdate   = "collection.update"
+	AuditCollectionDelete   = "collection.delete"
+	AuditCollectionProjects = "collection.projects_change"
+	AuditAdminUpdate        = "admin.project_update"
+	AuditAdminDelete        = "admin.project_delete"
+	AuditAdminVisibility    = "admin.visibility_change"
+	AuditAdminStatus        = "admin.status_change"
+	AuditAdminFeature       = "admin.feature"
+	AuditAdminUnfeature     = "admin.unfeature"
+	AuditAdminModeration    = "admin.moderation_decision"
+	AuditAdminBulk          = "admin.bulk"
+	AuditAdminImport        = "admin.project_import"
+)
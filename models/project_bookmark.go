@@ -0,0 +1,44 @@
+package models
+
+import "time"
+
+// ProjectBookmark saves a project for later, only the user who saved it can see it.
+//
+// Bookmarks are kept when the project is deleted or made private, so the user can
+// tell what happened to it. ProjectTitle is the title when the project was saved.
+type ProjectBookmark struct {
+	ID           uint      `gorm:"primaryKey"`
+	UserID       uint      `gorm:"column:user_id;not null;uniqueIndex:idx_project_bookmarks_user_project"`
+	ProjectID    uint      `gorm:"column:project_id;not null;uniqueIndex:idx_project_bookmarks_user_project"`
+	FolderID     *uint     `gorm:"column:folder_id;index"`
+	ProjectTitle string    `gorm:"column:project_title"`
+	CreatedAt    time.Time `gorm:"column:created_at;autoCreateTime"`
+	UpdatedAt    time.Time `gorm:"column:updated_at;autoUpdateTime"`
+
+	Folder *ProjectBookmarkFolder `gorm:"foreignKey:FolderID;references:ID"`
+}
+
+func (ProjectBookmark) TableName() string {
+	return "project_bookmarks"
+}
+
+// ProjectBookmarkFolder groups the bookmarks of a user.
+type ProjectBookmarkFolder struct {
+	ID        uint      `gorm:"primaryKey"`
+	UserID    uint      `gorm:"column:user_id;not null;uniqueIndex:idx_project_bookmark_folders_user_name"`
+	Name      string    `gorm:"column:name;not null;uniqueIndex:idx_project_bookmark_folders_user_name"`
+	CreatedAt time.Time `gorm:"column:created_at;autoCreateTime"`
+	UpdatedAt time.Time `gorm:"column:updated_at;autoUpdateTime"`
+}
+
+func (ProjectBookmarkFolder) TableName() string {
+	return "project_bookmark_folders"
+}
+
+// States of a bookmarked project, as seen by the user who saved it.
+const (
+	BookmarkAvailable = "available"
+	// BookmarkUnavailable projects were made private, or hidden, after they were saved.
+	BookmarkUnavailable = "private"
+	BookmarkDeleted     = "deleted"
+)
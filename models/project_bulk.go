@@ -0,0 +1,37 @@
+package models
+
+// Operations an admin can run on many projects at once.
+const (
+	BulkSetStatus     = "set_status"
+	BulkSetVisibility = "set_visibility"
+	BulkAddTag        = "add_tag"
+	BulkRemoveTag     = "remove_tag"
+	// BulkTrash moves projects to StatusTrashed, BulkRestore brings back the status they had.
+	BulkTrash   = "trash"
+	BulkRestore = "restore"
+	// BulkTransferOwner forces a transfer of each project to a new owner.
+	BulkTransferOwner = "transfer_owner"
+)
+
+// IsBulkOperation reports whether kind is one of the bulk operations.
+func IsBulkOperation(kind string) bool {
+	switch kind {
+	case BulkSetStatus, BulkSetVisibility, BulkAddTag, BulkRemoveTag, BulkTrash, BulkRestore, BulkTransferOwner:
+		return true
+	}
+	return false
+}
+
+// BulkOperation is one operation applied to every selected project, only the
+// field of its kind is used.
+type BulkOperation struct {
+	Kind       string
+	Status     string
+	Visibility int
+	// TagID is the tag added or removed.
+	TagID uint
+	// NewOwnerID is the user the projects are transferred to.
+	NewOwnerID uint
+	// By is the admin running the operation.
+	By uint
+}
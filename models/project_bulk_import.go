@@ -0,0 +1,9 @@
+package models
+
+// How a bulk import commits its projects.
+const (
+	// ImportAllOrNothing creates no project unless every one of them can be created.
+	ImportAllOrNothing = "all_or_nothing"
+	// ImportBestEffort creates the valid projects and reports the others.
+	ImportBestEffort = "best_effort"
+)
@@ -0,0 +1,35 @@
+package models
+
+import (
+	"time"
+
+	model "github.com/aruncs31s/esdcmodels"
+)
+
+// ProjectChanges describes a write to the fields of a project.
+type ProjectChanges struct {
+	// Fields maps column names to their new values.
+	Fields map[string]interface{}
+	// Tags and Technologies replace the current ones when not nil.
+	Tags         *[]model.Tag
+	Technologies *[]model.Technologies
+	ModifiedBy   uint
+	// RestoredFrom is the revision the changes were taken from, if any.
+	RestoredFrom *int
+}
+
+// IsEmpty reports whether there is nothing to write.
+func (c ProjectChanges) IsEmpty() bool {
+	return len(c.Fields) == 0 && c.Tags == nil && c.Technologies == nil
+}
+
+// NewProject is a project ready to be created together with its owner, invites and schedule.
+type NewProject struct {
+	// Project has its creator, tags and technologies set, the creator becomes its owner.
+	Project model.Project
+	// InviteeIDs are invited as editors by InvitedBy.
+	InviteeIDs []uint
+	InvitedBy  uint
+	// PublishAt schedules the publication of a draft.
+	PublishAt *time.Time
+}
@@ -0,0 +1,50 @@
+package models
+
+import (
+	"time"
+
+	model "github.com/aruncs31s/esdcmodels"
+)
+
+// ProjectCollection is a named, ordered list of projects, such as the finalists
+// of an exhibition.
+//
+// Collections without an owner are run by the admins, any admin can change them.
+type ProjectCollection struct {
+	ID          uint    `gorm:"primaryKey"`
+	Slug        string  `gorm:"column:slug;size:100;not null;uniqueIndex"`
+	Title       string  `gorm:"column:title;not null"`
+	Description string  `gorm:"column:description;type:text"`
+	Cover       *string `gorm:"column:cover"`
+	// Visibility takes the values of model.Project.Visibility.
+	Visibility int       `gorm:"column:visibility;not null;default:0;index"`
+	OwnerID    *uint     `gorm:"column:owner_id;index"`
+	CreatedBy  uint      `gorm:"column:created_by;not null"`
+	CreatedAt  time.Time `gorm:"column:created_at;autoCreateTime"`
+	UpdatedAt  time.Time `gorm:"column:updated_at;autoUpdateTime"`
+
+	Owner *model.User `gorm:"foreignKey:OwnerID;references:ID"`
+}
+
+func (ProjectCollection) TableName() string {
+	return "project_collections"
+}
+
+// IsAdminOwned reports whether the collection is run by the admins.
+func (c ProjectCollection) IsAdminOwned() bool {
+	return c.OwnerID == nil
+}
+
+// ProjectCollectionItem puts a project in a collection.
+//
+// Items are sorted by Position, lowest first.
+type ProjectCollectionItem struct {
+	CollectionID uint      `gorm:"column:collection_id;primaryKey;autoIncrement:false"`
+	ProjectID    uint      `gorm:"column:project_id;primaryKey;autoIncrement:false;index"`
+	Position     int       `gorm:"column:position;not null;default:0"`
+	CreatedAt    time.Time `gorm:"column:created_at;autoCreateTime"`
+}
+
+func (ProjectCollectionItem) TableName() string {
+	return "project_collection_items"
+}
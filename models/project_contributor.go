@@ -0,0 +1,35 @@
+package models
+
+// Contributor roles, from the most to the least privileged.
+const (
+	RoleOwner      = "owner"
+	RoleMaintainer = "maintainer"
+	RoleEditor     = "editor"
+	RoleViewer     = "viewer"
+)
+
+// UserRoleAdmin is the value of model.User.Role for site admins.
+const UserRoleAdmin = "admin"
+
+// ProjectContributor is the project_contributors join table with the role of each contributor.
+//
+// The table is also used by the many2many Contributors association of model.Project,
+// this model only adds the role column to it.
+type ProjectContributor struct {
+	ProjectID uint   `gorm:"column:project_id;primaryKey"`
+	UserID    uint   `gorm:"column:user_id;primaryKey"`
+	Role      string `gorm:"column:role;not null;default:'editor'"`
+}
+
+func (ProjectContributor) TableName() string {
+	return "project_contributors"
+}
+
+// IsValidRole reports whether the role is one of the contributor roles.
+func IsValidRole(role string) bool {
+	switch role {
+	case RoleOwner, RoleMaintainer, RoleEditor, RoleViewer:
+		return true
+	}
+	return false
+}
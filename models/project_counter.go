@@ -0,0 +1,11 @@
+package models
+
+// ProjectCounter keeps the counters of a project that have no column on the shared projects table.
+type ProjectCounter struct {
+	ProjectID uint `gorm:"column:project_id;primaryKey;autoIncrement:false"`
+	Forks     int  `gorm:"column:forks;not null;default:0"`
+}
+
+func (ProjectCounter) TableName() string {
+	return "project_counters"
+}
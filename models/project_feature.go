@@ -0,0 +1,47 @@
+package models
+
+import (
+	"time"
+
+	model "github.com/aruncs31s/esdcmodels"
+)
+
+// ProjectFeature puts a project on the homepage between StartsAt and EndsAt.
+//
+// Featured projects are sorted by Position, lowest first.
+type ProjectFeature struct {
+	ID        uint      `gorm:"primaryKey"`
+	ProjectID uint      `gorm:"column:project_id;not null;uniqueIndex"`
+	Position  int       `gorm:"column:position;not null;default:0"`
+	StartsAt  time.Time `gorm:"column:starts_at;not null;index"`
+	// EndsAt is nil for projects featured until they are taken off.
+	EndsAt     *time.Time `gorm:"column:ends_at;index"`
+	FeaturedBy uint       `gorm:"column:featured_by;not null"`
+	CreatedAt  time.Time  `gorm:"column:created_at;autoCreateTime"`
+	UpdatedAt  time.Time  `gorm:"column:updated_at;autoUpdateTime"`
+
+	Project model.Project `gorm:"foreignKey:ProjectID;references:ID"`
+}
+
+func (ProjectFeature) TableName() string {
+	return "project_features"
+}
+
+// IsActive reports whether the project is featured at now.
+func (f ProjectFeature) IsActive(now time.Time) bool {
+	return !f.StartsAt.After(now) && (f.EndsAt == nil || f.EndsAt.After(now))
+}
+
+// ProjectPin puts a project of a user before the others in their profile list.
+//
+// Pins are sorted by Position, lowest first.
+type ProjectPin struct {
+	UserID    uint      `gorm:"column:user_id;primaryKey;autoIncrement:false"`
+	ProjectID uint      `gorm:"column:project_id;primaryKey;autoIncrement:false;index"`
+	Position  int       `gorm:"column:position;not null;default:0"`
+	CreatedAt time.Time `gorm:"column:created_at;autoCreateTime"`
+}
+
+func (ProjectPin) TableName() string {
+	return "project_pins"
+}
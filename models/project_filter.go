@@ -0,0 +1,9 @@
+package models
+
+// ProjectFilter narrows down the projects listed to admins, zero values match every project.
+type ProjectFilter struct {
+	CreatedBy uint
+	Status    string
+	// Visibility is one of VisibilityPublic or VisibilityPrivate when set.
+	Visibility *int
+}
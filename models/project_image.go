@@ -0,0 +1,28 @@
+package models
+
+import "time"
+
+// ImageVariant is one stored file of an uploaded image.
+type ImageVariant struct {
+	// Size is "original" or one of the thumbnail size names.
+	Size   string `json:"size"`
+	Format string `json:"format"`
+	Width  int    `json:"width"`
+	Height int    `json:"height"`
+	Key    string `json:"key"`
+}
+
+// ProjectImage is the uploaded cover image of a project.
+//
+// A project has at most one, uploading again replaces it.
+type ProjectImage struct {
+	ID         uint           `gorm:"primaryKey"`
+	ProjectID  uint           `gorm:"column:project_id;not null;uniqueIndex"`
+	Variants   []ImageVariant `gorm:"column:variants;serializer:json"`
+	UploadedBy uint           `gorm:"column:uploaded_by;not null"`
+	CreatedAt  time.Time      `gorm:"column:created_at;autoCreateTime"`
+}
+
+func (ProjectImage) TableName() string {
+	return "project_images"
+}
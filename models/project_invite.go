@@ -0,0 +1,40 @@
+package models
+
+import (
+	"time"
+
+	model "github.com/aruncs31s/esdcmodels"
+)
+
+// Invite statuses.
+const (
+	InviteStatusPending  = "pending"
+	InviteStatusAccepted = "accepted"
+	InviteStatusDeclined = "declined"
+	InviteStatusRevoked  = "revoked"
+)
+
+// ProjectInvite is a pending request for a user to join a project as a contributor.
+//
+// The invitee is only added to the project's contributors after accepting.
+type ProjectInvite struct {
+	ID          uint          `gorm:"primaryKey"`
+	ProjectID   uint          `gorm:"column:project_id;not null;index"`
+	InviteeID   uint          `gorm:"column:invitee_id;not null;index"`
+	InvitedBy   uint          `gorm:"column:invited_by;not null"`
+	Role        string        `gorm:"column:role;not null;default:'editor'"`
+	Status      string        `gorm:"column:status;not null;default:'pending'"`
+	CreatedAt   time.Time     `gorm:"column:created_at;autoCreateTime"`
+	RespondedAt *time.Time    `gorm:"column:responded_at"`
+	Project     model.Project `gorm:"foreignKey:ProjectID;references:ID"`
+	Invitee     model.User    `gorm:"foreignKey:InviteeID;references:ID"`
+	Inviter     model.User    `gorm:"foreignKey:InvitedBy;references:ID"`
+}
+
+func (ProjectInvite) TableName() string {
+	return "project_invites"
+}
+
+func (i *ProjectInvite) IsPending() bool {
+	return i.Status == InviteStatusPending
+}
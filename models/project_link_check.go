@@ -0,0 +1,35 @@
+package models
+
+import (
+	"time"
+
+	model "github.com/aruncs31s/esdcmodels"
+)
+
+// Links of a project that are checked.
+const (
+	LinkKindGitHub = "github"
+	LinkKindLive   = "live"
+)
+
+// ProjectLinkCheck is the result of the last check of one link of a project.
+type ProjectLinkCheck struct {
+	ID        uint   `gorm:"primaryKey"`
+	ProjectID uint   `gorm:"column:project_id;not null;uniqueIndex:idx_project_link_kind"`
+	Kind      string `gorm:"column:kind;not null;uniqueIndex:idx_project_link_kind"`
+	// URL is the link that was checked, the result is stale once the project links elsewhere.
+	URL        string    `gorm:"column:url;not null"`
+	StatusCode int       `gorm:"column:status_code"`
+	Error      string    `gorm:"column:error"`
+	LatencyMs  int64     `gorm:"column:latency_ms"`
+	Healthy    bool      `gorm:"column:healthy;not null;index"`
+	CheckedAt  time.Time `gorm:"column:checked_at;not null;index"`
+	// FailingSince is when the link started failing, nil while it is healthy.
+	FailingSince *time.Time `gorm:"column:failing_since"`
+
+	Project model.Project `gorm:"foreignKey:ProjectID;references:ID"`
+}
+
+func (ProjectLinkCheck) TableName() string {
+	return "project_link_checks"
+}
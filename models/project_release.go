@@ -0,0 +1,34 @@
+package models
+
+import (
+	"time"
+
+	model "github.com/aruncs31s/esdcmodels"
+)
+
+// ReleaseArtifact is a downloadable file attached to a release.
+type ReleaseArtifact struct {
+	Name string `json:"name"`
+	URL  string `json:"url"`
+}
+
+// ProjectRelease is a published version of a project.
+//
+// Versions are semver and strictly increasing for each project, the latest one
+// is copied to the version column of the project.
+type ProjectRelease struct {
+	ID         uint              `gorm:"primaryKey"`
+	ProjectID  uint              `gorm:"column:project_id;not null;uniqueIndex:idx_project_release_version"`
+	Version    string            `gorm:"column:version;not null;uniqueIndex:idx_project_release_version"`
+	Notes      string            `gorm:"column:notes;type:text"`
+	Artifacts  []ReleaseArtifact `gorm:"column:artifacts;serializer:json"`
+	ReleasedAt time.Time         `gorm:"column:released_at;not null"`
+	CreatedBy  uint              `gorm:"column:created_by;not null"`
+	CreatedAt  time.Time         `gorm:"column:created_at;autoCreateTime"`
+
+	Creator model.User `gorm:"foreignKey:CreatedBy;references:ID"`
+}
+
+func (ProjectRelease) TableName() string {
+	return "project_releases"
+}
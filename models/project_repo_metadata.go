@@ -0,0 +1,28 @@
+package models
+
+import "time"
+
+// ProjectRepoMetadata is what the code host reported about the repository in
+// the github link of a project, at the last sync.
+type ProjectRepoMetadata struct {
+	ProjectID uint   `gorm:"column:project_id;primaryKey;autoIncrement:false"`
+	Provider  string `gorm:"column:provider;not null"`
+	// RepoURL is the link that was synced, a changed link is synced again.
+	RepoURL   string     `gorm:"column:repo_url;not null"`
+	Stars     int        `gorm:"column:stars;not null;default:0"`
+	Forks     int        `gorm:"column:forks;not null;default:0"`
+	Language  string     `gorm:"column:language"`
+	License   string     `gorm:"column:license"`
+	PushedAt  *time.Time `gorm:"column:pushed_at"`
+	Topics    []string   `gorm:"column:topics;serializer:json"`
+	Languages []string   `gorm:"column:languages;serializer:json"`
+	// SyncedAt is the time of the last attempt, SyncError is set when it failed
+	// and the values above are from an earlier sync.
+	SyncedAt  time.Time `gorm:"column:synced_at;not null;index"`
+	SyncError string    `gorm:"column:sync_error"`
+	UpdatedAt time.Time `gorm:"column:updated_at;autoUpdateTime"`
+}
+
+func (ProjectRepoMetadata) TableName() string {
+	return "project_repo_metadata"
+}
@@ -0,0 +1,96 @@
+package models
+
+import (
+	"time"
+
+	model "github.com/aruncs31s/esdcmodels"
+)
+
+// Reasons a project can be reported for.
+const (
+	ReportReasonSpam          = "spam"
+	ReportReasonInappropriate = "inappropriate"
+	ReportReasonCopyright     = "copyright"
+	ReportReasonMalicious     = "malicious"
+	ReportReasonOther         = "other"
+)
+
+// IsReportReason reports whether reason is one of the report reasons.
+func IsReportReason(reason string) bool {
+	switch reason {
+	case ReportReasonSpam, ReportReasonInappropriate, ReportReasonCopyright, ReportReasonMalicious, ReportReasonOther:
+		return true
+	}
+	return false
+}
+
+// Values of ProjectReport.Status.
+const (
+	ReportStatusOpen     = "open"
+	ReportStatusResolved = "resolved"
+)
+
+// ProjectReport is a complaint about a project, open until a moderator decides on it.
+type ProjectReport struct {
+	ID         uint      `gorm:"primaryKey"`
+	ProjectID  uint      `gorm:"column:project_id;not null;index"`
+	ReporterID uint      `gorm:"column:reporter_id;not null"`
+	Reason     string    `gorm:"column:reason;not null"`
+	Details    string    `gorm:"column:details;type:text"`
+	Status     string    `gorm:"column:status;not null;default:'open';index"`
+	DecisionID *uint     `gorm:"column:decision_id"`
+	CreatedAt  time.Time `gorm:"column:created_at;autoCreateTime"`
+
+	Reporter model.User `gorm:"foreignKey:ReporterID;references:ID"`
+}
+
+func (ProjectReport) TableName() string {
+	return "project_reports"
+}
+
+// ProjectReportSummary counts the open reports of a project.
+type ProjectReportSummary struct {
+	ProjectID uint
+	// Reports counts distinct reporters.
+	Reports int
+}
+
+// Actions a moderator can take on a project.
+const (
+	// ModerationHide makes the project unlisted until a moderator restores it.
+	ModerationHide = "hide"
+	// ModerationWarn keeps the project listed, restoring it if it was hidden, and warns the owner.
+	ModerationWarn = "warn"
+	// ModerationRemove deletes the project.
+	ModerationRemove = "remove"
+	// ModerationDismiss closes the reports without action, restoring the project if it was hidden.
+	ModerationDismiss = "dismiss"
+)
+
+// ProjectModerationDecision records what was done about the reports of a project.
+//
+// It is kept after the project is removed, so the title and owner are copied.
+type ProjectModerationDecision struct {
+	ID           uint   `gorm:"primaryKey"`
+	ProjectID    uint   `gorm:"column:project_id;not null;index"`
+	ProjectTitle string `gorm:"column:project_title"`
+	OwnerID      uint   `gorm:"column:owner_id;not null"`
+	Action       string `gorm:"column:action;not null"`
+	Note         string `gorm:"column:note;type:text"`
+	// DecidedBy is nil when the project was hidden automatically.
+	DecidedBy *uint `gorm:"column:decided_by"`
+	// PreviousStatus is the status of the project before the decision, to restore hidden projects.
+	PreviousStatus string    `gorm:"column:previous_status"`
+	CreatedAt      time.Time `gorm:"column:created_at;autoCreateTime"`
+
+	Moderator *model.User `gorm:"foreignKey:DecidedBy;references:ID"`
+}
+
+func (ProjectModerationDecision) TableName() string {
+	return "project_moderation_decisions"
+}
+
+// IsAutomatic reports whether the decision was made without a moderator.
+func (d ProjectModerationDecision) IsAutomatic() bool {
+	return d.DecidedBy == nil
+}
@@ -0,0 +1,30 @@
+package models
+
+import "time"
+
+// ProjectRevision is an immutable snapshot of the content of a project.
+//
+// One is written for every create and every change of the project fields,
+// Revision counts up from 1 for each project.
+type ProjectRevision struct {
+	ID           uint      `gorm:"primaryKey"`
+	ProjectID    uint      `gorm:"column:project_id;not null;uniqueIndex:idx_project_revision"`
+	Revision     int       `gorm:"column:revision;not null;uniqueIndex:idx_project_revision"`
+	Title        string    `gorm:"column:title"`
+	Description  string    `gorm:"column:description"`
+	GithubLink   string    `gorm:"column:github_link"`
+	LiveURL      *string   `gorm:"column:live_url"`
+	Tags         []string  `gorm:"column:tags;serializer:json"`
+	Technologies []string  `gorm:"column:technologies;serializer:json"`
+	Status       string    `gorm:"column:status"`
+	Visibility   int       `gorm:"column:visibility"`
+	Cost         int       `gorm:"column:cost"`
+	Version      string    `gorm:"column:version"`
+	RestoredFrom *int      `gorm:"column:restored_from"`
+	ModifiedBy   uint      `gorm:"column:modified_by;not null"`
+	CreatedAt    time.Time `gorm:"column:created_at;autoCreateTime"`
+}
+
+func (ProjectRevision) TableName() string {
+	return "project_revisions"
+}
@@ -0,0 +1,18 @@
+package models
+
+import "time"
+
+// ProjectSchedule is a pending publish of a draft project.
+//
+// A project has at most one, it is removed once the project is published.
+type ProjectSchedule struct {
+	ProjectID   uint      `gorm:"column:project_id;primaryKey;autoIncrement:false"`
+	PublishAt   time.Time `gorm:"column:publish_at;not null;index"`
+	ScheduledBy uint      `gorm:"column:scheduled_by;not null"`
+	CreatedAt   time.Time `gorm:"column:created_at;autoCreateTime"`
+	UpdatedAt   time.Time `gorm:"column:updated_at;autoUpdateTime"`
+}
+
+func (ProjectSchedule) TableName() string {
+	return "project_schedules"
+}
@@ -0,0 +1,30 @@
+package models
+
+// ProjectStatCount is one group of an aggregate over the projects, like the
+// projects of one status or the projects tagged with one tag.
+type ProjectStatCount struct {
+	Value string
+	Count int64
+}
+
+// ProjectDayCount is the number of projects created on one day.
+type ProjectDayCount struct {
+	// Day is the UTC date, formatted as 2006-01-02.
+	Day   string
+	Count int64
+}
+
+// ProjectCreatorStat sums up the projects of one creator.
+type ProjectCreatorStat struct {
+	UserID   uint
+	Username string
+	Projects int64
+	Likes    int64
+}
+
+// ProjectTotals are the totals over every project.
+type ProjectTotals struct {
+	Projects     int64
+	AverageLikes float64
+	AverageViews float64
+}
@@ -0,0 +1,46 @@
+package models
+
+import (
+	"slices"
+
+	model "github.com/aruncs31s/esdcmodels"
+)
+
+// Values of model.Project.Status used by the module.
+const (
+	StatusActive   = "active"
+	StatusInactive = "inactive"
+	StatusArchived = "archived"
+	// StatusDraft projects are only visible to their owner and contributors
+	// until they are published.
+	StatusDraft = "draft"
+	// StatusHidden projects were hidden by a moderator, or automatically after enough
+	// reports, and are only visible to their owner and contributors until a moderator
+	// restores them.
+	StatusHidden = "hidden"
+	// StatusTrashed projects were put in the trash by an admin and are only visible
+	// to their owner and contributors until an admin restores them.
+	StatusTrashed = "trashed"
+)
+
+// KnownStatuses are every status a project can have.
+var KnownStatuses = []string{StatusActive, StatusInactive, StatusArchived, StatusDraft, StatusHidden, StatusTrashed}
+
+// UnlistedStatuses are the statuses of projects that are never shown to everyone.
+var UnlistedStatuses = []string{StatusDraft, StatusHidden, StatusTrashed}
+
+// IsKnownStatus reports whether status is one of KnownStatuses.
+func IsKnownStatus(status string) bool {
+	return slices.Contains(KnownStatuses, status)
+}
+
+// IsModeratedStatus reports whether only moderators and admins can move a project
+// into or out of status.
+func IsModeratedStatus(status string) bool {
+	return status == StatusHidden || status == StatusTrashed
+}
+
+// IsListed reports whether the project can be shown to everyone.
+func IsListed(project model.Project) bool {
+	return !project.IsPrivate() && !slices.Contains(UnlistedStatuses, project.Status)
+}
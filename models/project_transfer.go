@@ -0,0 +1,41 @@
+package models
+
+import (
+	"time"
+
+	model "github.com/aruncs31s/esdcmodels"
+)
+
+// Transfer statuses.
+const (
+	TransferStatusPending   = "pending"
+	TransferStatusAccepted  = "accepted"
+	TransferStatusDeclined  = "declined"
+	TransferStatusCancelled = "cancelled"
+)
+
+// ProjectTransfer is a request to hand a project over to a new owner.
+//
+// Accepted transfers are kept as the ownership history of the project.
+type ProjectTransfer struct {
+	ID          uint          `gorm:"primaryKey"`
+	ProjectID   uint          `gorm:"column:project_id;not null;index"`
+	FromUserID  uint          `gorm:"column:from_user_id;not null"`
+	ToUserID    uint          `gorm:"column:to_user_id;not null;index"`
+	RequestedBy uint          `gorm:"column:requested_by;not null"`
+	Status      string        `gorm:"column:status;not null;default:'pending'"`
+	Forced      bool          `gorm:"column:forced;not null;default:false"`
+	CreatedAt   time.Time     `gorm:"column:created_at;autoCreateTime"`
+	RespondedAt *time.Time    `gorm:"column:responded_at"`
+	Project     model.Project `gorm:"foreignKey:ProjectID;references:ID"`
+	FromUser    model.User    `gorm:"foreignKey:FromUserID;references:ID"`
+	ToUser      model.User    `gorm:"foreignKey:ToUserID;references:ID"`
+}
+
+func (ProjectTransfer) TableName() string {
+	return "project_transfers"
+}
+
+func (t *ProjectTransfer) IsPending() bool {
+	return t.Status == TransferStatusPending
+}
@@ -0,0 +1,16 @@
+package models
+
+import "time"
+
+// ProjectTrash remembers the status of a project put in the trash, so restoring
+// it brings the status back.
+type ProjectTrash struct {
+	ProjectID      uint      `gorm:"column:project_id;primaryKey;autoIncrement:false"`
+	PreviousStatus string    `gorm:"column:previous_status"`
+	TrashedBy      uint      `gorm:"column:trashed_by;not null"`
+	TrashedAt      time.Time `gorm:"column:trashed_at;autoCreateTime"`
+}
+
+func (ProjectTrash) TableName() string {
+	return "project_trash"
+}
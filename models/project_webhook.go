@@ -0,0 +1,56 @@
+package models
+
+import (
+	"time"
+
+	model "github.com/aruncs31s/esdcmodels"
+)
+
+// ProjectWebhook holds the secret the code host signs deliveries for a project with.
+//
+// A project has at most one, creating it again rotates the secret.
+type ProjectWebhook struct {
+	ProjectID uint      `gorm:"column:project_id;primaryKey;autoIncrement:false"`
+	Secret    string    `gorm:"column:secret;not null"`
+	CreatedBy uint      `gorm:"column:created_by;not null"`
+	CreatedAt time.Time `gorm:"column:created_at;autoCreateTime"`
+	UpdatedAt time.Time `gorm:"column:updated_at;autoUpdateTime"`
+
+	Project model.Project `gorm:"foreignKey:ProjectID;references:ID"`
+}
+
+func (ProjectWebhook) TableName() string {
+	return "project_webhooks"
+}
+
+// ProjectWebhookEvent is a delivery that was accepted for a project.
+//
+// Error is set when the event could not be applied, a release with a version
+// that is not greater than the latest one for example.
+type ProjectWebhookEvent struct {
+	ID         uint      `gorm:"primaryKey"`
+	ProjectID  uint      `gorm:"column:project_id;not null;uniqueIndex:idx_project_webhook_delivery"`
+	DeliveryID string    `gorm:"column:delivery_id;not null;uniqueIndex:idx_project_webhook_delivery"`
+	Event      string    `gorm:"column:event;not null"`
+	Action     string    `gorm:"column:action"`
+	Summary    string    `gorm:"column:summary"`
+	Error      string    `gorm:"column:error"`
+	ReceivedAt time.Time `gorm:"column:received_at;not null"`
+}
+
+func (ProjectWebhookEvent) TableName() string {
+	return "project_webhook_events"
+}
+
+// ProjectActivity is when the repository of a project last changed.
+//
+// Project lists put the recently active projects first, projects without an
+// activity use their updated_at instead.
+type ProjectActivity struct {
+	ProjectID      uint      `gorm:"column:project_id;primaryKey;autoIncrement:false"`
+	LastActivityAt time.Time `gorm:"column:last_activity_at;not null;index"`
+}
+
+func (ProjectActivity) TableName() string {
+	return "project_activity"
+}
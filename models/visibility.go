@@ -0,0 +1,26 @@
+package models
+
+// Values of model.Project.Visibility.
+const (
+	VisibilityPublic  = 0
+	VisibilityPrivate = 1
+)
+
+// ParseVisibility converts the visibility sent by clients to the stored value.
+func ParseVisibility(visibility string) (int, bool) {
+	switch visibility {
+	case "public", "everyone":
+		return VisibilityPublic, true
+	case "private":
+		return VisibilityPrivate, true
+	}
+	return 0, false
+}
+
+// VisibilityName is the reverse of ParseVisibility.
+func VisibilityName(visibility int) string {
+	if visibility == VisibilityPublic {
+		return "public"
+	}
+	return "private"
+}
@@ -1,20 +1,176 @@
 package project
 
 import (
+	"log"
+	"net/http"
+	"time"
+
 	"github.com/aruncs31s/esdcprojectmodule/handler"
+	handlerInterface "github.com/aruncs31s/esdcprojectmodule/interfaces/handler"
+	"github.com/aruncs31s/esdcprojectmodule/interfaces/provider"
+	serviceInterface "github.com/aruncs31s/esdcprojectmodule/interfaces/service"
+	"github.com/aruncs31s/esdcprojectmodule/interfaces/storage"
+	"github.com/aruncs31s/esdcprojectmodule/models"
+	repoProvider "github.com/aruncs31s/esdcprojectmodule/provider"
 	"github.com/aruncs31s/esdcprojectmodule/repository"
 	"github.com/aruncs31s/esdcprojectmodule/routes"
 	"github.com/aruncs31s/esdcprojectmodule/service"
+	blobStorage "github.com/aruncs31s/esdcprojectmodule/storage"
+	projectUtils "github.com/aruncs31s/esdcprojectmodule/utils"
 	userRepo "github.com/aruncs31s/esdcusermodule/repository"
 	"github.com/gin-gonic/gin"
 	"gorm.io/gorm"
 )
 
 type projectModule struct {
-	projectHandler handler.ProjectHandler
-	r              *gin.Engine
+	projectHandler     handler.ProjectHandler
+	feedHandler        handlerInterface.ProjectFeedHandler
+	inviteHandler      handlerInterface.ProjectInviteHandler
+	contributorHandler handlerInterface.ProjectContributorHandler
+	transferHandler    handlerInterface.ProjectTransferHandler
+	forkHandler        handlerInterface.ProjectForkHandler
+	revisionHandler    handlerInterface.ProjectRevisionHandler
+	releaseHandler     handlerInterface.ProjectReleaseHandler
+	publishHandler     handlerInterface.ProjectPublishHandler
+	imageHandler       handlerInterface.ProjectImageHandler
+	attachmentHandler  handlerInterface.ProjectAttachmentHandler
+	placeholderHandler handlerInterface.ProjectPlaceholderHandler
+	repoHandler        handlerInterface.ProjectRepoMetadataHandler
+	importHandler      handlerInterface.ProjectImportHandler
+	webhookHandler     handlerInterface.ProjectWebhookHandler
+	linkHandler        handlerInterface.ProjectLinkHandler
+	adminHandler       handlerInterface.AdminProjectHandler
+	moderationHandler  handlerInterface.ProjectModerationHandler
+	featureHandler     handlerInterface.ProjectFeatureHandler
+	pinHandler         handlerInterface.ProjectPinHandler
+	collectionHandler  handlerInterface.ProjectCollectionHandler
+	bookmarkHandler    handlerInterface.ProjectBookmarkHandler
+	auditHandler       handlerInterface.ProjectAuditHandler
+	statsHandler       handlerInterface.ProjectStatsHandler
+	bulkHandler        handlerInterface.ProjectBulkHandler
+	exportHandler      handlerInterface.ProjectExportHandler
+	bulkImportHandler  handlerInterface.ProjectBulkImportHandler
+	// jobs run in the background until the module is initialized again.
+	jobs []*service.IntervalJob
+	// uploadsDir is served under defaultUploadsURL when the default local blob store is used.
+	uploadsDir string
+	r          *gin.Engine
+}
+
+const (
+	defaultUploadsDir = "uploads"
+	defaultUploadsURL = "/uploads"
+	// defaultAttachmentsDir is never served as static files, attachments are only
+	// downloaded through a route that checks the role of the user.
+	defaultAttachmentsDir = "attachments"
+)
+
+// Option changes how the module is set up.
+type Option func(*options)
+
+type options struct {
+	blobStore         storage.BlobStore
+	attachmentStore   storage.BlobStore
+	defaultImageURLs  projectUtils.DefaultImageURLs
+	repoProvider      provider.RepoMetadataProvider
+	linkCheckClient   *http.Client
+	autoHideReports   int
+	maxPinnedProjects int
+	auditRetention    time.Duration
+}
+
+// WithBlobStore stores uploaded files in store instead of the local uploads directory.
+func WithBlobStore(store storage.BlobStore) Option {
+	return func(o *options) {
+		o.blobStore = store
+	}
+}
+
+// WithAttachmentStore stores project attachments in store instead of the local attachments directory.
+//
+// Attachments are downloaded through the module, the store must not serve them publicly.
+func WithAttachmentStore(store storage.BlobStore) Option {
+	return func(o *options) {
+		o.attachmentStore = store
+	}
+}
+
+// WithDefaultImageURLs changes the URLs returned for users and projects without an image.
+//
+// By default they point at the placeholders generated by the module, a nil builder keeps that default.
+func WithDefaultImageURLs(avatar func(username string) string, cover func(projectID uint) string) Option {
+	return func(o *options) {
+		o.defaultImageURLs = projectUtils.DefaultImageURLs{Avatar: avatar, Cover: cover}
+	}
+}
+
+// WithRepoMetadataProvider fetches the metadata of project repositories, and the
+// repositories to import, from p instead of the public GitHub API without a token.
+func WithRepoMetadataProvider(p provider.RepoMetadataProvider) Option {
+	return func(o *options) {
+		o.repoProvider = p
+	}
+}
+
+// WithLinkCheckClient sends the requests of the link checker with client instead of
+// the default one, which only connects to public addresses.
+func WithLinkCheckClient(client *http.Client) Option {
+	return func(o *options) {
+		o.linkCheckClient = client
+	}
 }
 
+// WithAutoHideThreshold hides a project pending review once reports distinct users
+// have an open report on it, 0 never hides projects automatically.
+func WithAutoHideThreshold(reports int) Option {
+	return func(o *options) {
+		o.autoHideReports = reports
+	}
+}
+
+// WithMaxPinnedProjects changes how many of their projects a user can pin.
+func WithMaxPinnedProjects(n int) Option {
+	return func(o *options) {
+		o.maxPinnedProjects = n
+	}
+}
+
+// WithAuditRetention changes how long audit entries are kept, 0 keeps them forever.
+func WithAuditRetention(retention time.Duration) Option {
+	return func(o *options) {
+		o.auditRetention = retention
+	}
+}
+
+const (
+	// publishCheckInterval is how often the background job looks for drafts to publish.
+	publishCheckInterval = time.Minute
+	// repoSyncInterval is how often the background job looks for stale repository metadata,
+	// metadata older than repoMetadataMaxAge is synced again.
+	repoSyncInterval   = 15 * time.Minute
+	repoMetadataMaxAge = 6 * time.Hour
+	// linkCheckInterval is how often the background job looks for links to check, links
+	// checked longer than linkCheckMaxAge ago are checked again.
+	linkCheckInterval = 30 * time.Minute
+	linkCheckMaxAge   = 24 * time.Hour
+	// The link checker sends at most one request every linkRequestInterval, and at
+	// most linkRequestsPerHost at once to one host.
+	linkRequestInterval = 200 * time.Millisecond
+	linkRequestsPerHost = 2
+	linkRequestTimeout  = 10 * time.Second
+	// defaultAutoHideReports is the number of distinct reporters that hides a project
+	// unless WithAutoHideThreshold is passed.
+	defaultAutoHideReports = 5
+	// defaultMaxPinnedProjects is how many projects a user can pin unless WithMaxPinnedProjects is passed.
+	defaultMaxPinnedProjects = 6
+	// auditPruneInterval is how often entries past the retention are removed from the audit log.
+	auditPruneInterval = 6 * time.Hour
+	// defaultAuditRetention is how long audit entries are kept unless WithAuditRetention is passed.
+	defaultAuditRetention = 365 * 24 * time.Hour
+	// statsCacheTTL is how long the admin statistics are served before they are computed again.
+	statsCacheTTL = time.Minute
+)
+
 var projectInstance *projectModule
 
 // InitProjectModule initializes the project module with the provided Gin engine and GORM database.
@@ -25,24 +181,215 @@ var projectInstance *projectModule
 //   - r: *gin.Engine - The Gin engine to register routes on.
 //
 // - db: *gorm.DB - The GORM database connection.
+//
+// Note: The tables owned by this module are migrated here, it panics if the migration fails.
+// The background jobs that publish scheduled drafts, sync repository metadata, check
+// project links and prune the audit log are started here as well.
+// Uploads go to the local "uploads" directory unless WithBlobStore is passed.
+// Attachments go to the local "attachments" directory, which is not served, unless WithAttachmentStore is passed.
+// Users and projects without an image get generated placeholders unless WithDefaultImageURLs is passed.
+// Projects are hidden pending review after 5 distinct reports unless WithAutoHideThreshold is passed.
+// Owners are told about moderation decisions through the shared notifications table.
+// Every change made through the module routes is written to the audit log, entries
+// are kept for a year unless WithAuditRetention is passed.
 
-func InitProjectModule(r *gin.Engine, db *gorm.DB) {
+func InitProjectModule(r *gin.Engine, db *gorm.DB, opts ...Option) {
+	moduleOptions := options{
+		autoHideReports:   defaultAutoHideReports,
+		maxPinnedProjects: defaultMaxPinnedProjects,
+		auditRetention:    defaultAuditRetention,
+	}
+	for _, opt := range opts {
+		opt(&moduleOptions)
+	}
+	uploadsDir := ""
+	if moduleOptions.blobStore == nil {
+		uploadsDir = defaultUploadsDir
+		moduleOptions.blobStore = blobStorage.NewLocalBlobStore(defaultUploadsDir, defaultUploadsURL)
+	}
+	if moduleOptions.attachmentStore == nil {
+		moduleOptions.attachmentStore = blobStorage.NewLocalBlobStore(defaultAttachmentsDir, "")
+	}
+	if moduleOptions.linkCheckClient == nil {
+		moduleOptions.linkCheckClient = service.NewLinkCheckClient(linkRequestTimeout)
+	}
+	if moduleOptions.repoProvider == nil {
+		moduleOptions.repoProvider = repoProvider.NewGitHubProvider(repoProvider.GitHubAPIURL, "", nil)
+	}
+	projectUtils.SetDefaultImageURLs(moduleOptions.defaultImageURLs)
+	if err := models.AutoMigrate(db); err != nil {
+		panic("failed to migrate project module tables: " + err.Error())
+	}
 	projectRepository := repository.NewProjectRepository(db)
+	inviteRepository := repository.NewProjectInviteRepository(db)
+	contributorRepository := repository.NewProjectContributorRepository(db)
+	publishRepository := repository.NewProjectPublishRepository(db)
 	userRepository := userRepo.NewUserRepository(db)
-	projectService := service.NewProjectService(projectRepository, userRepository)
+	authorizer := service.NewProjectAuthorizer(projectRepository, contributorRepository, userRepository)
+	projectService := service.NewProjectService(projectRepository, userRepository, authorizer)
 	projectHandler := handler.NewProjectHandler(projectService)
+	feedRepository := repository.NewProjectFeedRepository(db)
+	feedService := service.NewProjectFeedService(feedRepository, userRepository)
+	feedHandler := handler.NewProjectFeedHandler(feedService)
+	inviteService := service.NewProjectInviteService(projectRepository, inviteRepository, userRepository, authorizer)
+	inviteHandler := handler.NewProjectInviteHandler(inviteService)
+	contributorService := service.NewProjectContributorService(projectRepository, contributorRepository, userRepository, authorizer)
+	contributorHandler := handler.NewProjectContributorHandler(contributorService)
+	transferRepository := repository.NewProjectTransferRepository(db)
+	transferService := service.NewProjectTransferService(projectRepository, transferRepository, userRepository, authorizer)
+	transferHandler := handler.NewProjectTransferHandler(transferService)
+	forkRepository := repository.NewProjectForkRepository(db)
+	forkService := service.NewProjectForkService(projectRepository, forkRepository, userRepository)
+	forkHandler := handler.NewProjectForkHandler(forkService)
+	revisionRepository := repository.NewProjectRevisionRepository(db)
+	revisionService := service.NewProjectRevisionService(projectRepository, revisionRepository, userRepository, authorizer)
+	revisionHandler := handler.NewProjectRevisionHandler(revisionService)
+	releaseRepository := repository.NewProjectReleaseRepository(db)
+	releaseService := service.NewProjectReleaseService(releaseRepository, userRepository, authorizer)
+	releaseHandler := handler.NewProjectReleaseHandler(releaseService)
+	auditService := service.NewProjectAuditService(projectRepository, contributorRepository, repository.NewProjectAuditRepository(db), userRepository, moduleOptions.auditRetention)
+	publishService := service.NewProjectPublishService(projectRepository, publishRepository, userRepository, authorizer, auditService)
+	publishHandler := handler.NewProjectPublishHandler(publishService)
+	imageRepository := repository.NewProjectImageRepository(db)
+	imageService := service.NewProjectImageService(projectRepository, imageRepository, userRepository, authorizer, moduleOptions.blobStore)
+	imageHandler := handler.NewProjectImageHandler(imageService)
+	attachmentRepository := repository.NewProjectAttachmentRepository(db)
+	attachmentService := service.NewProjectAttachmentService(attachmentRepository, userRepository, authorizer, moduleOptions.attachmentStore)
+	attachmentHandler := handler.NewProjectAttachmentHandler(attachmentService)
+	placeholderService := service.NewProjectPlaceholderService(repository.NewPublicProjectRepository(db))
+	placeholderHandler := handler.NewProjectPlaceholderHandler(placeholderService)
+	repoMetadataRepository := repository.NewProjectRepoMetadataRepository(db)
+	repoMetadataService := service.NewProjectRepoMetadataService(projectRepository, repoMetadataRepository, userRepository, authorizer, moduleOptions.repoProvider, repoMetadataMaxAge)
+	repoHandler := handler.NewProjectRepoMetadataHandler(repoMetadataService)
+	importService := service.NewProjectImportService(projectService, repoMetadataRepository, moduleOptions.repoProvider)
+	importHandler := handler.NewProjectImportHandler(importService)
+	webhookRepository := repository.NewProjectWebhookRepository(db)
+	webhookService := service.NewProjectWebhookService(projectRepository, webhookRepository, releaseRepository, userRepository, authorizer)
+	webhookHandler := handler.NewProjectWebhookHandler(webhookService)
+	linkChecker := service.NewLinkChecker(moduleOptions.linkCheckClient, linkRequestInterval, linkRequestsPerHost)
+	linkService := service.NewProjectLinkService(projectRepository, repository.NewProjectLinkRepository(db), userRepository, authorizer, linkChecker, linkCheckMaxAge)
+	linkHandler := handler.NewProjectLinkHandler(linkService)
+	adminService := service.NewAdminProjectService(projectRepository, imageRepository, attachmentRepository, userRepository, moduleOptions.blobStore, moduleOptions.attachmentStore)
+	adminHandler := handler.NewAdminProjectHandler(adminService)
+	moderationService := service.NewProjectModerationService(projectRepository, repository.NewProjectModerationRepository(db), userRepository, adminService, auditService, moduleOptions.autoHideReports)
+	moderationHandler := handler.NewProjectModerationHandler(moderationService)
+	featureService := service.NewProjectFeatureService(projectRepository, repository.NewProjectFeatureRepository(db), forkRepository, userRepository)
+	featureHandler := handler.NewProjectFeatureHandler(featureService)
+	pinService := service.NewProjectPinService(projectRepository, repository.NewProjectPinRepository(db), forkRepository, userRepository, moduleOptions.maxPinnedProjects)
+	pinHandler := handler.NewProjectPinHandler(pinService)
+	collectionService := service.NewProjectCollectionService(repository.NewProjectCollectionRepository(db), forkRepository, userRepository, authorizer)
+	collectionHandler := handler.NewProjectCollectionHandler(collectionService)
+	bookmarkService := service.NewProjectBookmarkService(repository.NewProjectBookmarkRepository(db), forkRepository, userRepository, authorizer)
+	bookmarkHandler := handler.NewProjectBookmarkHandler(bookmarkService)
+	auditHandler := handler.NewProjectAuditHandler(auditService)
+	statsService := service.NewProjectStatsService(repository.NewProjectStatsRepository(db), userRepository, statsCacheTTL)
+	statsHandler := handler.NewProjectStatsHandler(statsService)
+	bulkService := service.NewProjectBulkService(projectRepository, repository.NewProjectBulkRepository(db), userRepository, auditService)
+	bulkHandler := handler.NewProjectBulkHandler(bulkService)
+	exportService := service.NewProjectExportService(repository.NewProjectExportRepository(db), userRepository)
+	exportHandler := handler.NewProjectExportHandler(exportService)
+	bulkImportService := service.NewProjectBulkImportService(projectRepository, repository.NewProjectBulkImportRepository(db), userRepository)
+	bulkImportHandler := handler.NewProjectBulkImportHandler(bulkImportService)
+	jobs := newBackgroundJobs(publishService, repoMetadataService, linkService, auditService)
+	if projectInstance != nil {
+		for _, job := range projectInstance.jobs {
+			job.Stop()
+		}
+	}
+	for _, job := range jobs {
+		job.Start()
+	}
 	projectInstance = &projectModule{
-		projectHandler: projectHandler,
-		r:              r,
+		projectHandler:     projectHandler,
+		feedHandler:        feedHandler,
+		inviteHandler:      inviteHandler,
+		contributorHandler: contributorHandler,
+		transferHandler:    transferHandler,
+		forkHandler:        forkHandler,
+		revisionHandler:    revisionHandler,
+		releaseHandler:     releaseHandler,
+		publishHandler:     publishHandler,
+		imageHandler:       imageHandler,
+		attachmentHandler:  attachmentHandler,
+		placeholderHandler: placeholderHandler,
+		repoHandler:        repoHandler,
+		importHandler:      importHandler,
+		webhookHandler:     webhookHandler,
+		linkHandler:        linkHandler,
+		adminHandler:       adminHandler,
+		moderationHandler:  moderationHandler,
+		featureHandler:     featureHandler,
+		pinHandler:         pinHandler,
+		collectionHandler:  collectionHandler,
+		bookmarkHandler:    bookmarkHandler,
+		auditHandler:       auditHandler,
+		statsHandler:       statsHandler,
+		bulkHandler:        bulkHandler,
+		exportHandler:      exportHandler,
+		bulkImportHandler:  bulkImportHandler,
+		jobs:               jobs,
+		uploadsDir:         uploadsDir,
+		r:                  r,
+	}
+}
+
+// newBackgroundJobs returns the jobs that publish scheduled drafts, sync repository
+// metadata, check links and prune the audit log.
+func newBackgroundJobs(
+	publishService serviceInterface.ProjectPublishService,
+	repoMetadataService serviceInterface.ProjectRepoMetadataService,
+	linkService serviceInterface.ProjectLinkService,
+	auditService serviceInterface.ProjectAuditService,
+) []*service.IntervalJob {
+	return []*service.IntervalJob{
+		service.NewIntervalJob("publish scheduled projects", publishCheckInterval, func(now time.Time) error {
+			published, err := publishService.PublishDue(now)
+			if published > 0 {
+				log.Printf("Published %d scheduled projects", published)
+			}
+			return err
+		}),
+		service.NewIntervalJob("sync project repositories", repoSyncInterval, func(now time.Time) error {
+			synced, err := repoMetadataService.SyncStale(now)
+			if synced > 0 {
+				log.Printf("Synced %d project repositories", synced)
+			}
+			return err
+		}),
+		service.NewIntervalJob("check project links", linkCheckInterval, func(now time.Time) error {
+			checked, err := linkService.CheckStale(now)
+			if checked > 0 {
+				log.Printf("Checked the links of %d projects", checked)
+			}
+			return err
+		}),
+		service.NewIntervalJob("prune the audit log", auditPruneInterval, func(now time.Time) error {
+			pruned, err := auditService.Prune(now)
+			if pruned > 0 {
+				log.Printf("Pruned %d audit entries", pruned)
+			}
+			return err
+		}),
 	}
 }
 
 // RegisterPublicProjectRoutes registers the public project routes with the Gin engine.
 //
-// It sets up the routes that are accessible without authentication.
+// It sets up the routes that are accessible without authentication, including
+// the webhook receivers, which check the signature of each delivery instead.
 
 func RegisterPublicProjectRoutes() {
 	routes.RegisterPublicProjectRoutes(projectInstance.r, projectInstance.projectHandler)
+	routes.RegisterPublicProjectForkRoutes(projectInstance.r, projectInstance.forkHandler)
+	routes.RegisterPublicProjectImageRoutes(projectInstance.r, projectInstance.imageHandler)
+	routes.RegisterProjectPlaceholderRoutes(projectInstance.r, projectInstance.placeholderHandler)
+	routes.RegisterPublicProjectRepoMetadataRoutes(projectInstance.r, projectInstance.repoHandler)
+	routes.RegisterHookRoutes(projectInstance.r, projectInstance.webhookHandler, projectInstance.auditHandler)
+	routes.RegisterPublicProjectFeatureRoutes(projectInstance.r, projectInstance.featureHandler)
+	routes.RegisterPublicProjectCollectionRoutes(projectInstance.r, projectInstance.collectionHandler)
+	if projectInstance.uploadsDir != "" {
+		projectInstance.r.Static(defaultUploadsURL, projectInstance.uploadsDir)
+	}
 }
 
 // RegisterPrivateProjectRoutes registers the private project routes with the Gin engine.
@@ -54,5 +401,48 @@ func RegisterPublicProjectRoutes() {
 //
 // Note: Only Use this after enabling jwt middleware on the routes.
 func RegisterPrivateProjectRoutes(r *gin.Engine) {
-	routes.RegisterPrivateProjectRoutes(r, projectInstance.projectHandler)
+	routes.RegisterPrivateProjectRoutes(r, projectInstance.projectHandler, projectInstance.auditHandler)
+	routes.RegisterProjectFeedRoutes(r, projectInstance.feedHandler)
+	routes.RegisterProjectInviteRoutes(r, projectInstance.inviteHandler, projectInstance.auditHandler)
+	routes.RegisterProjectContributorRoutes(r, projectInstance.contributorHandler, projectInstance.auditHandler)
+	routes.RegisterProjectTransferRoutes(r, projectInstance.transferHandler, projectInstance.auditHandler)
+	routes.RegisterPrivateProjectForkRoutes(r, projectInstance.forkHandler, projectInstance.auditHandler)
+	routes.RegisterProjectRevisionRoutes(r, projectInstance.revisionHandler, projectInstance.auditHandler)
+	routes.RegisterProjectReleaseRoutes(r, projectInstance.releaseHandler, projectInstance.auditHandler)
+	routes.RegisterProjectPublishRoutes(r, projectInstance.publishHandler, projectInstance.auditHandler)
+	routes.RegisterPrivateProjectImageRoutes(r, projectInstance.imageHandler, projectInstance.auditHandler)
+	routes.RegisterProjectAttachmentRoutes(r, projectInstance.attachmentHandler, projectInstance.auditHandler)
+	routes.RegisterPrivateProjectRepoMetadataRoutes(r, projectInstance.repoHandler, projectInstance.auditHandler)
+	routes.RegisterProjectImportRoutes(r, projectInstance.importHandler, projectInstance.auditHandler)
+	routes.RegisterProjectWebhookRoutes(r, projectInstance.webhookHandler, projectInstance.auditHandler)
+	routes.RegisterProjectLinkRoutes(r, projectInstance.linkHandler)
+	routes.RegisterProjectReportRoutes(r, projectInstance.moderationHandler, projectInstance.auditHandler)
+	routes.RegisterProjectPinRoutes(r, projectInstance.pinHandler, projectInstance.auditHandler)
+	routes.RegisterProjectCollectionRoutes(r, projectInstance.collectionHandler, projectInstance.auditHandler)
+	routes.RegisterProjectBookmarkRoutes(r, projectInstance.bookmarkHandler)
+	routes.RegisterProjectExportRoutes(r, projectInstance.exportHandler)
+}
+
+// RegisterAdminProjectRoutes registers the routes for managing every project with the Gin engine.
+//
+// They list, edit and delete projects of any owner, including private projects and drafts,
+// report broken links, handle the moderation queue, feature projects on the homepage,
+// read the audit log, show the dashboard statistics, run bulk operations and export
+// and import projects.
+//
+// Params:
+// - r: *gin.Engine - The Gin engine to register routes on.
+//
+// Note: Only Use this after enabling jwt middleware and an admin role middleware on the routes.
+// The services check the admin role as well.
+func RegisterAdminProjectRoutes(r *gin.Engine) {
+	routes.RegisterAdminProjectRoutes(r, projectInstance.adminHandler, projectInstance.auditHandler)
+	routes.RegisterAdminProjectLinkRoutes(r, projectInstance.linkHandler)
+	routes.RegisterAdminProjectModerationRoutes(r, projectInstance.moderationHandler, projectInstance.auditHandler)
+	routes.RegisterAdminProjectFeatureRoutes(r, projectInstance.featureHandler, projectInstance.auditHandler)
+	routes.RegisterAdminProjectAuditRoutes(r, projectInstance.auditHandler)
+	routes.RegisterAdminProjectStatsRoutes(r, projectInstance.statsHandler)
+	routes.RegisterAdminProjectBulkRoutes(r, projectInstance.bulkHandler, projectInstance.auditHandler)
+	routes.RegisterAdminProjectExportRoutes(r, projectInstance.exportHandler)
+	routes.RegisterAdminProjectBulkImportRoutes(r, projectInstance.bulkImportHandler, projectInstance.auditHandler)
 }
@@ -0,0 +1,220 @@
+// Package provider fetches repository metadata from code hosts.
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aruncs31s/esdcprojectmodule/interfaces/provider"
+	"github.com/aruncs31s/esdcprojectmodule/utils"
+)
+
+// GitHubAPIURL is the base URL of the public GitHub REST API.
+const GitHubAPIURL = "https://api.github.com"
+
+const (
+	gitHubTimeout = 10 * time.Second
+	// maxReadmeBytes caps the README kept as a project description.
+	maxReadmeBytes = 64 << 10
+)
+
+type gitHubProvider struct {
+	baseURL string
+	token   string
+	client  *http.Client
+}
+
+// NewGitHubProvider fetches metadata from the GitHub REST API at baseURL.
+//
+// The token is optional, without it GitHub allows far fewer requests per hour.
+// A nil client uses one with a short timeout.
+func NewGitHubProvider(baseURL, token string, client *http.Client) provider.RepoMetadataProvider {
+	if client == nil {
+		client = &http.Client{Timeout: gitHubTimeout}
+	}
+	return &gitHubProvider{
+		baseURL: strings.TrimRight(baseURL, "/"),
+		token:   token,
+		client:  client,
+	}
+}
+
+type gitHubRepo struct {
+	Name            string     `json:"name"`
+	HTMLURL         string     `json:"html_url"`
+	Description     string     `json:"description"`
+	Homepage        string     `json:"homepage"`
+	StargazersCount int        `json:"stargazers_count"`
+	ForksCount      int        `json:"forks_count"`
+	Language        string     `json:"language"`
+	PushedAt        *time.Time `json:"pushed_at"`
+	Topics          []string   `json:"topics"`
+	License         *struct {
+		SPDXID string `json:"spdx_id"`
+		Name   string `json:"name"`
+	} `json:"license"`
+}
+
+func (p *gitHubProvider) Name() string {
+	return "github"
+}
+
+func (p *gitHubProvider) Supports(link string) bool {
+	_, _, ok := parseGitHubLink(link)
+	return ok
+}
+
+func (p *gitHubProvider) Fetch(ctx context.Context, link string) (*provider.RepoMetadata, error) {
+	owner, name, ok := parseGitHubLink(link)
+	if !ok {
+		return nil, utils.ErrUnsupportedRepoLink
+	}
+	repoPath := "/repos/" + url.PathEscape(owner) + "/" + url.PathEscape(name)
+	var repo gitHubRepo
+	if err := p.get(ctx, repoPath, &repo); err != nil {
+		return nil, err
+	}
+	var languageBytes map[string]int64
+	if err := p.get(ctx, repoPath+"/languages", &languageBytes); err != nil {
+		return nil, err
+	}
+	metadata := &provider.RepoMetadata{
+		URL:         repo.HTMLURL,
+		Name:        repo.Name,
+		Description: repo.Description,
+		Homepage:    repo.Homepage,
+		Stars:       repo.StargazersCount,
+		Forks:       repo.ForksCount,
+		Language:    repo.Language,
+		PushedAt:    repo.PushedAt,
+		Topics:      repo.Topics,
+		Languages:   languagesBySize(languageBytes),
+	}
+	if repo.License != nil {
+		// GitHub reports NOASSERTION for licenses it could not identify.
+		metadata.License = repo.License.SPDXID
+		if metadata.License == "" || metadata.License == "NOASSERTION" {
+			metadata.License = repo.License.Name
+		}
+	}
+	return metadata, nil
+}
+
+func (p *gitHubProvider) FetchReadme(ctx context.Context, link string) (string, error) {
+	owner, name, ok := parseGitHubLink(link)
+	if !ok {
+		return "", utils.ErrUnsupportedRepoLink
+	}
+	resp, err := p.do(ctx, "/repos/"+url.PathEscape(owner)+"/"+url.PathEscape(name)+"/readme", "application/vnd.github.raw+json")
+	if errors.Is(err, utils.ErrRepoNotFound) {
+		// GitHub answers 404 for a repository without a README as well.
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	readme, err := io.ReadAll(io.LimitReader(resp.Body, maxReadmeBytes))
+	if err != nil {
+		return "", err
+	}
+	return strings.ToValidUTF8(string(readme), ""), nil
+}
+
+func (p *gitHubProvider) get(ctx context.Context, path string, out any) error {
+	resp, err := p.do(ctx, path, "application/vnd.github+json")
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	return json.NewDecoder(resp.Body).Decode(out)
+}
+
+// do sends a GET request and turns the error statuses into errors, the caller
+// closes the body of a successful response.
+func (p *gitHubProvider) do(ctx context.Context, path, accept string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.baseURL+path, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", accept)
+	req.Header.Set("X-GitHub-Api-Version", "2022-11-28")
+	if p.token != "" {
+		req.Header.Set("Authorization", "Bearer "+p.token)
+	}
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode == http.StatusOK {
+		return resp, nil
+	}
+	resp.Body.Close()
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		return nil, utils.ErrRepoNotFound
+	case resp.StatusCode == http.StatusTooManyRequests,
+		resp.StatusCode == http.StatusForbidden && resp.Header.Get("X-RateLimit-Remaining") == "0":
+		return nil, utils.ErrRepoRateLimited
+	default:
+		return nil, fmt.Errorf("github returned %s for %s", resp.Status, path)
+	}
+}
+
+// GitHubRepoName returns "owner/name" of the repository a GitHub link points at, in
+// lower case, so links written differently can be compared.
+func GitHubRepoName(link string) (string, bool) {
+	owner, name, ok := parseGitHubLink(link)
+	if !ok {
+		return "", false
+	}
+	return strings.ToLower(owner + "/" + name), true
+}
+
+// parseGitHubLink returns the owner and name of the repository in links like
+// https://github.com/owner/name, with or without the scheme, a .git suffix or deeper paths.
+func parseGitHubLink(link string) (string, string, bool) {
+	link = strings.TrimSpace(link)
+	if link == "" {
+		return "", "", false
+	}
+	if !strings.Contains(link, "://") {
+		link = "https://" + link
+	}
+	parsed, err := url.Parse(link)
+	if err != nil {
+		return "", "", false
+	}
+	host := strings.ToLower(parsed.Hostname())
+	if host != "github.com" && host != "www.github.com" {
+		return "", "", false
+	}
+	parts := strings.Split(strings.Trim(parsed.Path, "/"), "/")
+	if len(parts) < 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", false
+	}
+	return parts[0], strings.TrimSuffix(parts[1], ".git"), true
+}
+
+// languagesBySize orders the languages by their number of bytes, largest first.
+func languagesBySize(languageBytes map[string]int64) []string {
+	languages := make([]string, 0, len(languageBytes))
+	for language := range languageBytes {
+		languages = append(languages, language)
+	}
+	sort.Slice(languages, func(i, j int) bool {
+		if languageBytes[languages[i]] != languageBytes[languages[j]] {
+			return languageBytes[languages[i]] > languageBytes[languages[j]]
+		}
+		return languages[i] < languages[j]
+	})
+	return languages
+}
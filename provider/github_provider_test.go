@@ -0,0 +1,185 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/aruncs31s/esdcprojectmodule/interfaces/provider"
+	"github.com/aruncs31s/esdcprojectmodule/utils"
+)
+
+// newGitHubServer stands in for the GitHub API with the handlers in routes.
+func newGitHubServer(t *testing.T, routes map[string]http.HandlerFunc) *httptest.Server {
+	t.Helper()
+	mux := http.NewServeMux()
+	for pattern, handler := range routes {
+		mux.HandleFunc(pattern, handler)
+	}
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server
+}
+
+func writeBody(body string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(body))
+	}
+}
+
+func TestGitHubProviderFetch(t *testing.T) {
+	var gotHeaders http.Header
+	server := newGitHubServer(t, map[string]http.HandlerFunc{
+		"GET /repos/club/robot": func(w http.ResponseWriter, r *http.Request) {
+			gotHeaders = r.Header.Clone()
+			writeBody(`{
+				"name": "robot",
+				"html_url": "https://github.com/club/robot",
+				"description": "Line follower",
+				"homepage": "https://robot.example.com",
+				"stargazers_count": 42,
+				"forks_count": 7,
+				"language": "C++",
+				"pushed_at": "2026-03-01T12:00:00Z",
+				"topics": ["robotics", "arduino"],
+				"license": {"spdx_id": "NOASSERTION", "name": "Other"}
+			}`)(w, r)
+		},
+		"GET /repos/club/robot/languages": writeBody(`{"C": 300, "C++": 5000, "Python": 300}`),
+	})
+	p := NewGitHubProvider(server.URL+"/", "secret", server.Client())
+
+	metadata, err := p.Fetch(context.Background(), "github.com/club/robot.git")
+	if err != nil {
+		t.Fatal(err)
+	}
+	pushedAt := time.Date(2026, time.March, 1, 12, 0, 0, 0, time.UTC)
+	if metadata.PushedAt == nil || !metadata.PushedAt.Equal(pushedAt) {
+		t.Fatalf("PushedAt = %v, want %v", metadata.PushedAt, pushedAt)
+	}
+	metadata.PushedAt = nil
+	want := &provider.RepoMetadata{
+		URL:         "https://github.com/club/robot",
+		Name:        "robot",
+		Description: "Line follower",
+		Homepage:    "https://robot.example.com",
+		Stars:       42,
+		Forks:       7,
+		Language:    "C++",
+		License:     "Other",
+		Topics:      []string{"robotics", "arduino"},
+		Languages:   []string{"C++", "C", "Python"},
+	}
+	if !reflect.DeepEqual(metadata, want) {
+		t.Fatalf("Fetch() = %+v, want %+v", metadata, want)
+	}
+	if gotHeaders.Get("Authorization") != "Bearer secret" {
+		t.Fatalf("Authorization = %q", gotHeaders.Get("Authorization"))
+	}
+	if gotHeaders.Get("Accept") != "application/vnd.github+json" {
+		t.Fatalf("Accept = %q", gotHeaders.Get("Accept"))
+	}
+}
+
+func TestGitHubProviderErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		handler http.HandlerFunc
+		want    error
+	}{
+		{
+			"not found",
+			func(w http.ResponseWriter, r *http.Request) { http.NotFound(w, r) },
+			utils.ErrRepoNotFound,
+		},
+		{
+			"too many requests",
+			func(w http.ResponseWriter, r *http.Request) { w.WriteHeader(http.StatusTooManyRequests) },
+			utils.ErrRepoRateLimited,
+		},
+		{
+			"rate limit exhausted",
+			func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("X-RateLimit-Remaining", "0")
+				w.WriteHeader(http.StatusForbidden)
+			},
+			utils.ErrRepoRateLimited,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := newGitHubServer(t, map[string]http.HandlerFunc{"/": tt.handler})
+			p := NewGitHubProvider(server.URL, "", server.Client())
+			_, err := p.Fetch(context.Background(), "https://github.com/club/robot")
+			if !errors.Is(err, tt.want) {
+				t.Fatalf("Fetch() error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+
+	t.Run("forbidden without rate limit", func(t *testing.T) {
+		server := newGitHubServer(t, map[string]http.HandlerFunc{
+			"/": func(w http.ResponseWriter, r *http.Request) { w.WriteHeader(http.StatusForbidden) },
+		})
+		_, err := NewGitHubProvider(server.URL, "", server.Client()).Fetch(context.Background(), "https://github.com/club/robot")
+		if err == nil || errors.Is(err, utils.ErrRepoRateLimited) || errors.Is(err, utils.ErrRepoNotFound) {
+			t.Fatalf("Fetch() error = %v, want a plain error", err)
+		}
+	})
+
+	t.Run("unsupported link", func(t *testing.T) {
+		_, err := NewGitHubProvider(GitHubAPIURL, "", nil).Fetch(context.Background(), "https://gitlab.com/club/robot")
+		if !errors.Is(err, utils.ErrUnsupportedRepoLink) {
+			t.Fatalf("Fetch() error = %v, want ErrUnsupportedRepoLink", err)
+		}
+	})
+}
+
+func TestGitHubProviderFetchReadme(t *testing.T) {
+	server := newGitHubServer(t, map[string]http.HandlerFunc{
+		"GET /repos/club/robot/readme": func(w http.ResponseWriter, r *http.Request) {
+			if r.Header.Get("Accept") != "application/vnd.github.raw+json" {
+				http.Error(w, "wrong accept header", http.StatusBadRequest)
+				return
+			}
+			w.Write([]byte("# Robot\n"))
+		},
+	})
+	p := NewGitHubProvider(server.URL, "", server.Client())
+
+	readme, err := p.FetchReadme(context.Background(), "https://github.com/club/robot")
+	if err != nil || readme != "# Robot\n" {
+		t.Fatalf("FetchReadme() = %q, %v", readme, err)
+	}
+	// GitHub answers 404 for a repository without a README.
+	readme, err = p.FetchReadme(context.Background(), "https://github.com/club/empty")
+	if err != nil || readme != "" {
+		t.Fatalf("FetchReadme() without README = %q, %v", readme, err)
+	}
+}
+
+func TestGitHubRepoName(t *testing.T) {
+	tests := []struct {
+		link   string
+		want   string
+		wantOK bool
+	}{
+		{"https://github.com/Club/Robot", "club/robot", true},
+		{"github.com/club/robot.git", "club/robot", true},
+		{"https://www.github.com/club/robot/tree/main/firmware", "club/robot", true},
+		{"https://github.com/club", "", false},
+		{"https://gitlab.com/club/robot", "", false},
+		{"", "", false},
+	}
+	for _, tt := range tests {
+		got, ok := GitHubRepoName(tt.link)
+		if got != tt.want || ok != tt.wantOK {
+			t.Errorf("GitHubRepoName(%q) = %q, %v, want %q, %v", tt.link, got, ok, tt.want, tt.wantOK)
+		}
+	}
+}
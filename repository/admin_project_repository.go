@@ -1,16 +1,87 @@
 package repository
 
-import commonModules "github.com/aruncs31s/esdcmodels"
+import (
+	commonModules "github.com/aruncs31s/esdcmodels"
+	"github.com/aruncs31s/esdcprojectmodule/models"
+	"gorm.io/gorm"
+)
 
-func (r *projectRepositoryReader) GetEssentialInfo(limit, offset int) (*[]commonModules.Project, error) {
+func (r *projectRepositoryReader) GetEssentialInfo(filter models.ProjectFilter, limit, offset int) (*[]commonModules.Project, error) {
 	var projects []commonModules.Project
 	essentialFields := commonModules.Project{}.GetProjectEssentialFields()
 	query := r.db.
 		Model(&commonModules.Project{}).
 		Select(essentialFields).
-		Limit(limit).Offset(offset)
+		Preload("Creator")
+	query = filterProjects(query, filter).Order("id DESC").Limit(limit).Offset(offset)
 	if err := query.Find(&projects).Error; err != nil {
 		return nil, err
 	}
 	return &projects, nil
 }
+
+// filterProjects keeps the projects matching the admin filter.
+func filterProjects(query *gorm.DB, filter models.ProjectFilter) *gorm.DB {
+	if filter.CreatedBy != 0 {
+		query = query.Where("created_by = ?", filter.CreatedBy)
+	}
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
+	}
+	if filter.Visibility != nil {
+		query = query.Where("visibility = ?", *filter.Visibility)
+	}
+	return query
+}
+
+// ownedProjectTables are the tables of the module with a row per project, removed with the project.
+//
+// Reports and moderation decisions are kept as a record of why a project was removed,
+// bookmarks are kept to tell the users who saved the project that it is gone and
+// audit entries are only removed once they are past the retention.
+var ownedProjectTables = []interface{}{
+	&models.ProjectInvite{},
+	&models.ProjectContributor{},
+	&models.ProjectTransfer{},
+	&models.ProjectCounter{},
+	&models.ProjectRevision{},
+	&models.ProjectRelease{},
+	&models.ProjectSchedule{},
+	&models.ProjectImage{},
+	&models.ProjectAttachment{},
+	&models.ProjectRepoMetadata{},
+	&models.ProjectWebhookEvent{},
+	&models.ProjectWebhook{},
+	&models.ProjectActivity{},
+	&models.ProjectLinkCheck{},
+	&models.ProjectFeature{},
+	&models.ProjectPin{},
+	&models.ProjectCollectionItem{},
+	&models.ProjectTrash{},
+}
+
+func (r *projectRepositoryWriter) Delete(projectID uint) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		var project commonModules.Project
+		if err := tx.Select("id", "forked_from").First(&project, projectID).Error; err != nil {
+			return err
+		}
+		if err := tx.Model(&commonModules.Project{}).
+			Where("forked_from = ?", projectID).
+			Update("forked_from", nil).Error; err != nil {
+			return err
+		}
+		if project.ForkedFrom != nil {
+			if err := incrementForkCount(tx, *project.ForkedFrom, -1); err != nil {
+				return err
+			}
+		}
+		for _, table := range ownedProjectTables {
+			if err := tx.Where("project_id = ?", projectID).Delete(table).Error; err != nil {
+				return err
+			}
+		}
+		// Selecting the many to many associations removes their join rows as well.
+		return tx.Select("Tags", "Technologies", "LikedBy", "ViewedBy").Delete(&project).Error
+	})
+}
@@ -0,0 +1,77 @@
+package repository
+
+import (
+	repository "github.com/aruncs31s/esdcprojectmodule/interfaces/repository"
+	"github.com/aruncs31s/esdcprojectmodule/models"
+	"gorm.io/gorm"
+)
+
+type projectAttachmentRepository struct {
+	db *gorm.DB
+}
+
+func NewProjectAttachmentRepository(db *gorm.DB) repository.ProjectAttachmentRepository {
+	return &projectAttachmentRepository{
+		db: db,
+	}
+}
+
+func (r *projectAttachmentRepository) GetByProject(projectID uint) ([]models.ProjectAttachment, error) {
+	var attachments []models.ProjectAttachment
+	if err := r.db.
+		Preload("Uploader").
+		Where("project_id = ?", projectID).
+		Order("position ASC, id ASC").
+		Find(&attachments).Error; err != nil {
+		return nil, err
+	}
+	return attachments, nil
+}
+
+func (r *projectAttachmentRepository) GetByID(projectID, id uint) (*models.ProjectAttachment, error) {
+	var attachment models.ProjectAttachment
+	if err := r.db.
+		Preload("Uploader").
+		Where("project_id = ? AND id = ?", projectID, id).
+		First(&attachment).Error; err != nil {
+		return nil, err
+	}
+	return &attachment, nil
+}
+
+func (r *projectAttachmentRepository) Create(attachment *models.ProjectAttachment) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		var last int
+		if err := tx.Model(&models.ProjectAttachment{}).
+			Where("project_id = ?", attachment.ProjectID).
+			Select("COALESCE(MAX(position), 0)").
+			Scan(&last).Error; err != nil {
+			return err
+		}
+		attachment.Position = last + 1
+		return tx.Create(attachment).Error
+	})
+}
+
+func (r *projectAttachmentRepository) UpdateCaption(id uint, caption string) error {
+	return r.db.Model(&models.ProjectAttachment{}).
+		Where("id = ?", id).
+		Update("caption", caption).Error
+}
+
+func (r *projectAttachmentRepository) Reorder(projectID uint, ids []uint) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		for i, id := range ids {
+			if err := tx.Model(&models.ProjectAttachment{}).
+				Where("project_id = ? AND id = ?", projectID, id).
+				Update("position", i+1).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (r *projectAttachmentRepository) Delete(id uint) error {
+	return r.db.Delete(&models.ProjectAttachment{}, id).Error
+}
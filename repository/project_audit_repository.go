@@ -0,0 +1,63 @@
+package repository
+
+import (
+	"time"
+
+	repository "github.com/aruncs31s/esdcprojectmodule/interfaces/repository"
+	"github.com/aruncs31s/esdcprojectmodule/models"
+	"gorm.io/gorm"
+)
+
+type projectAuditRepository struct {
+	db *gorm.DB
+}
+
+func NewProjectAuditRepository(db *gorm.DB) repository.ProjectAuditRepository {
+	return &projectAuditRepository{
+		db: db,
+	}
+}
+
+func (r *projectAuditRepository) Create(entry *models.ProjectAuditEntry) error {
+	return r.db.Create(entry).Error
+}
+
+func (r *projectAuditRepository) Get(filter models.ProjectAuditFilter, limit, offset int) ([]models.ProjectAuditEntry, error) {
+	var entries []models.ProjectAuditEntry
+	query := r.db.Model(&models.ProjectAuditEntry{})
+	if filter.ActorID != nil {
+		query = query.Where("actor_id = ?", *filter.ActorID)
+	}
+	if filter.System {
+		query = query.Where("actor_id IS NULL AND actor = ?", models.AuditSystemActor)
+	}
+	if filter.Action != "" {
+		query = query.Where("action = ?", filter.Action)
+	}
+	if filter.ProjectID != 0 {
+		query = query.Where("project_id = ?", filter.ProjectID)
+	}
+	if filter.RequestID != "" {
+		query = query.Where("request_id = ?", filter.RequestID)
+	}
+	if filter.From != nil {
+		query = query.Where("created_at >= ?", *filter.From)
+	}
+	if filter.To != nil {
+		query = query.Where("created_at < ?", *filter.To)
+	}
+	if err := query.
+		Order("created_at DESC").
+		Order("id DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&entries).Error; err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+func (r *projectAuditRepository) DeleteBefore(t time.Time) (int64, error) {
+	result := r.db.Where("created_at < ?", t).Delete(&models.ProjectAuditEntry{})
+	return result.RowsAffected, result.Error
+}
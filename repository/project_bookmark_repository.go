@@ -0,0 +1,124 @@
+package repository
+
+import (
+	model "github.com/aruncs31s/esdcmodels"
+	repository "github.com/aruncs31s/esdcprojectmodule/interfaces/repository"
+	"github.com/aruncs31s/esdcprojectmodule/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type projectBookmarkRepository struct {
+	db *gorm.DB
+}
+
+func NewProjectBookmarkRepository(db *gorm.DB) repository.ProjectBookmarkRepository {
+	return &projectBookmarkRepository{
+		db: db,
+	}
+}
+
+func (r *projectBookmarkRepository) GetBookmarks(userID uint, folderID *uint, limit, offset int) ([]models.ProjectBookmark, error) {
+	var bookmarks []models.ProjectBookmark
+	query := r.db.Preload("Folder").Where("user_id = ?", userID)
+	if folderID != nil {
+		query = query.Where("folder_id = ?", *folderID)
+	}
+	if err := query.
+		Order("created_at DESC").
+		Order("id DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&bookmarks).Error; err != nil {
+		return nil, err
+	}
+	return bookmarks, nil
+}
+
+func (r *projectBookmarkRepository) GetProjects(projectIDs []uint) ([]model.Project, error) {
+	var projects []model.Project
+	if len(projectIDs) == 0 {
+		return projects, nil
+	}
+	if err := r.db.
+		Preload("Contributors").
+		Preload("Creator").
+		Preload("Tags").
+		Preload("Technologies").
+		Where("id IN ?", projectIDs).
+		Find(&projects).Error; err != nil {
+		return nil, err
+	}
+	return projects, nil
+}
+
+func (r *projectBookmarkRepository) Save(bookmark *models.ProjectBookmark) error {
+	return r.db.
+		Omit("Folder").
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "user_id"}, {Name: "project_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"folder_id", "project_title", "updated_at"}),
+		}).
+		Create(bookmark).Error
+}
+
+func (r *projectBookmarkRepository) Delete(userID, projectID uint) error {
+	result := r.db.Where("user_id = ? AND project_id = ?", userID, projectID).Delete(&models.ProjectBookmark{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+func (r *projectBookmarkRepository) GetFolders(userID uint) ([]models.ProjectBookmarkFolder, error) {
+	var folders []models.ProjectBookmarkFolder
+	if err := r.db.Where("user_id = ?", userID).Order("name ASC").Find(&folders).Error; err != nil {
+		return nil, err
+	}
+	return folders, nil
+}
+
+func (r *projectBookmarkRepository) GetFolder(userID, folderID uint) (models.ProjectBookmarkFolder, error) {
+	var folder models.ProjectBookmarkFolder
+	if err := r.db.Where("id = ? AND user_id = ?", folderID, userID).First(&folder).Error; err != nil {
+		return models.ProjectBookmarkFolder{}, err
+	}
+	return folder, nil
+}
+
+func (r *projectBookmarkRepository) FolderNameExists(userID uint, name string, exceptID uint) (bool, error) {
+	var count int64
+	err := r.db.Model(&models.ProjectBookmarkFolder{}).
+		Where("user_id = ? AND name = ? AND id <> ?", userID, name, exceptID).
+		Count(&count).Error
+	return count > 0, err
+}
+
+func (r *projectBookmarkRepository) CreateFolder(folder *models.ProjectBookmarkFolder) error {
+	return r.db.Create(folder).Error
+}
+
+func (r *projectBookmarkRepository) UpdateFolder(folder *models.ProjectBookmarkFolder) error {
+	return r.db.Save(folder).Error
+}
+
+func (r *projectBookmarkRepository) DeleteFolder(userID, folderID uint) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&models.ProjectBookmark{}).
+			Where("user_id = ? AND folder_id = ?", userID, folderID).
+			Update("folder_id", nil).Error; err != nil {
+			return err
+		}
+		result := tx.Where("id = ? AND user_id = ?", folderID, userID).Delete(&models.ProjectBookmarkFolder{})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+		return nil
+	})
+}
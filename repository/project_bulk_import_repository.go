@@ -0,0 +1,42 @@
+package repository
+
+import (
+	repository "github.com/aruncs31s/esdcprojectmodule/interfaces/repository"
+	"github.com/aruncs31s/esdcprojectmodule/models"
+	"gorm.io/gorm"
+)
+
+type projectBulkImportRepository struct {
+	db *gorm.DB
+}
+
+func NewProjectBulkImportRepository(db *gorm.DB) repository.ProjectBulkImportRepository {
+	return &projectBulkImportRepository{
+		db: db,
+	}
+}
+
+func (r *projectBulkImportRepository) CreateAll(projects []models.NewProject, atomic bool) (map[int]error, error) {
+	failed := make(map[int]error)
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		for i := range projects {
+			// The nested transaction is a savepoint, it only rolls back this project.
+			if err := tx.Transaction(func(tx *gorm.DB) error {
+				return createProject(tx, &projects[i])
+			}); err != nil {
+				failed[i] = err
+				if atomic {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		for i := range projects {
+			projects[i].Project.ID = 0
+		}
+		return failed, err
+	}
+	return failed, nil
+}
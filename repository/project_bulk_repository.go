@@ -0,0 +1,121 @@
+package repository
+
+import (
+	"errors"
+
+	model "github.com/aruncs31s/esdcmodels"
+	repository "github.com/aruncs31s/esdcprojectmodule/interfaces/repository"
+	"github.com/aruncs31s/esdcprojectmodule/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type projectBulkRepository struct {
+	db *gorm.DB
+}
+
+func NewProjectBulkRepository(db *gorm.DB) repository.ProjectBulkRepository {
+	return &projectBulkRepository{
+		db: db,
+	}
+}
+
+func (r *projectBulkRepository) GetIDs(filter models.ProjectFilter, limit int) ([]uint, error) {
+	var ids []uint
+	if err := filterProjects(r.db.Model(&model.Project{}), filter).
+		Order("id").
+		Limit(limit).
+		Pluck("id", &ids).Error; err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+func (r *projectBulkRepository) GetProjects(ids []uint) ([]model.Project, error) {
+	var projects []model.Project
+	if len(ids) == 0 {
+		return projects, nil
+	}
+	if err := r.db.
+		Select("id", "title", "status", "visibility", "created_by").
+		Preload("Tags").
+		Where("id IN ?", ids).
+		Order("id").
+		Find(&projects).Error; err != nil {
+		return nil, err
+	}
+	return projects, nil
+}
+
+func (r *projectBulkRepository) Apply(operation models.BulkOperation, projectIDs []uint) (map[uint]error, error) {
+	failed := make(map[uint]error)
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		for _, projectID := range projectIDs {
+			// The nested transaction is a savepoint, it only rolls back this project.
+			if err := tx.Transaction(func(tx *gorm.DB) error {
+				return applyBulkOperation(tx, operation, projectID)
+			}); err != nil {
+				failed[projectID] = err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return failed, nil
+}
+
+func applyBulkOperation(tx *gorm.DB, operation models.BulkOperation, projectID uint) error {
+	var project model.Project
+	if err := tx.Select("id", "status", "created_by").First(&project, projectID).Error; err != nil {
+		return err
+	}
+	changes := models.ProjectChanges{Fields: map[string]interface{}{}, ModifiedBy: operation.By}
+	switch operation.Kind {
+	case models.BulkSetStatus:
+		changes.Fields["status"] = operation.Status
+	case models.BulkSetVisibility:
+		changes.Fields["visibility"] = operation.Visibility
+	case models.BulkAddTag:
+		if err := tx.Table("project_tags").
+			Clauses(clause.OnConflict{DoNothing: true}).
+			Create(map[string]interface{}{"project_id": projectID, "tag_id": operation.TagID}).Error; err != nil {
+			return err
+		}
+	case models.BulkRemoveTag:
+		if err := tx.Exec("DELETE FROM project_tags WHERE project_id = ? AND tag_id = ?", projectID, operation.TagID).Error; err != nil {
+			return err
+		}
+	case models.BulkTrash:
+		trash := models.ProjectTrash{ProjectID: projectID, PreviousStatus: project.Status, TrashedBy: operation.By}
+		if err := tx.Clauses(clause.OnConflict{UpdateAll: true}).Create(&trash).Error; err != nil {
+			return err
+		}
+		changes.Fields["status"] = models.StatusTrashed
+	case models.BulkRestore:
+		// Projects trashed by changing their status directly have no previous status.
+		status := models.StatusActive
+		var trash models.ProjectTrash
+		err := tx.Where("project_id = ?", projectID).First(&trash).Error
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return err
+		}
+		if err == nil && trash.PreviousStatus != "" && trash.PreviousStatus != models.StatusTrashed {
+			status = trash.PreviousStatus
+		}
+		if err := tx.Where("project_id = ?", projectID).Delete(&models.ProjectTrash{}).Error; err != nil {
+			return err
+		}
+		changes.Fields["status"] = status
+	case models.BulkTransferOwner:
+		return completeTransfer(tx, &models.ProjectTransfer{
+			ProjectID:   projectID,
+			FromUserID:  project.CreatedBy,
+			ToUserID:    operation.NewOwnerID,
+			RequestedBy: operation.By,
+			Forced:      true,
+		})
+	}
+	return updateProject(tx, projectID, changes)
+}
@@ -0,0 +1,138 @@
+package repository
+
+import (
+	"time"
+
+	model "github.com/aruncs31s/esdcmodels"
+	repository "github.com/aruncs31s/esdcprojectmodule/interfaces/repository"
+	"github.com/aruncs31s/esdcprojectmodule/models"
+	"gorm.io/gorm"
+)
+
+type projectCollectionRepository struct {
+	db *gorm.DB
+}
+
+func NewProjectCollectionRepository(db *gorm.DB) repository.ProjectCollectionRepository {
+	return &projectCollectionRepository{
+		db: db,
+	}
+}
+
+func (r *projectCollectionRepository) GetPublic(limit, offset int) ([]models.ProjectCollection, error) {
+	var collections []models.ProjectCollection
+	if err := r.db.
+		Preload("Owner").
+		Where("visibility = ?", models.VisibilityPublic).
+		Order("created_at DESC").
+		Order("id DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&collections).Error; err != nil {
+		return nil, err
+	}
+	return collections, nil
+}
+
+func (r *projectCollectionRepository) GetManaged(userID uint, includeAdminOwned bool, limit, offset int) ([]models.ProjectCollection, error) {
+	var collections []models.ProjectCollection
+	query := r.db.Preload("Owner")
+	if includeAdminOwned {
+		query = query.Where("owner_id = ? OR owner_id IS NULL", userID)
+	} else {
+		query = query.Where("owner_id = ?", userID)
+	}
+	if err := query.
+		Order("created_at DESC").
+		Order("id DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&collections).Error; err != nil {
+		return nil, err
+	}
+	return collections, nil
+}
+
+func (r *projectCollectionRepository) GetBySlug(slug string) (models.ProjectCollection, error) {
+	var collection models.ProjectCollection
+	if err := r.db.Preload("Owner").Where("slug = ?", slug).First(&collection).Error; err != nil {
+		return models.ProjectCollection{}, err
+	}
+	return collection, nil
+}
+
+func (r *projectCollectionRepository) SlugExists(slug string, exceptID uint) (bool, error) {
+	var count int64
+	err := r.db.Model(&models.ProjectCollection{}).Where("slug = ? AND id <> ?", slug, exceptID).Count(&count).Error
+	return count > 0, err
+}
+
+func (r *projectCollectionRepository) GetProjects(collectionID uint) ([]model.Project, error) {
+	return r.getProjects(r.db, collectionID)
+}
+
+func (r *projectCollectionRepository) GetListedProjects(collectionID uint) ([]model.Project, error) {
+	return r.getProjects(r.db.Scopes(listedProjects), collectionID)
+}
+
+func (r *projectCollectionRepository) getProjects(db *gorm.DB, collectionID uint) ([]model.Project, error) {
+	var projects []model.Project
+	if err := db.
+		Preload("Contributors").
+		Preload("Creator").
+		Preload("Tags").
+		Preload("Technologies").
+		Joins("JOIN project_collection_items ON project_collection_items.project_id = projects.id").
+		Where("project_collection_items.collection_id = ?", collectionID).
+		Order("project_collection_items.position ASC").
+		Find(&projects).Error; err != nil {
+		return nil, err
+	}
+	return projects, nil
+}
+
+func (r *projectCollectionRepository) Create(collection *models.ProjectCollection, projectIDs []uint) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit("Owner").Create(collection).Error; err != nil {
+			return err
+		}
+		return addCollectionItems(tx, collection.ID, projectIDs)
+	})
+}
+
+func (r *projectCollectionRepository) Update(collection *models.ProjectCollection) error {
+	return r.db.Omit("Owner").Save(collection).Error
+}
+
+func (r *projectCollectionRepository) Delete(collectionID uint) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("collection_id = ?", collectionID).Delete(&models.ProjectCollectionItem{}).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&models.ProjectCollection{}, collectionID).Error
+	})
+}
+
+func (r *projectCollectionRepository) SetProjects(collectionID uint, projectIDs []uint) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("collection_id = ?", collectionID).Delete(&models.ProjectCollectionItem{}).Error; err != nil {
+			return err
+		}
+		if err := addCollectionItems(tx, collectionID, projectIDs); err != nil {
+			return err
+		}
+		return tx.Model(&models.ProjectCollection{}).Where("id = ?", collectionID).Update("updated_at", time.Now()).Error
+	})
+}
+
+// addCollectionItems adds the projects to the collection in the given order.
+func addCollectionItems(tx *gorm.DB, collectionID uint, projectIDs []uint) error {
+	if len(projectIDs) == 0 {
+		return nil
+	}
+	items := make([]models.ProjectCollectionItem, len(projectIDs))
+	for i, projectID := range projectIDs {
+		items[i] = models.ProjectCollectionItem{CollectionID: collectionID, ProjectID: projectID, Position: i + 1}
+	}
+	return tx.Create(&items).Error
+}
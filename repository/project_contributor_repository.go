@@ -0,0 +1,103 @@
+package repository
+
+import (
+	model "github.com/aruncs31s/esdcmodels"
+	repository "github.com/aruncs31s/esdcprojectmodule/interfaces/repository"
+	"github.com/aruncs31s/esdcprojectmodule/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type projectContributorRepository struct {
+	db *gorm.DB
+}
+
+func NewProjectContributorRepository(db *gorm.DB) repository.ProjectContributorRepository {
+	return &projectContributorRepository{
+		db: db,
+	}
+}
+
+func (r *projectContributorRepository) GetRole(projectID, userID uint) (string, error) {
+	var contributor models.ProjectContributor
+	if err := r.db.
+		Where("project_id = ? AND user_id = ?", projectID, userID).
+		First(&contributor).Error; err != nil {
+		return "", err
+	}
+	return contributor.Role, nil
+}
+
+func (r *projectContributorRepository) GetContributors(projectID uint) ([]models.ProjectContributor, []model.User, error) {
+	var contributors []models.ProjectContributor
+	if err := r.db.
+		Where("project_id = ?", projectID).
+		Order("user_id").
+		Find(&contributors).Error; err != nil {
+		return nil, nil, err
+	}
+	userIDs := make([]uint, len(contributors))
+	for i, contributor := range contributors {
+		userIDs[i] = contributor.UserID
+	}
+	var users []model.User
+	if len(userIDs) > 0 {
+		if err := r.db.Where("id IN ?", userIDs).Order("id").Find(&users).Error; err != nil {
+			return nil, nil, err
+		}
+	}
+	// Keep only rows whose user still exists so both slices line up.
+	byID := make(map[uint]model.User, len(users))
+	for _, user := range users {
+		byID[user.ID] = user
+	}
+	rows := make([]models.ProjectContributor, 0, len(contributors))
+	ordered := make([]model.User, 0, len(contributors))
+	for _, contributor := range contributors {
+		if user, ok := byID[contributor.UserID]; ok {
+			rows = append(rows, contributor)
+			ordered = append(ordered, user)
+		}
+	}
+	return rows, ordered, nil
+}
+
+func (r *projectContributorRepository) Add(projectID, userID uint, role string) error {
+	return addContributor(r.db, projectID, userID, role)
+}
+
+func (r *projectContributorRepository) SetRole(projectID, userID uint, role string) error {
+	result := r.db.Model(&models.ProjectContributor{}).
+		Where("project_id = ? AND user_id = ?", projectID, userID).
+		Update("role", role)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+func (r *projectContributorRepository) Remove(projectID, userID uint) error {
+	result := r.db.
+		Where("project_id = ? AND user_id = ?", projectID, userID).
+		Delete(&models.ProjectContributor{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// addContributor inserts the join row or updates the role when the user already contributes.
+func addContributor(db *gorm.DB, projectID, userID uint, role string) error {
+	return db.
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "project_id"}, {Name: "user_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"role"}),
+		}).
+		Create(&models.ProjectContributor{ProjectID: projectID, UserID: userID, Role: role}).Error
+}
@@ -0,0 +1,37 @@
+package repository
+
+import (
+	model "github.com/aruncs31s/esdcmodels"
+	repository "github.com/aruncs31s/esdcprojectmodule/interfaces/repository"
+	"github.com/aruncs31s/esdcprojectmodule/models"
+	"gorm.io/gorm"
+)
+
+type projectExportRepository struct {
+	db *gorm.DB
+}
+
+func NewProjectExportRepository(db *gorm.DB) repository.ProjectExportRepository {
+	return &projectExportRepository{
+		db: db,
+	}
+}
+
+func (r *projectExportRepository) EachProject(filter models.ProjectFilter, batchSize int, fn func(project model.Project) error) error {
+	var projects []model.Project
+	query := r.db.
+		Model(&model.Project{}).
+		Preload("Creator").
+		Preload("Tags").
+		Preload("Technologies").
+		Preload("Contributors")
+	return filterProjects(query, filter).
+		FindInBatches(&projects, batchSize, func(tx *gorm.DB, batch int) error {
+			for _, project := range projects {
+				if err := fn(project); err != nil {
+					return err
+				}
+			}
+			return nil
+		}).Error
+}
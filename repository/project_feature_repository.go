@@ -0,0 +1,76 @@
+package repository
+
+import (
+	"time"
+
+	model "github.com/aruncs31s/esdcmodels"
+	repository "github.com/aruncs31s/esdcprojectmodule/interfaces/repository"
+	"github.com/aruncs31s/esdcprojectmodule/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type projectFeatureRepository struct {
+	db *gorm.DB
+}
+
+func NewProjectFeatureRepository(db *gorm.DB) repository.ProjectFeatureRepository {
+	return &projectFeatureRepository{
+		db: db,
+	}
+}
+
+func (r *projectFeatureRepository) GetActive(now time.Time, limit, offset int) (*[]model.Project, error) {
+	var projects []model.Project
+	if err := r.db.
+		Preload("Contributors").
+		Preload("Creator").
+		Preload("Tags").
+		Preload("Technologies").
+		Joins("JOIN project_features ON project_features.project_id = projects.id").
+		Where("project_features.starts_at <= ?", now).
+		Where("project_features.ends_at IS NULL OR project_features.ends_at > ?", now).
+		Scopes(listedProjects).
+		Order("project_features.position ASC").
+		Order("project_features.starts_at DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&projects).Error; err != nil {
+		return nil, err
+	}
+	return &projects, nil
+}
+
+func (r *projectFeatureRepository) GetAll(limit, offset int) ([]models.ProjectFeature, error) {
+	var features []models.ProjectFeature
+	if err := r.db.
+		Preload("Project").
+		Order("position ASC").
+		Order("starts_at DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&features).Error; err != nil {
+		return nil, err
+	}
+	return features, nil
+}
+
+func (r *projectFeatureRepository) Save(feature *models.ProjectFeature) error {
+	return r.db.
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "project_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"position", "starts_at", "ends_at", "featured_by", "updated_at"}),
+		}).
+		Create(feature).Error
+}
+
+func (r *projectFeatureRepository) Delete(projectID uint) error {
+	result := r.db.Where("project_id = ?", projectID).Delete(&models.ProjectFeature{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
@@ -0,0 +1,67 @@
+package repository
+
+import (
+	model "github.com/aruncs31s/esdcmodels"
+	repository "github.com/aruncs31s/esdcprojectmodule/interfaces/repository"
+	"gorm.io/gorm"
+)
+
+type projectFeedRepository struct {
+	db *gorm.DB
+}
+
+func NewProjectFeedRepository(db *gorm.DB) repository.ProjectFeedRepository {
+	return &projectFeedRepository{
+		db: db,
+	}
+}
+
+func (r *projectFeedRepository) GetLikedProjects(userID uint) ([]model.Project, error) {
+	var projects []model.Project
+	if err := r.db.
+		Preload("Contributors").
+		Preload("Tags").
+		Preload("Technologies").
+		Where("id IN (?)", r.db.Table("project_likes").Select("project_id").Where("user_id = ?", userID)).
+		Find(&projects).Error; err != nil {
+		return nil, err
+	}
+	return projects, nil
+}
+
+func (r *projectFeedRepository) GetInvolvedProjects(userID uint) ([]model.Project, error) {
+	var projects []model.Project
+	if err := r.db.
+		Preload("Contributors").
+		Preload("Tags").
+		Preload("Technologies").
+		Where("created_by = ? OR id IN (?)", userID,
+			r.db.Table("project_contributors").Select("project_id").Where("user_id = ?", userID)).
+		Find(&projects).Error; err != nil {
+		return nil, err
+	}
+	return projects, nil
+}
+
+func (r *projectFeedRepository) GetFeedCandidates(userID uint, excludeIDs []uint, limit int) ([]model.Project, error) {
+	var projects []model.Project
+	query := r.db.
+		Preload("Contributors").
+		Preload("Creator").
+		Preload("Tags").
+		Preload("Technologies").
+		Scopes(listedProjects).
+		Where("created_by <> ?", userID)
+	// NOT IN with an empty list matches nothing, so only add it when needed.
+	if len(excludeIDs) > 0 {
+		query = query.Where("id NOT IN ?", excludeIDs)
+	}
+	// The most recently active projects are ranked when there are more candidates than the limit.
+	if err := query.
+		Scopes(byRecentActivity).
+		Limit(limit).
+		Find(&projects).Error; err != nil {
+		return nil, err
+	}
+	return projects, nil
+}
@@ -0,0 +1,82 @@
+package repository
+
+import (
+	model "github.com/aruncs31s/esdcmodels"
+	repository "github.com/aruncs31s/esdcprojectmodule/interfaces/repository"
+	"github.com/aruncs31s/esdcprojectmodule/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type projectForkRepository struct {
+	db *gorm.DB
+}
+
+func NewProjectForkRepository(db *gorm.DB) repository.ProjectForkRepository {
+	return &projectForkRepository{
+		db: db,
+	}
+}
+
+func (r *projectForkRepository) CreateFork(fork *model.Project) error {
+	// The column default replaces a public (zero) visibility on create.
+	visibility := fork.Visibility
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(fork).Error; err != nil {
+			return err
+		}
+		if err := tx.Model(fork).UpdateColumn("visibility", visibility).Error; err != nil {
+			return err
+		}
+		if err := addContributor(tx, fork.ID, fork.CreatedBy, models.RoleOwner); err != nil {
+			return err
+		}
+		if err := writeRevision(tx, fork.ID, fork.CreatedBy, nil); err != nil {
+			return err
+		}
+		return incrementForkCount(tx, *fork.ForkedFrom, 1)
+	})
+}
+
+func (r *projectForkRepository) GetForks(sourceID uint, limit, offset int) (*[]model.Project, error) {
+	var projects []model.Project
+	if err := r.db.
+		Preload("Contributors").
+		Preload("Creator").
+		Preload("Tags").
+		Preload("Technologies").
+		Scopes(listedProjects).
+		Where("forked_from = ?", sourceID).
+		Order("id DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&projects).Error; err != nil {
+		return nil, err
+	}
+	return &projects, nil
+}
+
+func (r *projectForkRepository) GetForkCounts(projectIDs []uint) (map[uint]int, error) {
+	counts := make(map[uint]int, len(projectIDs))
+	if len(projectIDs) == 0 {
+		return counts, nil
+	}
+	var counters []models.ProjectCounter
+	if err := r.db.Where("project_id IN ?", projectIDs).Find(&counters).Error; err != nil {
+		return nil, err
+	}
+	for _, counter := range counters {
+		counts[counter.ProjectID] = counter.Forks
+	}
+	return counts, nil
+}
+
+// incrementForkCount adds delta to the fork count of the project, creating the counter row if needed.
+func incrementForkCount(db *gorm.DB, projectID uint, delta int) error {
+	return db.
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "project_id"}},
+			DoUpdates: clause.Assignments(map[string]interface{}{"forks": gorm.Expr("project_counters.forks + ?", delta)}),
+		}).
+		Create(&models.ProjectCounter{ProjectID: projectID, Forks: max(delta, 0)}).Error
+}
@@ -0,0 +1,57 @@
+package repository
+
+import (
+	"errors"
+
+	model "github.com/aruncs31s/esdcmodels"
+	repository "github.com/aruncs31s/esdcprojectmodule/interfaces/repository"
+	"github.com/aruncs31s/esdcprojectmodule/models"
+	"gorm.io/gorm"
+)
+
+type projectImageRepository struct {
+	db *gorm.DB
+}
+
+func NewProjectImageRepository(db *gorm.DB) repository.ProjectImageRepository {
+	return &projectImageRepository{
+		db: db,
+	}
+}
+
+func (r *projectImageRepository) GetByProject(projectID uint) (*models.ProjectImage, error) {
+	var image models.ProjectImage
+	if err := r.db.Where("project_id = ?", projectID).First(&image).Error; err != nil {
+		return nil, err
+	}
+	return &image, nil
+}
+
+func (r *projectImageRepository) Replace(image *models.ProjectImage, coverURL string) (*models.ProjectImage, error) {
+	var previous *models.ProjectImage
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		var old models.ProjectImage
+		err := tx.Where("project_id = ?", image.ProjectID).First(&old).Error
+		switch {
+		case err == nil:
+			previous = &old
+			if err := tx.Delete(&old).Error; err != nil {
+				return err
+			}
+		case !errors.Is(err, gorm.ErrRecordNotFound):
+			return err
+		}
+		if err := tx.Create(image).Error; err != nil {
+			return err
+		}
+		// The cover is not part of a revision, the replaced files are deleted
+		// from the blob store so an old revision could never bring them back.
+		return tx.Model(&model.Project{}).
+			Where("id = ?", image.ProjectID).
+			Update("image", coverURL).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return previous, nil
+}
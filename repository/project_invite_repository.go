@@ -0,0 +1,104 @@
+package repository
+
+import (
+	"time"
+
+	repository "github.com/aruncs31s/esdcprojectmodule/interfaces/repository"
+	"github.com/aruncs31s/esdcprojectmodule/models"
+	"gorm.io/gorm"
+)
+
+type projectInviteRepository struct {
+	db *gorm.DB
+}
+
+func NewProjectInviteRepository(db *gorm.DB) repository.ProjectInviteRepository {
+	return &projectInviteRepository{
+		db: db,
+	}
+}
+
+func (r *projectInviteRepository) Create(invites []models.ProjectInvite) error {
+	if len(invites) == 0 {
+		return nil
+	}
+	return r.db.Create(&invites).Error
+}
+
+func (r *projectInviteRepository) GetByID(id uint) (*models.ProjectInvite, error) {
+	var invite models.ProjectInvite
+	if err := r.db.
+		Preload("Project").
+		Preload("Invitee").
+		Preload("Inviter").
+		First(&invite, id).Error; err != nil {
+		return nil, err
+	}
+	return &invite, nil
+}
+
+func (r *projectInviteRepository) GetPendingForUser(userID uint) ([]models.ProjectInvite, error) {
+	var invites []models.ProjectInvite
+	if err := r.db.
+		Preload("Project").
+		Preload("Invitee").
+		Preload("Inviter").
+		Where("invitee_id = ? AND status = ?", userID, models.InviteStatusPending).
+		Order("created_at DESC").
+		Find(&invites).Error; err != nil {
+		return nil, err
+	}
+	return invites, nil
+}
+
+func (r *projectInviteRepository) GetByProject(projectID uint) ([]models.ProjectInvite, error) {
+	var invites []models.ProjectInvite
+	if err := r.db.
+		Preload("Project").
+		Preload("Invitee").
+		Preload("Inviter").
+		Where("project_id = ?", projectID).
+		Order("created_at DESC").
+		Find(&invites).Error; err != nil {
+		return nil, err
+	}
+	return invites, nil
+}
+
+func (r *projectInviteRepository) GetPendingInviteeIDs(projectID uint) ([]uint, error) {
+	var ids []uint
+	err := r.db.Model(&models.ProjectInvite{}).
+		Where("project_id = ? AND status = ?", projectID, models.InviteStatusPending).
+		Pluck("invitee_id", &ids).Error
+	return ids, err
+}
+
+func (r *projectInviteRepository) Accept(invite *models.ProjectInvite) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		now := time.Now()
+		result := tx.Model(&models.ProjectInvite{}).
+			Where("id = ? AND status = ?", invite.ID, models.InviteStatusPending).
+			Updates(map[string]interface{}{"status": models.InviteStatusAccepted, "responded_at": now})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+		// Association().Append does not work on the *[]User contributors field, so write the join row directly.
+		return addContributor(tx, invite.ProjectID, invite.InviteeID, invite.Role)
+	})
+}
+
+func (r *projectInviteRepository) UpdateStatus(id uint, status string) error {
+	result := r.db.Model(&models.ProjectInvite{}).
+		Where("id = ? AND status = ?", id, models.InviteStatusPending).
+		Updates(map[string]interface{}{"status": status, "responded_at": time.Now()})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
@@ -0,0 +1,107 @@
+package repository
+
+import (
+	"time"
+
+	model "github.com/aruncs31s/esdcmodels"
+	repository "github.com/aruncs31s/esdcprojectmodule/interfaces/repository"
+	"github.com/aruncs31s/esdcprojectmodule/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// currentLinkCheck keeps the checks whose url is still the link of their project.
+const currentLinkCheck = `(project_link_checks.kind = ? AND project_link_checks.url = projects.github_link)
+	OR (project_link_checks.kind = ? AND project_link_checks.url = projects.live_url)`
+
+type projectLinkRepository struct {
+	db *gorm.DB
+}
+
+func NewProjectLinkRepository(db *gorm.DB) repository.ProjectLinkRepository {
+	return &projectLinkRepository{
+		db: db,
+	}
+}
+
+func (r *projectLinkRepository) GetUnchecked(before time.Time, limit int) ([]model.Project, error) {
+	var projects []model.Project
+	if err := r.db.
+		Model(&model.Project{}).
+		Select("id", "github_link", "live_url").
+		Where("github_link <> '' OR live_url <> ''").
+		Where("id NOT IN (?)", r.db.
+			Model(&models.ProjectLinkCheck{}).
+			Select("project_id").
+			Where("checked_at >= ?", before)).
+		Order("id").
+		Limit(limit).
+		Find(&projects).Error; err != nil {
+		return nil, err
+	}
+	return projects, nil
+}
+
+func (r *projectLinkRepository) GetChecks(projectID uint) ([]models.ProjectLinkCheck, error) {
+	var checks []models.ProjectLinkCheck
+	if err := r.db.
+		Where("project_id = ?", projectID).
+		Order("kind").
+		Find(&checks).Error; err != nil {
+		return nil, err
+	}
+	return checks, nil
+}
+
+func (r *projectLinkRepository) SaveCheck(check *models.ProjectLinkCheck) error {
+	return r.db.Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "project_id"}, {Name: "kind"}},
+		DoUpdates: clause.AssignmentColumns([]string{
+			"url", "status_code", "error", "latency_ms", "healthy", "checked_at", "failing_since",
+		}),
+	}).Create(check).Error
+}
+
+func (r *projectLinkRepository) DeleteCheck(projectID uint, kind string) error {
+	return r.db.
+		Where("project_id = ? AND kind = ?", projectID, kind).
+		Delete(&models.ProjectLinkCheck{}).Error
+}
+
+func (r *projectLinkRepository) GetBroken(createdBy uint, limit, offset int) ([]models.ProjectLinkCheck, error) {
+	var checks []models.ProjectLinkCheck
+	query := r.brokenChecks().Preload("Project")
+	if createdBy != 0 {
+		query = query.Where("projects.created_by = ?", createdBy)
+	}
+	if err := query.
+		Order("project_link_checks.failing_since, project_link_checks.id").
+		Limit(limit).
+		Offset(offset).
+		Find(&checks).Error; err != nil {
+		return nil, err
+	}
+	return checks, nil
+}
+
+func (r *projectLinkRepository) CountChecks() (int64, int64, error) {
+	var checked, broken int64
+	if err := r.currentChecks().Count(&checked).Error; err != nil {
+		return 0, 0, err
+	}
+	if err := r.brokenChecks().Count(&broken).Error; err != nil {
+		return 0, 0, err
+	}
+	return checked, broken, nil
+}
+
+func (r *projectLinkRepository) currentChecks() *gorm.DB {
+	return r.db.
+		Model(&models.ProjectLinkCheck{}).
+		Joins("JOIN projects ON projects.id = project_link_checks.project_id").
+		Where(currentLinkCheck, models.LinkKindGitHub, models.LinkKindLive)
+}
+
+func (r *projectLinkRepository) brokenChecks() *gorm.DB {
+	return r.currentChecks().Where("project_link_checks.healthy = ?", false)
+}
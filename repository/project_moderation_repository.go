@@ -0,0 +1,137 @@
+package repository
+
+import (
+	model "github.com/aruncs31s/esdcmodels"
+	repository "github.com/aruncs31s/esdcprojectmodule/interfaces/repository"
+	"github.com/aruncs31s/esdcprojectmodule/models"
+	"gorm.io/gorm"
+)
+
+type projectModerationRepository struct {
+	db *gorm.DB
+}
+
+func NewProjectModerationRepository(db *gorm.DB) repository.ProjectModerationRepository {
+	return &projectModerationRepository{
+		db: db,
+	}
+}
+
+func (r *projectModerationRepository) HasOpenReport(projectID, reporterID uint) (bool, error) {
+	var count int64
+	if err := r.db.Model(&models.ProjectReport{}).
+		Where("project_id = ? AND reporter_id = ? AND status = ?", projectID, reporterID, models.ReportStatusOpen).
+		Count(&count).Error; err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+func (r *projectModerationRepository) CreateReport(report *models.ProjectReport) (int, error) {
+	var reporters int64
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(report).Error; err != nil {
+			return err
+		}
+		return tx.Model(&models.ProjectReport{}).
+			Where("project_id = ? AND status = ?", report.ProjectID, models.ReportStatusOpen).
+			Distinct("reporter_id").
+			Count(&reporters).Error
+	})
+	return int(reporters), err
+}
+
+func (r *projectModerationRepository) GetQueue(limit, offset int) ([]models.ProjectReportSummary, error) {
+	var summaries []models.ProjectReportSummary
+	if err := r.db.Model(&models.ProjectReport{}).
+		Select("project_reports.project_id, COUNT(DISTINCT project_reports.reporter_id) AS reports").
+		Joins("JOIN projects ON projects.id = project_reports.project_id").
+		Where("project_reports.status = ?", models.ReportStatusOpen).
+		Group("project_reports.project_id").
+		Order("reports DESC").
+		Order("MAX(project_reports.id) DESC").
+		Limit(limit).
+		Offset(offset).
+		Scan(&summaries).Error; err != nil {
+		return nil, err
+	}
+	return summaries, nil
+}
+
+func (r *projectModerationRepository) GetOpenReports(projectIDs []uint) ([]models.ProjectReport, error) {
+	var reports []models.ProjectReport
+	if len(projectIDs) == 0 {
+		return reports, nil
+	}
+	if err := r.db.
+		Where("project_id IN ? AND status = ?", projectIDs, models.ReportStatusOpen).
+		Order("id DESC").
+		Find(&reports).Error; err != nil {
+		return nil, err
+	}
+	return reports, nil
+}
+
+func (r *projectModerationRepository) GetReports(projectID uint) ([]models.ProjectReport, error) {
+	var reports []models.ProjectReport
+	if err := r.db.
+		Preload("Reporter").
+		Where("project_id = ?", projectID).
+		Order("id DESC").
+		Find(&reports).Error; err != nil {
+		return nil, err
+	}
+	return reports, nil
+}
+
+func (r *projectModerationRepository) GetDecisions(projectID uint) ([]models.ProjectModerationDecision, error) {
+	var decisions []models.ProjectModerationDecision
+	if err := r.db.
+		Preload("Moderator").
+		Where("project_id = ?", projectID).
+		Order("id DESC").
+		Find(&decisions).Error; err != nil {
+		return nil, err
+	}
+	return decisions, nil
+}
+
+func (r *projectModerationRepository) GetLastHide(projectID uint) (*models.ProjectModerationDecision, error) {
+	var decision models.ProjectModerationDecision
+	if err := r.db.
+		Where("project_id = ? AND action = ?", projectID, models.ModerationHide).
+		Order("id DESC").
+		First(&decision).Error; err != nil {
+		return nil, err
+	}
+	return &decision, nil
+}
+
+func (r *projectModerationRepository) Decide(decision *models.ProjectModerationDecision, status string, notification *model.Notification) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(decision).Error; err != nil {
+			return err
+		}
+		if !decision.IsAutomatic() {
+			if err := tx.Model(&models.ProjectReport{}).
+				Where("project_id = ? AND status = ?", decision.ProjectID, models.ReportStatusOpen).
+				Updates(map[string]interface{}{"status": models.ReportStatusResolved, "decision_id": decision.ID}).Error; err != nil {
+				return err
+			}
+		}
+		if status != "" {
+			// Automatic decisions have no moderator, their revision has no modifier.
+			var modifiedBy uint
+			if decision.DecidedBy != nil {
+				modifiedBy = *decision.DecidedBy
+			}
+			if err := updateProject(tx, decision.ProjectID, models.ProjectChanges{
+				Fields:     map[string]interface{}{"status": status},
+				ModifiedBy: modifiedBy,
+			}); err != nil {
+				return err
+			}
+		}
+		return tx.Create(notification).Error
+	})
+}
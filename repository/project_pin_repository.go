@@ -0,0 +1,75 @@
+package repository
+
+import (
+	model "github.com/aruncs31s/esdcmodels"
+	repository "github.com/aruncs31s/esdcprojectmodule/interfaces/repository"
+	"github.com/aruncs31s/esdcprojectmodule/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type projectPinRepository struct {
+	db *gorm.DB
+}
+
+func NewProjectPinRepository(db *gorm.DB) repository.ProjectPinRepository {
+	return &projectPinRepository{
+		db: db,
+	}
+}
+
+func (r *projectPinRepository) GetPinned(userID uint) ([]model.Project, error) {
+	var projects []model.Project
+	// Pins of projects the user transferred away are left out.
+	if err := r.db.
+		Preload("Contributors").
+		Preload("Creator").
+		Preload("Tags").
+		Preload("Technologies").
+		Joins("JOIN project_pins ON project_pins.project_id = projects.id AND project_pins.user_id = projects.created_by").
+		Where("project_pins.user_id = ?", userID).
+		Order("project_pins.position ASC").
+		Find(&projects).Error; err != nil {
+		return nil, err
+	}
+	return projects, nil
+}
+
+func (r *projectPinRepository) Pin(userID, projectID uint) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		var last int
+		if err := tx.Model(&models.ProjectPin{}).
+			Where("user_id = ?", userID).
+			Select("COALESCE(MAX(position), 0)").
+			Scan(&last).Error; err != nil {
+			return err
+		}
+		return tx.
+			Clauses(clause.OnConflict{DoNothing: true}).
+			Create(&models.ProjectPin{UserID: userID, ProjectID: projectID, Position: last + 1}).Error
+	})
+}
+
+func (r *projectPinRepository) Unpin(userID, projectID uint) error {
+	result := r.db.Where("user_id = ? AND project_id = ?", userID, projectID).Delete(&models.ProjectPin{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+func (r *projectPinRepository) Reorder(userID uint, projectIDs []uint) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		for i, projectID := range projectIDs {
+			if err := tx.Model(&models.ProjectPin{}).
+				Where("user_id = ? AND project_id = ?", userID, projectID).
+				Update("position", i+1).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
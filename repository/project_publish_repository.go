@@ -0,0 +1,74 @@
+package repository
+
+import (
+	"time"
+
+	model "github.com/aruncs31s/esdcmodels"
+	repository "github.com/aruncs31s/esdcprojectmodule/interfaces/repository"
+	"github.com/aruncs31s/esdcprojectmodule/models"
+	utils "github.com/aruncs31s/esdcprojectmodule/utils"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type projectPublishRepository struct {
+	db *gorm.DB
+}
+
+func NewProjectPublishRepository(db *gorm.DB) repository.ProjectPublishRepository {
+	return &projectPublishRepository{
+		db: db,
+	}
+}
+
+func (r *projectPublishRepository) Publish(projectID, publishedBy uint) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&model.Project{}).
+			Where("id = ? AND status = ?", projectID, models.StatusDraft).
+			Updates(map[string]interface{}{
+				"status":      models.StatusActive,
+				"modified_by": publishedBy,
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return utils.ErrNotDraft
+		}
+		if err := tx.Where("project_id = ?", projectID).Delete(&models.ProjectSchedule{}).Error; err != nil {
+			return err
+		}
+		return writeRevision(tx, projectID, publishedBy, nil)
+	})
+}
+
+func (r *projectPublishRepository) Schedule(schedule *models.ProjectSchedule) error {
+	return r.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "project_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"publish_at", "scheduled_by", "updated_at"}),
+	}).Create(schedule).Error
+}
+
+func (r *projectPublishRepository) GetSchedule(projectID uint) (*models.ProjectSchedule, error) {
+	var schedule models.ProjectSchedule
+	if err := r.db.Where("project_id = ?", projectID).First(&schedule).Error; err != nil {
+		return nil, err
+	}
+	return &schedule, nil
+}
+
+func (r *projectPublishRepository) CancelSchedule(projectID uint) error {
+	return r.db.Where("project_id = ?", projectID).Delete(&models.ProjectSchedule{}).Error
+}
+
+func (r *projectPublishRepository) GetDue(now time.Time, limit int) ([]models.ProjectSchedule, error) {
+	var schedules []models.ProjectSchedule
+	if err := r.db.
+		Where("publish_at <= ?", now).
+		Order("publish_at ASC").
+		Limit(limit).
+		Find(&schedules).Error; err != nil {
+		return nil, err
+	}
+	return schedules, nil
+}
@@ -0,0 +1,92 @@
+package repository
+
+import (
+	"errors"
+
+	model "github.com/aruncs31s/esdcmodels"
+	repository "github.com/aruncs31s/esdcprojectmodule/interfaces/repository"
+	"github.com/aruncs31s/esdcprojectmodule/models"
+	utils "github.com/aruncs31s/esdcprojectmodule/utils"
+	"gorm.io/gorm"
+)
+
+type projectReleaseRepository struct {
+	db *gorm.DB
+}
+
+func NewProjectReleaseRepository(db *gorm.DB) repository.ProjectReleaseRepository {
+	return &projectReleaseRepository{
+		db: db,
+	}
+}
+
+func (r *projectReleaseRepository) Create(release *models.ProjectRelease) error {
+	version, err := utils.ParseSemver(release.Version)
+	if err != nil {
+		return utils.ErrInvalidVersion
+	}
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		latest, err := latestRelease(tx, release.ProjectID)
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return err
+		}
+		if latest != nil {
+			previous, err := utils.ParseSemver(latest.Version)
+			if err == nil && version.Compare(previous) <= 0 {
+				return utils.ErrVersionNotIncreased
+			}
+		}
+		// Before the first release the project version is bumped by its edits, the
+		// release must not take it back.
+		var project model.Project
+		if err := tx.Select("id", "version").First(&project, release.ProjectID).Error; err != nil {
+			return err
+		}
+		if current, err := utils.ParseSemver(project.Version); err == nil && version.Compare(current) < 0 {
+			return utils.ErrVersionNotIncreased
+		}
+		if err := tx.Create(release).Error; err != nil {
+			return err
+		}
+		return updateProject(tx, release.ProjectID, models.ProjectChanges{
+			Fields:     map[string]interface{}{"version": release.Version},
+			ModifiedBy: release.CreatedBy,
+		})
+	})
+}
+
+func (r *projectReleaseRepository) GetReleases(projectID uint, limit, offset int) ([]models.ProjectRelease, error) {
+	var releases []models.ProjectRelease
+	if err := r.db.
+		Preload("Creator").
+		Where("project_id = ?", projectID).
+		Order("id DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&releases).Error; err != nil {
+		return nil, err
+	}
+	return releases, nil
+}
+
+func (r *projectReleaseRepository) GetLatest(projectID uint) (*models.ProjectRelease, error) {
+	release, err := latestRelease(r.db.Preload("Creator"), projectID)
+	if err != nil {
+		return nil, err
+	}
+	return release, nil
+}
+
+// latestRelease retrieves the newest release of a project.
+//
+// Versions only ever go up, so the newest row is also the highest version.
+func latestRelease(db *gorm.DB, projectID uint) (*models.ProjectRelease, error) {
+	var release models.ProjectRelease
+	if err := db.
+		Where("project_id = ?", projectID).
+		Order("id DESC").
+		First(&release).Error; err != nil {
+		return nil, err
+	}
+	return &release, nil
+}
@@ -0,0 +1,61 @@
+package repository
+
+import (
+	"strings"
+	"time"
+
+	model "github.com/aruncs31s/esdcmodels"
+	repository "github.com/aruncs31s/esdcprojectmodule/interfaces/repository"
+	"github.com/aruncs31s/esdcprojectmodule/models"
+	"gorm.io/gorm"
+)
+
+type projectRepoMetadataRepository struct {
+	db *gorm.DB
+}
+
+func NewProjectRepoMetadataRepository(db *gorm.DB) repository.ProjectRepoMetadataRepository {
+	return &projectRepoMetadataRepository{
+		db: db,
+	}
+}
+
+func (r *projectRepoMetadataRepository) Get(projectID uint) (*models.ProjectRepoMetadata, error) {
+	var metadata models.ProjectRepoMetadata
+	if err := r.db.Where("project_id = ?", projectID).First(&metadata).Error; err != nil {
+		return nil, err
+	}
+	return &metadata, nil
+}
+
+func (r *projectRepoMetadataRepository) Save(metadata *models.ProjectRepoMetadata) error {
+	return r.db.Save(metadata).Error
+}
+
+func (r *projectRepoMetadataRepository) GetStale(before time.Time, limit int) ([]model.Project, error) {
+	var projects []model.Project
+	if err := r.db.
+		Model(&model.Project{}).
+		Select("projects.id", "projects.github_link").
+		Joins("LEFT JOIN project_repo_metadata ON project_repo_metadata.project_id = projects.id").
+		Where("projects.github_link <> ''").
+		Where("project_repo_metadata.project_id IS NULL OR project_repo_metadata.synced_at < ? OR project_repo_metadata.repo_url <> projects.github_link", before).
+		Order("projects.id").
+		Limit(limit).
+		Find(&projects).Error; err != nil {
+		return nil, err
+	}
+	return projects, nil
+}
+
+func (r *projectRepoMetadataRepository) FindLinked(fullName string) ([]model.Project, error) {
+	var projects []model.Project
+	if err := r.db.
+		Model(&model.Project{}).
+		Select("id", "github_link").
+		Where("LOWER(github_link) LIKE ?", "%"+strings.ToLower(fullName)+"%").
+		Find(&projects).Error; err != nil {
+		return nil, err
+	}
+	return projects, nil
+}
@@ -0,0 +1,88 @@
+package repository
+
+import (
+	model "github.com/aruncs31s/esdcmodels"
+	repository "github.com/aruncs31s/esdcprojectmodule/interfaces/repository"
+	"github.com/aruncs31s/esdcprojectmodule/models"
+	"gorm.io/gorm"
+)
+
+type projectRevisionRepository struct {
+	db *gorm.DB
+}
+
+func NewProjectRevisionRepository(db *gorm.DB) repository.ProjectRevisionRepository {
+	return &projectRevisionRepository{
+		db: db,
+	}
+}
+
+func (r *projectRevisionRepository) GetRevisions(projectID uint, limit, offset int) ([]models.ProjectRevision, error) {
+	var revisions []models.ProjectRevision
+	if err := r.db.
+		Where("project_id = ?", projectID).
+		Order("revision DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&revisions).Error; err != nil {
+		return nil, err
+	}
+	return revisions, nil
+}
+
+func (r *projectRevisionRepository) GetRevision(projectID uint, revision int) (*models.ProjectRevision, error) {
+	var rev models.ProjectRevision
+	if err := r.db.
+		Where("project_id = ? AND revision = ?", projectID, revision).
+		First(&rev).Error; err != nil {
+		return nil, err
+	}
+	return &rev, nil
+}
+
+// writeRevision stores a snapshot of the current state of the project.
+//
+// It must be called inside the transaction that changed the project.
+func writeRevision(tx *gorm.DB, projectID, modifiedBy uint, restoredFrom *int) error {
+	var project model.Project
+	if err := tx.
+		Preload("Tags").
+		Preload("Technologies").
+		First(&project, projectID).Error; err != nil {
+		return err
+	}
+	var last int
+	if err := tx.Model(&models.ProjectRevision{}).
+		Where("project_id = ?", projectID).
+		Select("COALESCE(MAX(revision), 0)").
+		Scan(&last).Error; err != nil {
+		return err
+	}
+	revision := models.ProjectRevision{
+		ProjectID:    project.ID,
+		Revision:     last + 1,
+		Title:        project.Title,
+		Description:  project.Description,
+		GithubLink:   project.GithubLink,
+		LiveURL:      project.LiveURL,
+		Tags:         make([]string, 0),
+		Technologies: make([]string, 0),
+		Status:       project.Status,
+		Visibility:   project.Visibility,
+		Cost:         project.Cost,
+		Version:      project.Version,
+		RestoredFrom: restoredFrom,
+		ModifiedBy:   modifiedBy,
+	}
+	if project.Tags != nil {
+		for _, tag := range *project.Tags {
+			revision.Tags = append(revision.Tags, tag.Name)
+		}
+	}
+	if project.Technologies != nil {
+		for _, tech := range *project.Technologies {
+			revision.Technologies = append(revision.Technologies, tech.Name)
+		}
+	}
+	return tx.Create(&revision).Error
+}
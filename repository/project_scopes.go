@@ -0,0 +1,38 @@
+package repository
+
+import (
+	"fmt"
+
+	"github.com/aruncs31s/esdcprojectmodule/models"
+	"gorm.io/gorm"
+)
+
+// listedProjects limits a query to the projects everyone can see,
+// public and neither a draft nor hidden.
+func listedProjects(db *gorm.DB) *gorm.DB {
+	return db.
+		Where("visibility = ?", models.VisibilityPublic).
+		Where("status NOT IN ?", models.UnlistedStatuses)
+}
+
+// byRecentActivity orders projects by their last repository activity, falling
+// back to the last update for projects without one, newest first.
+func byRecentActivity(db *gorm.DB) *gorm.DB {
+	return db.
+		Order("COALESCE((SELECT last_activity_at FROM project_activity WHERE project_activity.project_id = projects.id), projects.updated_at) DESC").
+		Order("projects.id DESC")
+}
+
+// pinnedFirst orders the projects the user pinned, and still owns, before the
+// others, by the position of the pin.
+func pinnedFirst(userID uint) func(db *gorm.DB) *gorm.DB {
+	// The ID is formatted in, GORM drops the vars of an order expression once
+	// another order is added after it.
+	pin := fmt.Sprintf("(SELECT project_pins.position FROM project_pins WHERE project_pins.project_id = projects.id AND project_pins.user_id = projects.created_by AND project_pins.user_id = %d)", userID)
+	return func(db *gorm.DB) *gorm.DB {
+		// Sorting on IS NULL first keeps unpinned projects last whatever the database does with NULLs.
+		return db.
+			Order("CASE WHEN " + pin + " IS NULL THEN 1 ELSE 0 END").
+			Order(pin)
+	}
+}
@@ -0,0 +1,116 @@
+package repository
+
+import (
+	"time"
+
+	model "github.com/aruncs31s/esdcmodels"
+	repository "github.com/aruncs31s/esdcprojectmodule/interfaces/repository"
+	"github.com/aruncs31s/esdcprojectmodule/models"
+	"gorm.io/gorm"
+)
+
+type projectStatsRepository struct {
+	db *gorm.DB
+}
+
+func NewProjectStatsRepository(db *gorm.DB) repository.ProjectStatsRepository {
+	return &projectStatsRepository{
+		db: db,
+	}
+}
+
+func (r *projectStatsRepository) GetTotals() (*models.ProjectTotals, error) {
+	var totals models.ProjectTotals
+	if err := r.db.
+		Model(&model.Project{}).
+		Select("COUNT(*) AS projects, COALESCE(AVG(likes), 0) AS average_likes, COALESCE(AVG(views), 0) AS average_views").
+		Scan(&totals).Error; err != nil {
+		return nil, err
+	}
+	return &totals, nil
+}
+
+func (r *projectStatsRepository) CountByStatus() ([]models.ProjectStatCount, error) {
+	return r.countBy("status")
+}
+
+func (r *projectStatsRepository) CountByVisibility() ([]models.ProjectStatCount, error) {
+	return r.countBy("visibility")
+}
+
+func (r *projectStatsRepository) CountByCategory() ([]models.ProjectStatCount, error) {
+	return r.countBy("category")
+}
+
+// countBy groups the projects by column, which must not come from the request.
+func (r *projectStatsRepository) countBy(column string) ([]models.ProjectStatCount, error) {
+	var counts []models.ProjectStatCount
+	if err := r.db.
+		Model(&model.Project{}).
+		Select(column + " AS value, COUNT(*) AS count").
+		Group(column).
+		Order("count DESC, value").
+		Scan(&counts).Error; err != nil {
+		return nil, err
+	}
+	return counts, nil
+}
+
+func (r *projectStatsRepository) CountCreatedPerDay(since time.Time) ([]models.ProjectDayCount, error) {
+	var counts []models.ProjectDayCount
+	if err := r.db.
+		Model(&model.Project{}).
+		Select("DATE(created_at) AS day, COUNT(*) AS count").
+		Where("created_at >= ?", since).
+		Group("DATE(created_at)").
+		Order("day").
+		Scan(&counts).Error; err != nil {
+		return nil, err
+	}
+	return counts, nil
+}
+
+func (r *projectStatsRepository) GetTopTags(limit int) ([]models.ProjectStatCount, error) {
+	var counts []models.ProjectStatCount
+	if err := r.db.
+		Table("project_tags").
+		Select("tags.name AS value, COUNT(*) AS count").
+		Joins("JOIN tags ON tags.id = project_tags.tag_id").
+		Group("tags.id, tags.name").
+		Order("count DESC, value").
+		Limit(limit).
+		Scan(&counts).Error; err != nil {
+		return nil, err
+	}
+	return counts, nil
+}
+
+func (r *projectStatsRepository) GetTopTechnologies(limit int) ([]models.ProjectStatCount, error) {
+	var counts []models.ProjectStatCount
+	if err := r.db.
+		Table("project_technologies").
+		Select("technologies.name AS value, COUNT(*) AS count").
+		Joins("JOIN technologies ON technologies.id = project_technologies.technologies_id").
+		Group("technologies.id, technologies.name").
+		Order("count DESC, value").
+		Limit(limit).
+		Scan(&counts).Error; err != nil {
+		return nil, err
+	}
+	return counts, nil
+}
+
+func (r *projectStatsRepository) GetTopCreators(limit int) ([]models.ProjectCreatorStat, error) {
+	var creators []models.ProjectCreatorStat
+	if err := r.db.
+		Model(&model.Project{}).
+		Select("projects.created_by AS user_id, users.username AS username, COUNT(*) AS projects, COALESCE(SUM(projects.likes), 0) AS likes").
+		Joins("JOIN users ON users.id = projects.created_by").
+		Group("projects.created_by, users.username").
+		Order("projects DESC, likes DESC, username").
+		Limit(limit).
+		Scan(&creators).Error; err != nil {
+		return nil, err
+	}
+	return creators, nil
+}
@@ -0,0 +1,128 @@
+package repository
+
+import (
+	"time"
+
+	model "github.com/aruncs31s/esdcmodels"
+	repository "github.com/aruncs31s/esdcprojectmodule/interfaces/repository"
+	"github.com/aruncs31s/esdcprojectmodule/models"
+	"gorm.io/gorm"
+)
+
+type projectTransferRepository struct {
+	db *gorm.DB
+}
+
+func NewProjectTransferRepository(db *gorm.DB) repository.ProjectTransferRepository {
+	return &projectTransferRepository{
+		db: db,
+	}
+}
+
+func (r *projectTransferRepository) Create(transfer *models.ProjectTransfer) error {
+	return r.db.Create(transfer).Error
+}
+
+func (r *projectTransferRepository) GetByID(id uint) (*models.ProjectTransfer, error) {
+	var transfer models.ProjectTransfer
+	if err := r.preloaded().First(&transfer, id).Error; err != nil {
+		return nil, err
+	}
+	return &transfer, nil
+}
+
+func (r *projectTransferRepository) GetPendingForUser(userID uint) ([]models.ProjectTransfer, error) {
+	var transfers []models.ProjectTransfer
+	if err := r.preloaded().
+		Where("to_user_id = ? AND status = ?", userID, models.TransferStatusPending).
+		Order("created_at DESC").
+		Find(&transfers).Error; err != nil {
+		return nil, err
+	}
+	return transfers, nil
+}
+
+func (r *projectTransferRepository) GetPendingForProject(projectID uint) (*models.ProjectTransfer, error) {
+	var transfer models.ProjectTransfer
+	if err := r.preloaded().
+		Where("project_id = ? AND status = ?", projectID, models.TransferStatusPending).
+		First(&transfer).Error; err != nil {
+		return nil, err
+	}
+	return &transfer, nil
+}
+
+func (r *projectTransferRepository) GetByProject(projectID uint) ([]models.ProjectTransfer, error) {
+	var transfers []models.ProjectTransfer
+	if err := r.preloaded().
+		Where("project_id = ?", projectID).
+		Order("created_at DESC").
+		Find(&transfers).Error; err != nil {
+		return nil, err
+	}
+	return transfers, nil
+}
+
+func (r *projectTransferRepository) UpdateStatus(id uint, status string) error {
+	result := r.db.Model(&models.ProjectTransfer{}).
+		Where("id = ? AND status = ?", id, models.TransferStatusPending).
+		Updates(map[string]interface{}{"status": status, "responded_at": time.Now()})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+func (r *projectTransferRepository) Complete(transfer *models.ProjectTransfer) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		return completeTransfer(tx, transfer)
+	})
+}
+
+// completeTransfer records the transfer as accepted and hands the project over inside tx, see Complete.
+func completeTransfer(tx *gorm.DB, transfer *models.ProjectTransfer) error {
+	now := time.Now()
+	transfer.Status = models.TransferStatusAccepted
+	transfer.RespondedAt = &now
+	if transfer.ID == 0 {
+		// Forced transfers are recorded and completed at once.
+		if err := tx.Create(transfer).Error; err != nil {
+			return err
+		}
+	} else {
+		result := tx.Model(&models.ProjectTransfer{}).
+			Where("id = ? AND status = ?", transfer.ID, models.TransferStatusPending).
+			Updates(map[string]interface{}{"status": transfer.Status, "responded_at": now})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+	}
+	// A forced transfer replaces any transfer still waiting for an answer.
+	if err := tx.Model(&models.ProjectTransfer{}).
+		Where("project_id = ? AND status = ? AND id <> ?", transfer.ProjectID, models.TransferStatusPending, transfer.ID).
+		Updates(map[string]interface{}{"status": models.TransferStatusCancelled, "responded_at": now}).Error; err != nil {
+		return err
+	}
+	if err := tx.Model(&model.Project{}).
+		Where("id = ?", transfer.ProjectID).
+		Updates(map[string]interface{}{"created_by": transfer.ToUserID, "modified_by": transfer.RequestedBy}).Error; err != nil {
+		return err
+	}
+	if err := addContributor(tx, transfer.ProjectID, transfer.ToUserID, models.RoleOwner); err != nil {
+		return err
+	}
+	return addContributor(tx, transfer.ProjectID, transfer.FromUserID, models.RoleMaintainer)
+}
+
+func (r *projectTransferRepository) preloaded() *gorm.DB {
+	return r.db.
+		Preload("Project").
+		Preload("FromUser").
+		Preload("ToUser")
+}
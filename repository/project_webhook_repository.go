@@ -0,0 +1,94 @@
+package repository
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	repository "github.com/aruncs31s/esdcprojectmodule/interfaces/repository"
+	"github.com/aruncs31s/esdcprojectmodule/models"
+	"gorm.io/gorm"
+)
+
+type projectWebhookRepository struct {
+	db *gorm.DB
+}
+
+func NewProjectWebhookRepository(db *gorm.DB) repository.ProjectWebhookRepository {
+	return &projectWebhookRepository{
+		db: db,
+	}
+}
+
+func (r *projectWebhookRepository) Get(projectID uint) (*models.ProjectWebhook, error) {
+	var webhook models.ProjectWebhook
+	if err := r.db.Where("project_id = ?", projectID).First(&webhook).Error; err != nil {
+		return nil, err
+	}
+	return &webhook, nil
+}
+
+func (r *projectWebhookRepository) Save(webhook *models.ProjectWebhook) error {
+	return r.db.Save(webhook).Error
+}
+
+func (r *projectWebhookRepository) Delete(projectID uint) error {
+	return r.db.Where("project_id = ?", projectID).Delete(&models.ProjectWebhook{}).Error
+}
+
+func (r *projectWebhookRepository) FindByRepo(fullName string) ([]models.ProjectWebhook, error) {
+	var webhooks []models.ProjectWebhook
+	if err := r.db.
+		Preload("Project").
+		Where("project_id IN (?)", r.db.Table("projects").
+			Select("id").
+			Where("LOWER(github_link) LIKE ?", "%"+strings.ToLower(fullName)+"%")).
+		Find(&webhooks).Error; err != nil {
+		return nil, err
+	}
+	return webhooks, nil
+}
+
+func (r *projectWebhookRepository) HasEvent(projectID uint, deliveryID string) (bool, error) {
+	var count int64
+	if err := r.db.
+		Model(&models.ProjectWebhookEvent{}).
+		Where("project_id = ? AND delivery_id = ?", projectID, deliveryID).
+		Count(&count).Error; err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+func (r *projectWebhookRepository) RecordEvent(event *models.ProjectWebhookEvent) error {
+	return r.db.Create(event).Error
+}
+
+func (r *projectWebhookRepository) GetEvents(projectID uint, limit, offset int) ([]models.ProjectWebhookEvent, error) {
+	var events []models.ProjectWebhookEvent
+	if err := r.db.
+		Where("project_id = ?", projectID).
+		Order("id DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&events).Error; err != nil {
+		return nil, err
+	}
+	return events, nil
+}
+
+func (r *projectWebhookRepository) TouchActivity(projectID uint, at time.Time) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		var activity models.ProjectActivity
+		err := tx.Where("project_id = ?", projectID).First(&activity).Error
+		switch {
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			return tx.Create(&models.ProjectActivity{ProjectID: projectID, LastActivityAt: at}).Error
+		case err != nil:
+			return err
+		case activity.LastActivityAt.After(at):
+			return nil
+		}
+		return tx.Model(&activity).Update("last_activity_at", at).Error
+	})
+}
@@ -3,8 +3,11 @@ package repository
 import (
 	commonModules "github.com/aruncs31s/esdcmodels"
 	"github.com/aruncs31s/esdcprojectmodule/interfaces/repository"
+	"github.com/aruncs31s/esdcprojectmodule/models"
+	"github.com/aruncs31s/esdcprojectmodule/utils"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type projectRepositoryMixed struct {
@@ -53,8 +56,12 @@ func (r *projectRepository) GetByID(id uint) (commonModules.Project, error) {
 	return r.reader.GetByID(id)
 }
 
-func (r *projectRepository) GetEssentialInfo(limit, offset int) (*[]commonModules.Project, error) {
-	return r.reader.GetEssentialInfo(limit, offset)
+func (r *projectRepository) GetByIDIncludingPrivate(id uint) (commonModules.Project, error) {
+	return r.reader.GetByIDIncludingPrivate(id)
+}
+
+func (r *projectRepository) GetEssentialInfo(filter models.ProjectFilter, limit, offset int) (*[]commonModules.Project, error) {
+	return r.reader.GetEssentialInfo(filter, limit, offset)
 }
 
 func (r *projectRepository) GetProjectsCount() (int, error) {
@@ -65,10 +72,18 @@ func (r *projectRepository) IsLiked(userID uint, projectID uint) (bool, error) {
 	return r.reader.IsLiked(userID, projectID)
 }
 
-func (r *projectRepository) Create(project *commonModules.Project) error {
+func (r *projectRepository) Create(project *models.NewProject) error {
 	return r.writer.Create(project)
 }
 
+func (r *projectRepository) Update(projectID uint, changes models.ProjectChanges) error {
+	return r.writer.Update(projectID, changes)
+}
+
+func (r *projectRepository) Delete(projectID uint) error {
+	return r.writer.Delete(projectID)
+}
+
 func (r *projectRepository) LikeProject(userID uint, projectID uint) error {
 	return r.writer.LikeProject(userID, projectID)
 }
@@ -92,7 +107,7 @@ func (r *projectRepositoryReader) GetPublicProjects(limit, offset int) ([]common
 		Preload("Creator").
 		Preload("Tags").
 		Preload("Technologies").
-		Where("visibility = ?", 0).
+		Scopes(listedProjects, byRecentActivity).
 		Limit(limit).
 		Offset(offset).
 		Find(&projects).Error; err != nil {
@@ -108,7 +123,8 @@ func (r *projectRepositoryReader) GetUserProjects(userID uint, limit, offset int
 		Preload("Creator").
 		Preload("Tags").
 		Preload("Technologies").
-		Where("created_by = ? OR visibility = 0", userID).
+		Where("created_by = ? OR (visibility = ? AND status NOT IN ?)", userID, models.VisibilityPublic, models.UnlistedStatuses).
+		Scopes(byRecentActivity).
 		Limit(limit).
 		Offset(offset).
 		Find(&projects).Error; err != nil {
@@ -127,12 +143,25 @@ func (r *projectRepositoryReader) GetByID(id uint) (commonModules.Project, error
 		First(&project, id).Error; err != nil {
 		return commonModules.Project{}, err
 	}
-	if project.IsPrivate() {
+	if !models.IsListed(project) {
 		return commonModules.Project{}, gorm.ErrRecordNotFound
 	}
 	return project, nil
 }
 
+func (r *projectRepositoryReader) GetByIDIncludingPrivate(id uint) (commonModules.Project, error) {
+	var project commonModules.Project
+	if err := r.db.
+		Preload("Contributors").
+		Preload("Creator").
+		Preload("Tags").
+		Preload("Technologies").
+		First(&project, id).Error; err != nil {
+		return commonModules.Project{}, err
+	}
+	return project, nil
+}
+
 func (r *projectRepositoryReader) GetProjectsCount() (int, error) {
 	var count int64
 	result := r.db.Model(&commonModules.Project{}).Count(&count)
@@ -145,10 +174,117 @@ func (r *projectRepositoryReader) IsLiked(userID uint, projectID uint) (bool, er
 	return count > 0, err
 }
 
-func (r *projectRepositoryWriter) Create(project *commonModules.Project) error {
-	if err := r.db.Create(project).Error; err != nil {
+func (r *projectRepositoryWriter) Create(project *models.NewProject) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		return createProject(tx, project)
+	})
+}
+
+// createProject creates the project with its owner, first revision, schedule and invites inside tx.
+func createProject(tx *gorm.DB, newProject *models.NewProject) error {
+	project := &newProject.Project
+	if err := tx.Create(project).Error; err != nil {
 		return err
 	}
+	if err := addContributor(tx, project.ID, project.CreatedBy, models.RoleOwner); err != nil {
+		return err
+	}
+	if err := writeRevision(tx, project.ID, project.CreatedBy, nil); err != nil {
+		return err
+	}
+	if newProject.PublishAt != nil {
+		if err := tx.Create(&models.ProjectSchedule{
+			ProjectID:   project.ID,
+			PublishAt:   *newProject.PublishAt,
+			ScheduledBy: project.CreatedBy,
+		}).Error; err != nil {
+			return err
+		}
+	}
+	if len(newProject.InviteeIDs) == 0 {
+		return nil
+	}
+	invites := make([]models.ProjectInvite, 0, len(newProject.InviteeIDs))
+	for _, inviteeID := range newProject.InviteeIDs {
+		invites = append(invites, models.ProjectInvite{
+			ProjectID: project.ID,
+			InviteeID: inviteeID,
+			InvitedBy: newProject.InvitedBy,
+			Role:      models.RoleEditor,
+			Status:    models.InviteStatusPending,
+		})
+	}
+	return tx.Create(&invites).Error
+}
+
+func (r *projectRepositoryWriter) Update(projectID uint, changes models.ProjectChanges) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		return updateProject(tx, projectID, changes)
+	})
+}
+
+// updateProject writes the changes and the revision of a project inside tx, see Update.
+func updateProject(tx *gorm.DB, projectID uint, changes models.ProjectChanges) error {
+	var project commonModules.Project
+	if err := tx.Select("id", "version").First(&project, projectID).Error; err != nil {
+		return err
+	}
+	fields := make(map[string]interface{}, len(changes.Fields)+2)
+	for column, value := range changes.Fields {
+		fields[column] = value
+	}
+	fields["modified_by"] = changes.ModifiedBy
+	// Once the project has releases the version follows the latest release.
+	var releases int64
+	if err := tx.Model(&models.ProjectRelease{}).Where("project_id = ?", projectID).Count(&releases).Error; err != nil {
+		return err
+	}
+	if releases == 0 {
+		fields["version"] = utils.BumpPatch(project.Version)
+	}
+	if err := tx.Model(&commonModules.Project{}).Where("id = ?", projectID).Updates(fields).Error; err != nil {
+		return err
+	}
+	if changes.Tags != nil {
+		if err := replaceProjectTags(tx, projectID, *changes.Tags); err != nil {
+			return err
+		}
+	}
+	if changes.Technologies != nil {
+		if err := replaceProjectTechnologies(tx, projectID, *changes.Technologies); err != nil {
+			return err
+		}
+	}
+	return writeRevision(tx, projectID, changes.ModifiedBy, changes.RestoredFrom)
+}
+
+// replaceProjectTags rewrites the project_tags join rows of the project.
+func replaceProjectTags(tx *gorm.DB, projectID uint, tags []commonModules.Tag) error {
+	if err := tx.Exec("DELETE FROM project_tags WHERE project_id = ?", projectID).Error; err != nil {
+		return err
+	}
+	for _, tag := range tags {
+		if err := tx.Table("project_tags").
+			Clauses(clause.OnConflict{DoNothing: true}).
+			Create(map[string]interface{}{"project_id": projectID, "tag_id": tag.ID}).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// replaceProjectTechnologies rewrites the project_technologies join rows of the project.
+func replaceProjectTechnologies(tx *gorm.DB, projectID uint, technologies []commonModules.Technologies) error {
+	if err := tx.Exec("DELETE FROM project_technologies WHERE project_id = ?", projectID).Error; err != nil {
+		return err
+	}
+	for _, tech := range technologies {
+		if err := tx.Table("project_technologies").
+			Clauses(clause.OnConflict{DoNothing: true}).
+			Create(map[string]interface{}{"project_id": projectID, "technologies_id": tech.ID}).Error; err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
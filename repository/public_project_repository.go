@@ -3,6 +3,7 @@ package repository
 import (
 	model "github.com/aruncs31s/esdcmodels"
 	repository "github.com/aruncs31s/esdcprojectmodule/interfaces/repository"
+	"github.com/aruncs31s/esdcprojectmodule/models"
 	"gorm.io/gorm"
 )
 
@@ -27,7 +28,7 @@ func (r *publicProjectRepository) GetAllProjects(limit, offset int) (*[]model.Pr
 		Preload("ViewedBy").
 		Preload("Comments").
 		Preload("Reviews").
-		Where("visibility = ?", 0).
+		Scopes(listedProjects, byRecentActivity).
 		Limit(limit).
 		Offset(offset).
 		Find(&projects).Error; err != nil {
@@ -41,7 +42,8 @@ func (r *publicProjectRepository) GetUserProjects(userID uint, limit, offset int
 		Preload("Contributors").
 		Preload("Tags").
 		Preload("Technologies").
-		Where("created_by = ? OR visibility = 0", userID).
+		Where("created_by = ? OR (visibility = ? AND status NOT IN ?)", userID, models.VisibilityPublic, models.UnlistedStatuses).
+		Scopes(pinnedFirst(userID), byRecentActivity).
 		Limit(limit).
 		Offset(offset).
 		Find(&projects).Error; err != nil {
@@ -63,8 +65,18 @@ func (r *publicProjectRepository) GetProject(id uint) (*model.Project, error) {
 		First(&project, id).Error; err != nil {
 		return nil, err
 	}
-	if project.IsPrivate() {
+	if !models.IsListed(project) {
 		return nil, gorm.ErrRecordNotFound
 	}
 	return &project, nil
 }
+func (r *publicProjectRepository) GetCategory(id uint) (string, error) {
+	var project model.Project
+	if err := r.db.
+		Select("category").
+		Scopes(listedProjects).
+		First(&project, id).Error; err != nil {
+		return "", err
+	}
+	return project.Category, nil
+}
@@ -0,0 +1,18 @@
+package routes
+
+import (
+	"github.com/aruncs31s/esdcprojectmodule/interfaces/handler"
+	"github.com/aruncs31s/esdcprojectmodule/models"
+	"github.com/gin-gonic/gin"
+)
+
+func RegisterAdminProjectRoutes(r *gin.Engine, adminHandler handler.AdminProjectHandler, auditHandler handler.ProjectAuditHandler) {
+	adminRoutes := r.Group("/api/admin/projects")
+	{
+		adminRoutes.GET("", adminHandler.GetProjects)
+		adminRoutes.PUT("/:id", auditHandler.Audit(models.AuditAdminUpdate), adminHandler.UpdateProject)
+		adminRoutes.DELETE("/:id", auditHandler.Audit(models.AuditAdminDelete), adminHandler.DeleteProject)
+		adminRoutes.PATCH("/:id/visibility", auditHandler.Audit(models.AuditAdminVisibility), adminHandler.SetVisibility)
+		adminRoutes.PATCH("/:id/status", auditHandler.Audit(models.AuditAdminStatus), adminHandler.SetStatus)
+	}
+}
@@ -0,0 +1,19 @@
+package routes
+
+import (
+	"github.com/aruncs31s/esdcprojectmodule/interfaces/handler"
+	"github.com/aruncs31s/esdcprojectmodule/models"
+	"github.com/gin-gonic/gin"
+)
+
+func RegisterProjectAttachmentRoutes(r *gin.Engine, attachmentHandler handler.ProjectAttachmentHandler, auditHandler handler.ProjectAuditHandler) {
+	attachmentRoutes := r.Group("/api/projects")
+	{
+		attachmentRoutes.GET("/:id/attachments", attachmentHandler.GetAttachments)
+		attachmentRoutes.POST("/:id/attachments", auditHandler.Audit(models.AuditAttachmentUpload), attachmentHandler.UploadAttachment)
+		attachmentRoutes.PUT("/:id/attachments/order", auditHandler.Audit(models.AuditAttachmentReorder), attachmentHandler.ReorderAttachments)
+		attachmentRoutes.GET("/:id/attachments/:attachmentId/file", attachmentHandler.DownloadAttachment)
+		attachmentRoutes.PUT("/:id/attachments/:attachmentId", auditHandler.Audit(models.AuditAttachmentUpdate), attachmentHandler.UpdateCaption)
+		attachmentRoutes.DELETE("/:id/attachments/:attachmentId", auditHandler.Audit(models.AuditAttachmentDelete), attachmentHandler.DeleteAttachment)
+	}
+}
@@ -0,0 +1,13 @@
+package routes
+
+import (
+	"github.com/aruncs31s/esdcprojectmodule/interfaces/handler"
+	"github.com/gin-gonic/gin"
+)
+
+func RegisterAdminProjectAuditRoutes(r *gin.Engine, auditHandler handler.ProjectAuditHandler) {
+	auditRoutes := r.Group("/api/admin/projects")
+	{
+		auditRoutes.GET("/audit", auditHandler.GetEntries)
+	}
+}
@@ -0,0 +1,19 @@
+package routes
+
+import (
+	"github.com/aruncs31s/esdcprojectmodule/interfaces/handler"
+	"github.com/gin-gonic/gin"
+)
+
+func RegisterProjectBookmarkRoutes(r *gin.Engine, bookmarkHandler handler.ProjectBookmarkHandler) {
+	bookmarkRoutes := r.Group("/api/projects/bookmarks")
+	{
+		bookmarkRoutes.GET("", bookmarkHandler.GetBookmarks)
+		bookmarkRoutes.POST("", bookmarkHandler.Bookmark)
+		bookmarkRoutes.DELETE("/:id", bookmarkHandler.RemoveBookmark)
+		bookmarkRoutes.GET("/folders", bookmarkHandler.GetFolders)
+		bookmarkRoutes.POST("/folders", bookmarkHandler.CreateFolder)
+		bookmarkRoutes.PUT("/folders/:id", bookmarkHandler.RenameFolder)
+		bookmarkRoutes.DELETE("/folders/:id", bookmarkHandler.DeleteFolder)
+	}
+}
@@ -0,0 +1,14 @@
+package routes
+
+import (
+	"github.com/aruncs31s/esdcprojectmodule/interfaces/handler"
+	"github.com/aruncs31s/esdcprojectmodule/models"
+	"github.com/gin-gonic/gin"
+)
+
+func RegisterAdminProjectBulkImportRoutes(r *gin.Engine, importHandler handler.ProjectBulkImportHandler, auditHandler handler.ProjectAuditHandler) {
+	importRoutes := r.Group("/api/admin/projects")
+	{
+		importRoutes.POST("/import", auditHandler.Audit(models.AuditAdminImport), importHandler.ImportProjects)
+	}
+}
@@ -0,0 +1,14 @@
+package routes
+
+import (
+	"github.com/aruncs31s/esdcprojectmodule/interfaces/handler"
+	"github.com/aruncs31s/esdcprojectmodule/models"
+	"github.com/gin-gonic/gin"
+)
+
+func RegisterAdminProjectBulkRoutes(r *gin.Engine, bulkHandler handler.ProjectBulkHandler, auditHandler handler.ProjectAuditHandler) {
+	bulkRoutes := r.Group("/api/admin/projects")
+	{
+		bulkRoutes.POST("/bulk", auditHandler.Audit(models.AuditAdminBulk), bulkHandler.Run)
+	}
+}
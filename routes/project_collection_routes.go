@@ -0,0 +1,27 @@
+package routes
+
+import (
+	"github.com/aruncs31s/esdcprojectmodule/interfaces/handler"
+	"github.com/aruncs31s/esdcprojectmodule/models"
+	"github.com/gin-gonic/gin"
+)
+
+func RegisterPublicProjectCollectionRoutes(r *gin.Engine, collectionHandler handler.ProjectCollectionHandler) {
+	publicCollectionRoutes := r.Group("/api/public/collections")
+	{
+		publicCollectionRoutes.GET("", collectionHandler.GetPublicCollections)
+		publicCollectionRoutes.GET("/:slug", collectionHandler.GetPublicCollection)
+	}
+}
+
+func RegisterProjectCollectionRoutes(r *gin.Engine, collectionHandler handler.ProjectCollectionHandler, auditHandler handler.ProjectAuditHandler) {
+	collectionRoutes := r.Group("/api/collections")
+	{
+		collectionRoutes.GET("", collectionHandler.GetCollections)
+		collectionRoutes.POST("", auditHandler.Audit(models.AuditCollectionCreate), collectionHandler.CreateCollection)
+		collectionRoutes.GET("/:slug", collectionHandler.GetCollection)
+		collectionRoutes.PUT("/:slug", auditHandler.Audit(models.AuditCollectionUpdate), collectionHandler.UpdateCollection)
+		collectionRoutes.DELETE("/:slug", auditHandler.Audit(models.AuditCollectionDelete), collectionHandler.DeleteCollection)
+		collectionRoutes.PUT("/:slug/projects", auditHandler.Audit(models.AuditCollectionProjects), collectionHandler.SetProjects)
+	}
+}
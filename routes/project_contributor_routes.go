@@ -0,0 +1,16 @@
+package routes
+
+import (
+	"github.com/aruncs31s/esdcprojectmodule/interfaces/handler"
+	"github.com/aruncs31s/esdcprojectmodule/models"
+	"github.com/gin-gonic/gin"
+)
+
+func RegisterProjectContributorRoutes(r *gin.Engine, contributorHandler handler.ProjectContributorHandler, auditHandler handler.ProjectAuditHandler) {
+	contributorRoutes := r.Group("/api/projects")
+	{
+		contributorRoutes.GET("/:id/contributors", contributorHandler.GetContributors)
+		contributorRoutes.PUT("/:id/contributors/:userId", auditHandler.Audit(models.AuditContributorRole), contributorHandler.UpdateRole)
+		contributorRoutes.DELETE("/:id/contributors/:userId", auditHandler.Audit(models.AuditContributorRemove), contributorHandler.RemoveContributor)
+	}
+}
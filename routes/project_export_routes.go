@@ -0,0 +1,20 @@
+package routes
+
+import (
+	"github.com/aruncs31s/esdcprojectmodule/interfaces/handler"
+	"github.com/gin-gonic/gin"
+)
+
+func RegisterProjectExportRoutes(r *gin.Engine, exportHandler handler.ProjectExportHandler) {
+	exportRoutes := r.Group("/api/projects")
+	{
+		exportRoutes.GET("/export", exportHandler.ExportMyProjects)
+	}
+}
+
+func RegisterAdminProjectExportRoutes(r *gin.Engine, exportHandler handler.ProjectExportHandler) {
+	exportRoutes := r.Group("/api/admin/projects")
+	{
+		exportRoutes.GET("/export", exportHandler.ExportProjects)
+	}
+}
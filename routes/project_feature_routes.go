@@ -0,0 +1,23 @@
+package routes
+
+import (
+	"github.com/aruncs31s/esdcprojectmodule/interfaces/handler"
+	"github.com/aruncs31s/esdcprojectmodule/models"
+	"github.com/gin-gonic/gin"
+)
+
+func RegisterPublicProjectFeatureRoutes(r *gin.Engine, featureHandler handler.ProjectFeatureHandler) {
+	publicFeatureRoutes := r.Group("/api/public/projects")
+	{
+		publicFeatureRoutes.GET("/featured", featureHandler.GetFeatured)
+	}
+}
+
+func RegisterAdminProjectFeatureRoutes(r *gin.Engine, featureHandler handler.ProjectFeatureHandler, auditHandler handler.ProjectAuditHandler) {
+	adminFeatureRoutes := r.Group("/api/admin/projects")
+	{
+		adminFeatureRoutes.GET("/featured", featureHandler.GetFeatures)
+		adminFeatureRoutes.PUT("/:id/feature", auditHandler.Audit(models.AuditAdminFeature), featureHandler.FeatureProject)
+		adminFeatureRoutes.DELETE("/:id/feature", auditHandler.Audit(models.AuditAdminUnfeature), featureHandler.UnfeatureProject)
+	}
+}
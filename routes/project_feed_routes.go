@@ -0,0 +1,13 @@
+package routes
+
+import (
+	"github.com/aruncs31s/esdcprojectmodule/interfaces/handler"
+	"github.com/gin-gonic/gin"
+)
+
+func RegisterProjectFeedRoutes(r *gin.Engine, feedHandler handler.ProjectFeedHandler) {
+	feedRoutes := r.Group("/api/projects")
+	{
+		feedRoutes.GET("/feed", feedHandler.GetFeed)
+	}
+}
@@ -0,0 +1,21 @@
+package routes
+
+import (
+	"github.com/aruncs31s/esdcprojectmodule/interfaces/handler"
+	"github.com/aruncs31s/esdcprojectmodule/models"
+	"github.com/gin-gonic/gin"
+)
+
+func RegisterPublicProjectForkRoutes(r *gin.Engine, forkHandler handler.ProjectForkHandler) {
+	publicForkRoutes := r.Group("/api/public/projects")
+	{
+		publicForkRoutes.GET("/:id/forks", forkHandler.GetForks)
+	}
+}
+
+func RegisterPrivateProjectForkRoutes(r *gin.Engine, forkHandler handler.ProjectForkHandler, auditHandler handler.ProjectAuditHandler) {
+	privateForkRoutes := r.Group("/api/projects")
+	{
+		privateForkRoutes.POST("/:id/fork", auditHandler.Audit(models.AuditProjectFork), forkHandler.ForkProject)
+	}
+}
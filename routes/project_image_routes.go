@@ -0,0 +1,22 @@
+package routes
+
+import (
+	"github.com/aruncs31s/esdcprojectmodule/interfaces/handler"
+	"github.com/aruncs31s/esdcprojectmodule/models"
+	"github.com/gin-gonic/gin"
+)
+
+func RegisterPublicProjectImageRoutes(r *gin.Engine, imageHandler handler.ProjectImageHandler) {
+	publicImageRoutes := r.Group("/api/public/projects")
+	{
+		publicImageRoutes.GET("/:id/image", imageHandler.GetImage)
+	}
+}
+
+func RegisterPrivateProjectImageRoutes(r *gin.Engine, imageHandler handler.ProjectImageHandler, auditHandler handler.ProjectAuditHandler) {
+	privateImageRoutes := r.Group("/api/projects")
+	{
+		privateImageRoutes.GET("/:id/image", imageHandler.GetImage)
+		privateImageRoutes.POST("/:id/image", auditHandler.Audit(models.AuditProjectImage), imageHandler.UploadImage)
+	}
+}
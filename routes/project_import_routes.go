@@ -0,0 +1,14 @@
+package routes
+
+import (
+	"github.com/aruncs31s/esdcprojectmodule/interfaces/handler"
+	"github.com/aruncs31s/esdcprojectmodule/models"
+	"github.com/gin-gonic/gin"
+)
+
+func RegisterProjectImportRoutes(r *gin.Engine, importHandler handler.ProjectImportHandler, auditHandler handler.ProjectAuditHandler) {
+	importRoutes := r.Group("/api/projects")
+	{
+		importRoutes.POST("/import", auditHandler.Audit(models.AuditProjectImport), importHandler.ImportProject)
+	}
+}
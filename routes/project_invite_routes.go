@@ -0,0 +1,19 @@
+package routes
+
+import (
+	"github.com/aruncs31s/esdcprojectmodule/interfaces/handler"
+	"github.com/aruncs31s/esdcprojectmodule/models"
+	"github.com/gin-gonic/gin"
+)
+
+func RegisterProjectInviteRoutes(r *gin.Engine, inviteHandler handler.ProjectInviteHandler, auditHandler handler.ProjectAuditHandler) {
+	inviteRoutes := r.Group("/api/projects")
+	{
+		inviteRoutes.GET("/invites", inviteHandler.GetMyInvites)
+		inviteRoutes.POST("/invites/:id/accept", auditHandler.Audit(models.AuditInviteAccept), inviteHandler.AcceptInvite)
+		inviteRoutes.POST("/invites/:id/decline", auditHandler.Audit(models.AuditInviteDecline), inviteHandler.DeclineInvite)
+		inviteRoutes.GET("/:id/invites", inviteHandler.GetProjectInvites)
+		inviteRoutes.POST("/:id/invites", auditHandler.Audit(models.AuditInviteSend), inviteHandler.InviteContributors)
+		inviteRoutes.DELETE("/:id/invites/:inviteId", auditHandler.Audit(models.AuditInviteRevoke), inviteHandler.RevokeInvite)
+	}
+}
@@ -0,0 +1,21 @@
+package routes
+
+import (
+	"github.com/aruncs31s/esdcprojectmodule/interfaces/handler"
+	"github.com/gin-gonic/gin"
+)
+
+func RegisterProjectLinkRoutes(r *gin.Engine, linkHandler handler.ProjectLinkHandler) {
+	linkRoutes := r.Group("/api/projects")
+	{
+		linkRoutes.GET("/links/broken", linkHandler.GetBrokenLinks)
+		linkRoutes.GET("/:id/links", linkHandler.GetLinks)
+	}
+}
+
+func RegisterAdminProjectLinkRoutes(r *gin.Engine, linkHandler handler.ProjectLinkHandler) {
+	adminLinkRoutes := r.Group("/api/admin/projects")
+	{
+		adminLinkRoutes.GET("/links", linkHandler.GetReport)
+	}
+}
@@ -0,0 +1,23 @@
+package routes
+
+import (
+	"github.com/aruncs31s/esdcprojectmodule/interfaces/handler"
+	"github.com/aruncs31s/esdcprojectmodule/models"
+	"github.com/gin-gonic/gin"
+)
+
+func RegisterProjectReportRoutes(r *gin.Engine, moderationHandler handler.ProjectModerationHandler, auditHandler handler.ProjectAuditHandler) {
+	reportRoutes := r.Group("/api/projects")
+	{
+		reportRoutes.POST("/:id/report", auditHandler.Audit(models.AuditProjectReport), moderationHandler.ReportProject)
+	}
+}
+
+func RegisterAdminProjectModerationRoutes(r *gin.Engine, moderationHandler handler.ProjectModerationHandler, auditHandler handler.ProjectAuditHandler) {
+	moderationRoutes := r.Group("/api/admin/projects")
+	{
+		moderationRoutes.GET("/moderation", moderationHandler.GetQueue)
+		moderationRoutes.GET("/:id/moderation", moderationHandler.GetHistory)
+		moderationRoutes.POST("/:id/moderation", auditHandler.Audit(models.AuditAdminModeration), moderationHandler.Decide)
+	}
+}
@@ -0,0 +1,17 @@
+package routes
+
+import (
+	"github.com/aruncs31s/esdcprojectmodule/interfaces/handler"
+	"github.com/aruncs31s/esdcprojectmodule/models"
+	"github.com/gin-gonic/gin"
+)
+
+func RegisterProjectPinRoutes(r *gin.Engine, pinHandler handler.ProjectPinHandler, auditHandler handler.ProjectAuditHandler) {
+	pinRoutes := r.Group("/api/projects")
+	{
+		pinRoutes.GET("/pins", pinHandler.GetPinned)
+		pinRoutes.PUT("/pins", auditHandler.Audit(models.AuditPinReorder), pinHandler.ReorderPins)
+		pinRoutes.POST("/:id/pin", auditHandler.Audit(models.AuditProjectPin), pinHandler.PinProject)
+		pinRoutes.DELETE("/:id/pin", auditHandler.Audit(models.AuditProjectUnpin), pinHandler.UnpinProject)
+	}
+}
@@ -0,0 +1,15 @@
+package routes
+
+import (
+	"github.com/aruncs31s/esdcprojectmodule/interfaces/handler"
+	"github.com/gin-gonic/gin"
+)
+
+func RegisterProjectPlaceholderRoutes(r *gin.Engine, placeholderHandler handler.ProjectPlaceholderHandler) {
+	placeholderRoutes := r.Group("/api/public/projects")
+	{
+		placeholderRoutes.GET("/:id/cover.svg", placeholderHandler.GetCoverSVG)
+		placeholderRoutes.GET("/:id/cover.png", placeholderHandler.GetCoverPNG)
+		placeholderRoutes.GET("/avatars/:file", placeholderHandler.GetAvatar)
+	}
+}
@@ -0,0 +1,15 @@
+package routes
+
+import (
+	"github.com/aruncs31s/esdcprojectmodule/interfaces/handler"
+	"github.com/aruncs31s/esdcprojectmodule/models"
+	"github.com/gin-gonic/gin"
+)
+
+func RegisterProjectPublishRoutes(r *gin.Engine, publishHandler handler.ProjectPublishHandler, auditHandler handler.ProjectAuditHandler) {
+	publishRoutes := r.Group("/api/projects")
+	{
+		publishRoutes.POST("/:id/publish", auditHandler.Audit(models.AuditProjectPublish), publishHandler.PublishProject)
+		publishRoutes.DELETE("/:id/publish", auditHandler.Audit(models.AuditProjectUnschedule), publishHandler.CancelScheduledPublish)
+	}
+}
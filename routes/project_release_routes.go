@@ -0,0 +1,15 @@
+package routes
+
+import (
+	"github.com/aruncs31s/esdcprojectmodule/interfaces/handler"
+	"github.com/aruncs31s/esdcprojectmodule/models"
+	"github.com/gin-gonic/gin"
+)
+
+func RegisterProjectReleaseRoutes(r *gin.Engine, releaseHandler handler.ProjectReleaseHandler, auditHandler handler.ProjectAuditHandler) {
+	releaseRoutes := r.Group("/api/projects")
+	{
+		releaseRoutes.GET("/:id/releases", releaseHandler.GetReleases)
+		releaseRoutes.POST("/:id/releases", auditHandler.Audit(models.AuditProjectRelease), releaseHandler.CreateRelease)
+	}
+}
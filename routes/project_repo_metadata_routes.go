@@ -0,0 +1,23 @@
+package routes
+
+import (
+	"github.com/aruncs31s/esdcprojectmodule/interfaces/handler"
+	"github.com/aruncs31s/esdcprojectmodule/models"
+	"github.com/gin-gonic/gin"
+)
+
+func RegisterPublicProjectRepoMetadataRoutes(r *gin.Engine, metadataHandler handler.ProjectRepoMetadataHandler) {
+	publicMetadataRoutes := r.Group("/api/public/projects")
+	{
+		publicMetadataRoutes.GET("/:id/repository", metadataHandler.GetMetadata)
+	}
+}
+
+func RegisterPrivateProjectRepoMetadataRoutes(r *gin.Engine, metadataHandler handler.ProjectRepoMetadataHandler, auditHandler handler.ProjectAuditHandler) {
+	privateMetadataRoutes := r.Group("/api/projects")
+	{
+		privateMetadataRoutes.GET("/:id/repository", metadataHandler.GetMetadata)
+		privateMetadataRoutes.POST("/:id/repository/sync", auditHandler.Audit(models.AuditProjectSync), metadataHandler.SyncMetadata)
+		privateMetadataRoutes.GET("/:id/repository/suggestions", metadataHandler.GetSuggestions)
+	}
+}
@@ -0,0 +1,16 @@
+package routes
+
+import (
+	"github.com/aruncs31s/esdcprojectmodule/interfaces/handler"
+	"github.com/aruncs31s/esdcprojectmodule/models"
+	"github.com/gin-gonic/gin"
+)
+
+func RegisterProjectRevisionRoutes(r *gin.Engine, revisionHandler handler.ProjectRevisionHandler, auditHandler handler.ProjectAuditHandler) {
+	revisionRoutes := r.Group("/api/projects")
+	{
+		revisionRoutes.GET("/:id/revisions", revisionHandler.GetRevisions)
+		revisionRoutes.GET("/:id/revisions/diff", revisionHandler.DiffRevisions)
+		revisionRoutes.POST("/:id/revisions/:rev/restore", auditHandler.Audit(models.AuditProjectRestore), revisionHandler.RestoreRevision)
+	}
+}
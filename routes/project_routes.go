@@ -2,6 +2,8 @@ package routes
 
 import (
 	"github.com/aruncs31s/esdcprojectmodule/handler"
+	handlerInterface "github.com/aruncs31s/esdcprojectmodule/interfaces/handler"
+	"github.com/aruncs31s/esdcprojectmodule/models"
 	"github.com/gin-gonic/gin"
 )
 
@@ -13,14 +15,14 @@ func RegisterPublicProjectRoutes(r *gin.Engine, projectHandler handler.ProjectHa
 
 	}
 }
-func RegisterPrivateProjectRoutes(r *gin.Engine, projectHandler handler.ProjectHandler) {
+func RegisterPrivateProjectRoutes(r *gin.Engine, projectHandler handler.ProjectHandler, auditHandler handlerInterface.ProjectAuditHandler) {
 	privateProjectRoutes := r.Group("/api/projects")
 	{
-		privateProjectRoutes.POST("", projectHandler.CreateProject)
-		privateProjectRoutes.POST("/:id/toggle-like", projectHandler.ToggleLikeProject)
+		privateProjectRoutes.POST("", auditHandler.Audit(models.AuditProjectCreate), projectHandler.CreateProject)
+		privateProjectRoutes.POST("/:id/toggle-like", auditHandler.Audit(models.AuditProjectLike), projectHandler.ToggleLikeProject)
 		privateProjectRoutes.GET("/:id", projectHandler.GetProject)
 		privateProjectRoutes.GET("", projectHandler.GetAllProjects)
-		// privateProjectRoutes.PUT("/:id", projectHandler.UpdateProject)
+		privateProjectRoutes.PUT("/:id", auditHandler.Audit(models.AuditProjectUpdate), projectHandler.UpdateProject)
 		// privateProjectRoutes.DELETE("/:id", projectHandler.DeleteProject)
 	}
 }
@@ -0,0 +1,13 @@
+package routes
+
+import (
+	"github.com/aruncs31s/esdcprojectmodule/interfaces/handler"
+	"github.com/gin-gonic/gin"
+)
+
+func RegisterAdminProjectStatsRoutes(r *gin.Engine, statsHandler handler.ProjectStatsHandler) {
+	statsRoutes := r.Group("/api/admin/projects")
+	{
+		statsRoutes.GET("/stats", statsHandler.GetStats)
+	}
+}
@@ -0,0 +1,19 @@
+package routes
+
+import (
+	"github.com/aruncs31s/esdcprojectmodule/interfaces/handler"
+	"github.com/aruncs31s/esdcprojectmodule/models"
+	"github.com/gin-gonic/gin"
+)
+
+func RegisterProjectTransferRoutes(r *gin.Engine, transferHandler handler.ProjectTransferHandler, auditHandler handler.ProjectAuditHandler) {
+	transferRoutes := r.Group("/api/projects")
+	{
+		transferRoutes.GET("/transfers", transferHandler.GetMyTransfers)
+		transferRoutes.POST("/transfers/:id/accept", auditHandler.Audit(models.AuditTransferAccept), transferHandler.AcceptTransfer)
+		transferRoutes.POST("/transfers/:id/decline", auditHandler.Audit(models.AuditTransferDecline), transferHandler.DeclineTransfer)
+		transferRoutes.POST("/:id/transfer", auditHandler.Audit(models.AuditTransferRequest), transferHandler.RequestTransfer)
+		transferRoutes.DELETE("/:id/transfer", auditHandler.Audit(models.AuditTransferCancel), transferHandler.CancelTransfer)
+		transferRoutes.GET("/:id/transfers", transferHandler.GetProjectTransfers)
+	}
+}
@@ -0,0 +1,23 @@
+package routes
+
+import (
+	"github.com/aruncs31s/esdcprojectmodule/interfaces/handler"
+	"github.com/aruncs31s/esdcprojectmodule/models"
+	"github.com/gin-gonic/gin"
+)
+
+func RegisterHookRoutes(r *gin.Engine, webhookHandler handler.ProjectWebhookHandler, auditHandler handler.ProjectAuditHandler) {
+	hookRoutes := r.Group("/api/hooks")
+	{
+		hookRoutes.POST("/github", auditHandler.Audit(models.AuditWebhookDelivery), webhookHandler.ReceiveGitHub)
+	}
+}
+
+func RegisterProjectWebhookRoutes(r *gin.Engine, webhookHandler handler.ProjectWebhookHandler, auditHandler handler.ProjectAuditHandler) {
+	webhookRoutes := r.Group("/api/projects")
+	{
+		webhookRoutes.GET("/:id/webhook", webhookHandler.GetWebhook)
+		webhookRoutes.POST("/:id/webhook", auditHandler.Audit(models.AuditWebhookCreate), webhookHandler.CreateWebhook)
+		webhookRoutes.DELETE("/:id/webhook", auditHandler.Audit(models.AuditWebhookDelete), webhookHandler.DeleteWebhook)
+	}
+}
@@ -0,0 +1,221 @@
+package service
+
+import (
+	"errors"
+	"log"
+	"strings"
+	"time"
+
+	model "github.com/aruncs31s/esdcmodels"
+	"github.com/aruncs31s/esdcprojectmodule/dto"
+	repository "github.com/aruncs31s/esdcprojectmodule/interfaces/repository"
+	"github.com/aruncs31s/esdcprojectmodule/interfaces/service"
+	"github.com/aruncs31s/esdcprojectmodule/interfaces/storage"
+	"github.com/aruncs31s/esdcprojectmodule/models"
+	utils "github.com/aruncs31s/esdcprojectmodule/utils"
+	userRepo "github.com/aruncs31s/esdcusermodule/repository"
+	"gorm.io/gorm"
+)
+
+type adminProjectService struct {
+	projectRepo     repository.ProjectRepository
+	imageRepo       repository.ProjectImageRepository
+	attachmentRepo  repository.ProjectAttachmentRepository
+	userRepo        userRepo.UserRepository
+	blobStore       storage.BlobStore
+	attachmentStore storage.BlobStore
+}
+
+func NewAdminProjectService(
+	projectRepo repository.ProjectRepository,
+	imageRepo repository.ProjectImageRepository,
+	attachmentRepo repository.ProjectAttachmentRepository,
+	userRepo userRepo.UserRepository,
+	blobStore storage.BlobStore,
+	attachmentStore storage.BlobStore,
+) service.AdminProjectService {
+	return &adminProjectService{
+		projectRepo:     projectRepo,
+		imageRepo:       imageRepo,
+		attachmentRepo:  attachmentRepo,
+		userRepo:        userRepo,
+		blobStore:       blobStore,
+		attachmentStore: attachmentStore,
+	}
+}
+
+func (s *adminProjectService) GetProjects(username string, filter dto.AdminProjectFilter, limit, offset int) ([]dto.ProjectsEssentialInfo, error) {
+	if _, err := getAdminID(s.userRepo, username); err != nil {
+		return nil, err
+	}
+	projectFilter, err := toProjectFilter(s.userRepo, filter)
+	if err != nil {
+		return nil, err
+	}
+	projects, err := s.projectRepo.GetEssentialInfo(projectFilter, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	infos := make([]dto.ProjectsEssentialInfo, 0, len(*projects))
+	for _, project := range *projects {
+		infos = append(infos, formatEssentialInfo(project))
+	}
+	return infos, nil
+}
+
+func (s *adminProjectService) UpdateProject(username string, projectID uint, project dto.ProjectUpdate) (*dto.ProjectsEssentialInfo, error) {
+	adminID, err := getAdminID(s.userRepo, username)
+	if err != nil {
+		return nil, err
+	}
+	changes, err := getProjectChanges(project, s.projectRepo)
+	if err != nil {
+		return nil, err
+	}
+	if status, ok := changes.Fields["status"]; ok {
+		if err := checkAdminStatus(status.(string)); err != nil {
+			return nil, err
+		}
+	}
+	return s.update(adminID, projectID, changes)
+}
+
+func (s *adminProjectService) SetVisibility(username string, projectID uint, visibility string) (*dto.ProjectsEssentialInfo, error) {
+	adminID, err := getAdminID(s.userRepo, username)
+	if err != nil {
+		return nil, err
+	}
+	value, ok := models.ParseVisibility(visibility)
+	if !ok {
+		return nil, utils.ErrInvalidVisibility
+	}
+	return s.update(adminID, projectID, models.ProjectChanges{Fields: map[string]interface{}{"visibility": value}})
+}
+
+func (s *adminProjectService) SetStatus(username string, projectID uint, status string) (*dto.ProjectsEssentialInfo, error) {
+	adminID, err := getAdminID(s.userRepo, username)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkAdminStatus(status); err != nil {
+		return nil, err
+	}
+	// A schedule left behind by a draft that is moved out of draft here is dropped
+	// by the publish scheduler when it is due.
+	return s.update(adminID, projectID, models.ProjectChanges{Fields: map[string]interface{}{"status": status}})
+}
+
+func (s *adminProjectService) DeleteProject(username string, projectID uint) error {
+	if _, err := getAdminID(s.userRepo, username); err != nil {
+		return err
+	}
+	if _, err := s.projectRepo.GetByIDIncludingPrivate(projectID); err != nil {
+		return err
+	}
+	// The rows go with the project, so look up the files to delete first.
+	image, err := s.imageRepo.GetByProject(projectID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return err
+	}
+	attachments, err := s.attachmentRepo.GetByProject(projectID)
+	if err != nil {
+		return err
+	}
+	if err := s.projectRepo.Delete(projectID); err != nil {
+		return err
+	}
+	if image != nil {
+		deleteImageVariants(s.blobStore, image.Variants)
+	}
+	for _, attachment := range attachments {
+		if err := s.attachmentStore.Delete(attachment.Key); err != nil {
+			log.Printf("Error deleting attachment %s: %v", attachment.Key, err)
+		}
+		deleteImageVariants(s.attachmentStore, attachment.Variants)
+	}
+	return nil
+}
+
+// checkAdminStatus checks a status set by an admin. Projects go into the trash
+// through the bulk operations only, so the previous status is kept for restore.
+func checkAdminStatus(status string) error {
+	switch {
+	case strings.TrimSpace(status) == "":
+		return utils.ErrEmptyStatus
+	case status == models.StatusTrashed:
+		return utils.ErrTrashedStatus
+	case !models.IsKnownStatus(status):
+		return utils.ErrInvalidStatus
+	}
+	return nil
+}
+
+// toProjectFilter looks up the creator and parses the visibility of the admin filter.
+func toProjectFilter(userRepo userRepo.UserRepository, filter dto.AdminProjectFilter) (models.ProjectFilter, error) {
+	projectFilter := models.ProjectFilter{Status: filter.Status}
+	if filter.Creator != "" {
+		creatorID, err := userRepo.FindUserIDByUsername(filter.Creator)
+		if err != nil {
+			return projectFilter, err
+		}
+		projectFilter.CreatedBy = creatorID
+	}
+	if filter.Visibility != "" {
+		visibility, ok := models.ParseVisibility(filter.Visibility)
+		if !ok {
+			return projectFilter, utils.ErrInvalidVisibility
+		}
+		projectFilter.Visibility = &visibility
+	}
+	return projectFilter, nil
+}
+
+// getAdminID returns the ID of the user, or utils.ErrAdminOnly when the user is not an admin.
+func getAdminID(userRepo userRepo.UserRepository, username string) (uint, error) {
+	user, err := userRepo.FindByUsername(username)
+	if err != nil {
+		return 0, err
+	}
+	if user.Role != models.UserRoleAdmin {
+		return 0, utils.ErrAdminOnly
+	}
+	return user.ID, nil
+}
+
+func (s *adminProjectService) update(adminID, projectID uint, changes models.ProjectChanges) (*dto.ProjectsEssentialInfo, error) {
+	current, err := s.projectRepo.GetByIDIncludingPrivate(projectID)
+	if err != nil {
+		return nil, err
+	}
+	// Only restore brings a project out of the trash, it puts back the status it had.
+	if _, ok := changes.Fields["status"]; ok && current.Status == models.StatusTrashed {
+		return nil, utils.ErrProjectTrashed
+	}
+	if !changes.IsEmpty() {
+		changes.ModifiedBy = adminID
+		if err := s.projectRepo.Update(projectID, changes); err != nil {
+			return nil, err
+		}
+	}
+	updated, err := s.projectRepo.GetByIDIncludingPrivate(projectID)
+	if err != nil {
+		return nil, err
+	}
+	info := formatEssentialInfo(updated)
+	return &info, nil
+}
+
+func formatEssentialInfo(project model.Project) dto.ProjectsEssentialInfo {
+	return dto.ProjectsEssentialInfo{
+		ID:         int(project.ID),
+		Title:      project.Title,
+		CreatedBy:  project.Creator.Username,
+		Image:      *utils.GetProjectImage(project.ID, project.Image),
+		Status:     project.Status,
+		Visibility: models.VisibilityName(project.Visibility),
+		Likes:      project.Likes,
+		Views:      project.Views,
+		CreatedAt:  project.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:  project.UpdatedAt.Format(time.RFC3339),
+	}
+}
@@ -0,0 +1,55 @@
+package service
+
+import (
+	"log"
+	"sync"
+	"time"
+)
+
+// IntervalJob runs a job in the background, like publishing the scheduled drafts.
+//
+// The job runs every interval until Stop is called.
+type IntervalJob struct {
+	// name says what the job does in its log lines, like "publish scheduled projects".
+	name     string
+	interval time.Duration
+	run      func(now time.Time) error
+	stop     chan struct{}
+	stopOnce sync.Once
+}
+
+func NewIntervalJob(name string, interval time.Duration, run func(now time.Time) error) *IntervalJob {
+	return &IntervalJob{
+		name:     name,
+		interval: interval,
+		run:      run,
+		stop:     make(chan struct{}),
+	}
+}
+
+// Start runs the job in its own goroutine.
+func (j *IntervalJob) Start() {
+	go j.loop()
+}
+
+// Stop ends the job, it is safe to call more than once.
+func (j *IntervalJob) Stop() {
+	j.stopOnce.Do(func() {
+		close(j.stop)
+	})
+}
+
+func (j *IntervalJob) loop() {
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-j.stop:
+			return
+		case now := <-ticker.C:
+			if err := j.run(now); err != nil {
+				log.Printf("Error running the job to %s: %v", j.name, err)
+			}
+		}
+	}
+}
@@ -0,0 +1,35 @@
+package service
+
+import (
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestIntervalJob(t *testing.T) {
+	var runs atomic.Int32
+	ran := make(chan time.Time, 10)
+	job := NewIntervalJob("test", 5*time.Millisecond, func(now time.Time) error {
+		runs.Add(1)
+		ran <- now
+		return errors.New("failed")
+	})
+	job.Start()
+	// A failing run does not end the job.
+	for range 3 {
+		select {
+		case <-ran:
+		case <-time.After(time.Second):
+			t.Fatal("the job did not run")
+		}
+	}
+	job.Stop()
+	job.Stop()
+	time.Sleep(20 * time.Millisecond)
+	stopped := runs.Load()
+	time.Sleep(30 * time.Millisecond)
+	if runs.Load() != stopped {
+		t.Fatalf("the job ran %d times after Stop", runs.Load()-stopped)
+	}
+}
@@ -0,0 +1,239 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+)
+
+const (
+	// linkCheckUserAgent is sent with every check, so site owners can tell what is calling.
+	linkCheckUserAgent = "esdc-link-checker/1.0"
+	// maxLinkRedirects is how many redirects a check follows.
+	maxLinkRedirects = 5
+)
+
+var (
+	// errInvalidLink is the result of links that can not be requested at all.
+	errInvalidLink = errors.New("not an http(s) url")
+	// errLinkUnreachable replaces the error of a failed request, the owner of the
+	// link sees it and must not learn what the server can reach.
+	errLinkUnreachable = errors.New("the link could not be reached")
+	// errBlockedAddress is returned when a link resolves to an address of the
+	// server's own network.
+	errBlockedAddress = errors.New("the address is not public")
+)
+
+// blockedPrefixes are the ranges not covered by the netip.Addr checks of
+// isPublicAddr that are not reachable on the internet either.
+var blockedPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),
+	netip.MustParsePrefix("100.64.0.0/10"),
+}
+
+// NewLinkCheckClient returns the client links are checked with. Users choose the
+// links, so it only connects to public addresses, checked after the name is
+// resolved, and revalidates every redirect.
+func NewLinkCheckClient(timeout time.Duration) *http.Client {
+	dialer := &net.Dialer{
+		Timeout: timeout,
+		Control: func(network, address string, _ syscall.RawConn) error {
+			addrPort, err := netip.ParseAddrPort(address)
+			if err != nil || !isPublicAddr(addrPort.Addr()) {
+				return errBlockedAddress
+			}
+			return nil
+		},
+	}
+	return &http.Client{
+		Timeout: timeout,
+		Transport: &http.Transport{
+			// A proxy would connect on our behalf, past the check of the dialer.
+			Proxy:                 nil,
+			DialContext:           dialer.DialContext,
+			TLSHandshakeTimeout:   timeout,
+			ResponseHeaderTimeout: timeout,
+			MaxIdleConnsPerHost:   2,
+			IdleConnTimeout:       90 * time.Second,
+		},
+		CheckRedirect: checkLinkRedirect,
+	}
+}
+
+// checkLinkRedirect follows at most maxLinkRedirects redirects to http(s) links,
+// hosts given as an address must be public. Names are checked when dialing.
+func checkLinkRedirect(req *http.Request, via []*http.Request) error {
+	if len(via) >= maxLinkRedirects {
+		return fmt.Errorf("stopped after %d redirects", maxLinkRedirects)
+	}
+	if (req.URL.Scheme != "http" && req.URL.Scheme != "https") || req.URL.Hostname() == "" {
+		return errInvalidLink
+	}
+	if addr, err := netip.ParseAddr(req.URL.Hostname()); err == nil && !isPublicAddr(addr) {
+		return errBlockedAddress
+	}
+	return nil
+}
+
+// isPublicAddr reports whether addr can be reached on the internet, rather than
+// being loopback, private, link-local, unspecified or multicast.
+func isPublicAddr(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	if !addr.IsValid() || addr.IsLoopback() || addr.IsPrivate() || addr.IsUnspecified() ||
+		addr.IsLinkLocalUnicast() || addr.IsLinkLocalMulticast() || addr.IsInterfaceLocalMulticast() ||
+		addr.IsMulticast() {
+		return false
+	}
+	for _, prefix := range blockedPrefixes {
+		if prefix.Contains(addr) {
+			return false
+		}
+	}
+	return true
+}
+
+// LinkResult is the outcome of checking one link.
+type LinkResult struct {
+	StatusCode int
+	Latency    time.Duration
+	Err        error
+}
+
+// Healthy reports whether the link answered without an error status, redirects are followed.
+func (r LinkResult) Healthy() bool {
+	return r.Err == nil && r.StatusCode < http.StatusBadRequest
+}
+
+// LinkChecker requests links to see whether they still answer.
+//
+// Requests are spaced by at least interval overall, and at most perHost run at once
+// against the same host, so a project list full of links to one host does not hammer it.
+type LinkChecker struct {
+	client   *http.Client
+	interval time.Duration
+	perHost  int
+
+	mu    sync.Mutex
+	next  time.Time
+	hosts map[string]chan struct{}
+}
+
+func NewLinkChecker(client *http.Client, interval time.Duration, perHost int) *LinkChecker {
+	return &LinkChecker{
+		client:   client,
+		interval: interval,
+		perHost:  max(perHost, 1),
+		hosts:    map[string]chan struct{}{},
+	}
+}
+
+// Check sends a HEAD request to the link, and a GET when the server does not accept HEAD.
+//
+// Links without a scheme are requested over https.
+func (c *LinkChecker) Check(ctx context.Context, link string) LinkResult {
+	target, err := parseLink(link)
+	if err != nil {
+		return LinkResult{Err: err}
+	}
+	release, err := c.acquireHost(ctx, target.Host)
+	if err != nil {
+		return LinkResult{Err: err}
+	}
+	defer release()
+
+	result := c.request(ctx, http.MethodHead, target.String())
+	switch result.StatusCode {
+	// Some servers reject HEAD or only answer it for browsers.
+	case http.StatusMethodNotAllowed, http.StatusNotImplemented, http.StatusForbidden:
+		result = c.request(ctx, http.MethodGet, target.String())
+	}
+	return result
+}
+
+func (c *LinkChecker) request(ctx context.Context, method, link string) LinkResult {
+	if err := c.wait(ctx); err != nil {
+		return LinkResult{Err: err}
+	}
+	req, err := http.NewRequestWithContext(ctx, method, link, nil)
+	if err != nil {
+		return LinkResult{Err: err}
+	}
+	req.Header.Set("User-Agent", linkCheckUserAgent)
+	start := time.Now()
+	resp, err := c.client.Do(req)
+	latency := time.Since(start)
+	if err != nil {
+		if ctx.Err() != nil {
+			return LinkResult{Latency: latency, Err: ctx.Err()}
+		}
+		return LinkResult{Latency: latency, Err: errLinkUnreachable}
+	}
+	// Only the status matters, the body is drained a little so the connection can be reused.
+	io.CopyN(io.Discard, resp.Body, 4<<10)
+	resp.Body.Close()
+	return LinkResult{StatusCode: resp.StatusCode, Latency: latency}
+}
+
+// wait blocks until the next request may be sent.
+func (c *LinkChecker) wait(ctx context.Context) error {
+	c.mu.Lock()
+	now := time.Now()
+	at := c.next
+	if at.Before(now) {
+		at = now
+	}
+	c.next = at.Add(c.interval)
+	c.mu.Unlock()
+
+	delay := time.Until(at)
+	if delay <= 0 {
+		return nil
+	}
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// acquireHost takes one of the slots of the host, the returned func gives it back.
+func (c *LinkChecker) acquireHost(ctx context.Context, host string) (func(), error) {
+	c.mu.Lock()
+	slots, ok := c.hosts[host]
+	if !ok {
+		slots = make(chan struct{}, c.perHost)
+		c.hosts[host] = slots
+	}
+	c.mu.Unlock()
+
+	select {
+	case slots <- struct{}{}:
+		return func() { <-slots }, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func parseLink(link string) (*url.URL, error) {
+	link = strings.TrimSpace(link)
+	if !strings.Contains(link, "://") {
+		link = "https://" + link
+	}
+	target, err := url.Parse(link)
+	if err != nil || (target.Scheme != "http" && target.Scheme != "https") || target.Hostname() == "" {
+		return nil, errInvalidLink
+	}
+	return target, nil
+}
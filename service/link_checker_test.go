@@ -0,0 +1,215 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"net/url"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func newLinkServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	mux := http.NewServeMux()
+	mux.HandleFunc("/ok", func(w http.ResponseWriter, r *http.Request) {})
+	mux.HandleFunc("/missing", func(w http.ResponseWriter, r *http.Request) { http.NotFound(w, r) })
+	mux.HandleFunc("/broken", func(w http.ResponseWriter, r *http.Request) { w.WriteHeader(http.StatusInternalServerError) })
+	mux.HandleFunc("/moved", func(w http.ResponseWriter, r *http.Request) { http.Redirect(w, r, "/ok", http.StatusMovedPermanently) })
+	mux.HandleFunc("/moved-away", func(w http.ResponseWriter, r *http.Request) { http.Redirect(w, r, "/missing", http.StatusFound) })
+	mux.HandleFunc("/loop", func(w http.ResponseWriter, r *http.Request) { http.Redirect(w, r, "/loop", http.StatusFound) })
+	mux.HandleFunc("/get-only", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	})
+	mux.HandleFunc("/slow", func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-time.After(5 * time.Second):
+		case <-r.Context().Done():
+		}
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestLinkCheckerCheck(t *testing.T) {
+	server := newLinkServer(t)
+	client := server.Client()
+	client.Timeout = 200 * time.Millisecond
+	checker := NewLinkChecker(client, 0, 2)
+	tests := []struct {
+		path        string
+		wantStatus  int
+		wantHealthy bool
+		wantErr     bool
+	}{
+		{"/ok", http.StatusOK, true, false},
+		{"/missing", http.StatusNotFound, false, false},
+		{"/broken", http.StatusInternalServerError, false, false},
+		{"/moved", http.StatusOK, true, false},
+		{"/moved-away", http.StatusNotFound, false, false},
+		{"/loop", 0, false, true},
+		{"/get-only", http.StatusOK, true, false},
+		{"/slow", 0, false, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			result := checker.Check(context.Background(), server.URL+tt.path)
+			if result.StatusCode != tt.wantStatus {
+				t.Fatalf("StatusCode = %d, want %d", result.StatusCode, tt.wantStatus)
+			}
+			if result.Healthy() != tt.wantHealthy {
+				t.Fatalf("Healthy() = %v, want %v", result.Healthy(), tt.wantHealthy)
+			}
+			if (result.Err != nil) != tt.wantErr {
+				t.Fatalf("Err = %v, want error %v", result.Err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestLinkCheckerInvalidLinks(t *testing.T) {
+	checker := NewLinkChecker(http.DefaultClient, 0, 1)
+	for _, link := range []string{"", "ftp://example.com/file", "https://", "http://"} {
+		result := checker.Check(context.Background(), link)
+		if !errors.Is(result.Err, errInvalidLink) || result.Healthy() {
+			t.Errorf("Check(%q) = %+v, want errInvalidLink", link, result)
+		}
+	}
+}
+
+func TestLinkCheckerLimitsPerHost(t *testing.T) {
+	var running, most atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		now := running.Add(1)
+		defer running.Add(-1)
+		for {
+			seen := most.Load()
+			if now <= seen || most.CompareAndSwap(seen, now) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+	}))
+	t.Cleanup(server.Close)
+	checker := NewLinkChecker(server.Client(), 0, 2)
+
+	var wg sync.WaitGroup
+	for range 6 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if result := checker.Check(context.Background(), server.URL); !result.Healthy() {
+				t.Errorf("Check() = %+v", result)
+			}
+		}()
+	}
+	wg.Wait()
+	if most.Load() > 2 {
+		t.Fatalf("%d requests ran at once against one host, want at most 2", most.Load())
+	}
+}
+
+func TestLinkCheckerSpacesRequests(t *testing.T) {
+	server := newLinkServer(t)
+	interval := 30 * time.Millisecond
+	checker := NewLinkChecker(server.Client(), interval, 4)
+	start := time.Now()
+	for range 3 {
+		checker.Check(context.Background(), server.URL+"/ok")
+	}
+	if elapsed := time.Since(start); elapsed < 2*interval {
+		t.Fatalf("3 checks took %v, want at least %v", elapsed, 2*interval)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if result := checker.Check(ctx, server.URL+"/ok"); !errors.Is(result.Err, context.Canceled) {
+		t.Fatalf("Check() with a cancelled context = %+v", result)
+	}
+}
+
+func TestLinkCheckClientOnlyReachesPublicAddresses(t *testing.T) {
+	server := newLinkServer(t)
+	checker := NewLinkChecker(NewLinkCheckClient(time.Second), 0, 1)
+	for _, link := range []string{
+		server.URL + "/ok",
+		"http://localhost:1/",
+		"http://169.254.169.254/latest/meta-data/",
+		"http://10.0.0.5:6379",
+		"http://[::1]:80/",
+		"http://[::ffff:127.0.0.1]/",
+		"http://0.0.0.0/",
+	} {
+		result := checker.Check(context.Background(), link)
+		if !errors.Is(result.Err, errLinkUnreachable) || result.StatusCode != 0 {
+			t.Errorf("Check(%q) = %+v, want errLinkUnreachable", link, result)
+		}
+	}
+}
+
+func TestCheckLinkRedirect(t *testing.T) {
+	request := func(link string) *http.Request {
+		target, err := url.Parse(link)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return &http.Request{URL: target}
+	}
+	tests := []struct {
+		link string
+		hops int
+		want error
+	}{
+		{"https://example.com/moved", 1, nil},
+		{"http://93.184.216.34/", 1, nil},
+		{"http://127.0.0.1:8080/", 1, errBlockedAddress},
+		{"http://169.254.169.254/latest/meta-data/", 1, errBlockedAddress},
+		{"http://[fd00::1]/", 1, errBlockedAddress},
+		{"file:///etc/passwd", 1, errInvalidLink},
+		{"https://example.com/again", maxLinkRedirects - 1, nil},
+	}
+	for _, tt := range tests {
+		via := make([]*http.Request, tt.hops)
+		if err := checkLinkRedirect(request(tt.link), via); !errors.Is(err, tt.want) {
+			t.Errorf("checkLinkRedirect(%q) = %v, want %v", tt.link, err, tt.want)
+		}
+	}
+	if err := checkLinkRedirect(request("https://example.com/"), make([]*http.Request, maxLinkRedirects)); err == nil {
+		t.Errorf("checkLinkRedirect() after %d redirects = nil", maxLinkRedirects)
+	}
+}
+
+func TestIsPublicAddr(t *testing.T) {
+	tests := map[string]bool{
+		"93.184.216.34":        true,
+		"2606:2800:220:1::":    true,
+		"127.0.0.1":            false,
+		"10.1.2.3":             false,
+		"172.16.0.1":           false,
+		"192.168.1.1":          false,
+		"169.254.169.254":      false,
+		"100.64.0.1":           false,
+		"0.0.0.0":              false,
+		"0.1.2.3":              false,
+		"224.0.0.1":            false,
+		"::1":                  false,
+		"::":                   false,
+		"fe80::1":              false,
+		"fd12:3456::1":         false,
+		"ff02::1":              false,
+		"::ffff:10.0.0.1":      false,
+		"::ffff:93.184.216.34": true,
+	}
+	for address, want := range tests {
+		if got := isPublicAddr(netip.MustParseAddr(address)); got != want {
+			t.Errorf("isPublicAddr(%s) = %v, want %v", address, got, want)
+		}
+	}
+}
@@ -0,0 +1,411 @@
+package service
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"image"
+	"io"
+	"io/fs"
+	"log"
+	"net/http"
+	"path"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/aruncs31s/esdcprojectmodule/dto"
+	repository "github.com/aruncs31s/esdcprojectmodule/interfaces/repository"
+	"github.com/aruncs31s/esdcprojectmodule/interfaces/service"
+	"github.com/aruncs31s/esdcprojectmodule/interfaces/storage"
+	"github.com/aruncs31s/esdcprojectmodule/models"
+	utils "github.com/aruncs31s/esdcprojectmodule/utils"
+	userRepo "github.com/aruncs31s/esdcusermodule/repository"
+	"gorm.io/gorm"
+)
+
+// attachmentLimits is the largest file allowed for each kind, in bytes.
+var attachmentLimits = map[string]int64{
+	models.AttachmentImage:    10 << 20,
+	models.AttachmentDocument: 20 << 20,
+	models.AttachmentFirmware: 32 << 20,
+	models.AttachmentCAD:      50 << 20,
+	models.AttachmentArchive:  50 << 20,
+}
+
+// attachmentExtensions gives the kind of the non image files by extension.
+var attachmentExtensions = map[string]string{
+	".pdf":       models.AttachmentDocument,
+	".bin":       models.AttachmentFirmware,
+	".hex":       models.AttachmentFirmware,
+	".elf":       models.AttachmentFirmware,
+	".uf2":       models.AttachmentFirmware,
+	".stl":       models.AttachmentCAD,
+	".step":      models.AttachmentCAD,
+	".stp":       models.AttachmentCAD,
+	".dxf":       models.AttachmentCAD,
+	".f3d":       models.AttachmentCAD,
+	".fcstd":     models.AttachmentCAD,
+	".scad":      models.AttachmentCAD,
+	".kicad_pcb": models.AttachmentCAD,
+	".kicad_sch": models.AttachmentCAD,
+	".brd":       models.AttachmentCAD,
+	".sch":       models.AttachmentCAD,
+	".zip":       models.AttachmentArchive,
+}
+
+// attachmentContentTypes are the detected types documents and archives must have,
+// the other kinds are binary formats the detection does not know.
+var attachmentContentTypes = map[string]string{
+	models.AttachmentDocument: "application/pdf",
+	models.AttachmentArchive:  "application/zip",
+}
+
+var unsafeFileNameChars = regexp.MustCompile(`[^A-Za-z0-9._-]+`)
+
+type projectAttachmentService struct {
+	attachmentRepo repository.ProjectAttachmentRepository
+	userRepo       userRepo.UserRepository
+	authorizer     service.ProjectAuthorizer
+	blobStore      storage.BlobStore
+}
+
+func NewProjectAttachmentService(
+	attachmentRepo repository.ProjectAttachmentRepository,
+	userRepo userRepo.UserRepository,
+	authorizer service.ProjectAuthorizer,
+	blobStore storage.BlobStore,
+) service.ProjectAttachmentService {
+	return &projectAttachmentService{
+		attachmentRepo: attachmentRepo,
+		userRepo:       userRepo,
+		authorizer:     authorizer,
+		blobStore:      blobStore,
+	}
+}
+
+func (s *projectAttachmentService) GetAttachments(username string, projectID uint) (*dto.ProjectAttachments, error) {
+	if _, err := s.authorize(username, projectID, models.ActionViewAttachments); err != nil {
+		return nil, err
+	}
+	return s.getAttachments(projectID)
+}
+
+func (s *projectAttachmentService) UploadAttachment(username string, projectID uint, fileName, caption string, file io.Reader) (*dto.ProjectAttachment, error) {
+	userID, err := s.authorize(username, projectID, models.ActionEdit)
+	if err != nil {
+		return nil, err
+	}
+	reader := bufio.NewReader(file)
+	// Peek returns fewer bytes with io.EOF for small files, that is fine for detection.
+	head, err := reader.Peek(512)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return nil, err
+	}
+	if len(head) == 0 {
+		return nil, utils.ErrUnsupportedFile
+	}
+	contentType := http.DetectContentType(head)
+	kind, err := attachmentKind(fileName, contentType)
+	if err != nil {
+		return nil, err
+	}
+	// Detection only guesses at firmware and CAD files, often as text.
+	if _, known := attachmentContentTypes[kind]; !known && kind != models.AttachmentImage {
+		contentType = "application/octet-stream"
+	}
+	limit := attachmentLimits[kind]
+	hash := sha256.New()
+	counter := &countingWriter{}
+	content := io.TeeReader(io.LimitReader(reader, limit+1), io.MultiWriter(hash, counter))
+
+	prefix := fmt.Sprintf("projects/%d/attachments/%d/", projectID, time.Now().UnixNano())
+	attachment := &models.ProjectAttachment{
+		ProjectID:   projectID,
+		Kind:        kind,
+		Name:        path.Base(strings.ReplaceAll(fileName, "\\", "/")),
+		Caption:     strings.TrimSpace(caption),
+		ContentType: contentType,
+		Key:         prefix + safeFileName(fileName),
+		UploadedBy:  userID,
+	}
+	if kind == models.AttachmentImage {
+		data, err := io.ReadAll(content)
+		if err != nil {
+			return nil, err
+		}
+		if counter.n > limit {
+			return nil, utils.ErrFileTooLarge
+		}
+		if attachment.Variants, err = s.storeGalleryImage(data, attachment.Key, prefix+"sizes/", contentType); err != nil {
+			return nil, err
+		}
+	} else {
+		if err := s.blobStore.Put(attachment.Key, content, contentType); err != nil {
+			return nil, err
+		}
+		if counter.n > limit {
+			s.deleteFiles(*attachment)
+			return nil, utils.ErrFileTooLarge
+		}
+	}
+	attachment.Size = counter.n
+	attachment.Checksum = hex.EncodeToString(hash.Sum(nil))
+	if err := s.attachmentRepo.Create(attachment); err != nil {
+		s.deleteFiles(*attachment)
+		return nil, err
+	}
+	created, err := s.attachmentRepo.GetByID(projectID, attachment.ID)
+	if err != nil {
+		return nil, err
+	}
+	formatted := s.formatAttachment(created)
+	return &formatted, nil
+}
+
+func (s *projectAttachmentService) OpenAttachment(username string, projectID, attachmentID uint, size, format string) (*dto.AttachmentFile, error) {
+	if _, err := s.authorize(username, projectID, models.ActionViewAttachments); err != nil {
+		return nil, err
+	}
+	attachment, err := s.getAttachment(projectID, attachmentID)
+	if err != nil {
+		return nil, err
+	}
+	file := &dto.AttachmentFile{
+		Name:        attachment.Name,
+		ContentType: attachment.ContentType,
+		Size:        attachment.Size,
+		Inline:      attachment.IsImage(),
+	}
+	key := attachment.Key
+	if size != "" {
+		variant := findImageVariant(attachment.Variants, size, format)
+		if variant == nil {
+			return nil, utils.ErrAttachmentNotFound
+		}
+		key = variant.Key
+		file.Name = strings.TrimSuffix(attachment.Name, path.Ext(attachment.Name)) + path.Ext(variant.Key)
+		file.ContentType = "image/" + variant.Format
+		file.Size = -1
+	}
+	if file.Content, err = s.blobStore.Open(key); err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, utils.ErrAttachmentNotFound
+		}
+		return nil, err
+	}
+	return file, nil
+}
+
+func (s *projectAttachmentService) UpdateCaption(username string, projectID, attachmentID uint, caption string) (*dto.ProjectAttachment, error) {
+	if _, err := s.authorize(username, projectID, models.ActionEdit); err != nil {
+		return nil, err
+	}
+	attachment, err := s.getAttachment(projectID, attachmentID)
+	if err != nil {
+		return nil, err
+	}
+	attachment.Caption = strings.TrimSpace(caption)
+	if err := s.attachmentRepo.UpdateCaption(attachment.ID, attachment.Caption); err != nil {
+		return nil, err
+	}
+	formatted := s.formatAttachment(attachment)
+	return &formatted, nil
+}
+
+func (s *projectAttachmentService) ReorderAttachments(username string, projectID uint, ids []uint) (*dto.ProjectAttachments, error) {
+	if _, err := s.authorize(username, projectID, models.ActionEdit); err != nil {
+		return nil, err
+	}
+	attachments, err := s.attachmentRepo.GetByProject(projectID)
+	if err != nil {
+		return nil, err
+	}
+	remaining := make(map[uint]bool, len(attachments))
+	for _, attachment := range attachments {
+		remaining[attachment.ID] = true
+	}
+	if len(ids) != len(remaining) {
+		return nil, utils.ErrInvalidOrder
+	}
+	for _, id := range ids {
+		if !remaining[id] {
+			return nil, utils.ErrInvalidOrder
+		}
+		delete(remaining, id)
+	}
+	if err := s.attachmentRepo.Reorder(projectID, ids); err != nil {
+		return nil, err
+	}
+	return s.getAttachments(projectID)
+}
+
+func (s *projectAttachmentService) DeleteAttachment(username string, projectID, attachmentID uint) error {
+	if _, err := s.authorize(username, projectID, models.ActionEdit); err != nil {
+		return err
+	}
+	attachment, err := s.getAttachment(projectID, attachmentID)
+	if err != nil {
+		return err
+	}
+	if err := s.attachmentRepo.Delete(attachment.ID); err != nil {
+		return err
+	}
+	s.deleteFiles(*attachment)
+	return nil
+}
+
+func (s *projectAttachmentService) authorize(username string, projectID uint, action models.ProjectAction) (uint, error) {
+	userID, err := s.userRepo.FindUserIDByUsername(username)
+	if err != nil {
+		return 0, err
+	}
+	return userID, s.authorizer.Authorize(userID, projectID, action)
+}
+
+func (s *projectAttachmentService) getAttachment(projectID, attachmentID uint) (*models.ProjectAttachment, error) {
+	attachment, err := s.attachmentRepo.GetByID(projectID, attachmentID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, utils.ErrAttachmentNotFound
+	}
+	return attachment, err
+}
+
+func (s *projectAttachmentService) getAttachments(projectID uint) (*dto.ProjectAttachments, error) {
+	attachments, err := s.attachmentRepo.GetByProject(projectID)
+	if err != nil {
+		return nil, err
+	}
+	formatted := &dto.ProjectAttachments{
+		Gallery: make([]dto.ProjectAttachment, 0),
+		Files:   make([]dto.ProjectAttachment, 0),
+	}
+	for i := range attachments {
+		if attachments[i].IsImage() {
+			formatted.Gallery = append(formatted.Gallery, s.formatAttachment(&attachments[i]))
+		} else {
+			formatted.Files = append(formatted.Files, s.formatAttachment(&attachments[i]))
+		}
+	}
+	return formatted, nil
+}
+
+// storeGalleryImage stores the uploaded image as it is and its resized variants.
+func (s *projectAttachmentService) storeGalleryImage(data []byte, key, variantPrefix, contentType string) ([]models.ImageVariant, error) {
+	config, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, utils.ErrUnsupportedImage
+	}
+	if config.Width*config.Height > maxImagePixels {
+		return nil, utils.ErrImageTooLarge
+	}
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, utils.ErrUnsupportedImage
+	}
+	if err := s.blobStore.Put(key, bytes.NewReader(data), contentType); err != nil {
+		return nil, err
+	}
+	variants, err := storeImageVariants(s.blobStore, img, variantPrefix, contentType)
+	if err != nil {
+		deleteImageVariants(s.blobStore, variants)
+		if deleteErr := s.blobStore.Delete(key); deleteErr != nil {
+			log.Printf("Error deleting attachment %s: %v", key, deleteErr)
+		}
+		return nil, err
+	}
+	return variants, nil
+}
+
+// deleteFiles removes the stored files of an attachment, failures are only logged.
+func (s *projectAttachmentService) deleteFiles(attachment models.ProjectAttachment) {
+	if err := s.blobStore.Delete(attachment.Key); err != nil {
+		log.Printf("Error deleting attachment %s: %v", attachment.Key, err)
+	}
+	deleteImageVariants(s.blobStore, attachment.Variants)
+}
+
+func (s *projectAttachmentService) formatAttachment(attachment *models.ProjectAttachment) dto.ProjectAttachment {
+	formatted := dto.ProjectAttachment{
+		ID:          attachment.ID,
+		Kind:        attachment.Kind,
+		Name:        attachment.Name,
+		Caption:     attachment.Caption,
+		ContentType: attachment.ContentType,
+		Size:        attachment.Size,
+		Checksum:    attachment.Checksum,
+		Position:    attachment.Position,
+		URL:         attachmentFileURL(attachment, nil),
+		UploadedBy:  utils.GetCreatorDetails(attachment.Uploader),
+		CreatedAt:   attachment.CreatedAt,
+	}
+	if len(attachment.Variants) > 0 {
+		_, formatted.Sizes = formatImageVariants(attachment.Variants, func(variant models.ImageVariant) string {
+			return attachmentFileURL(attachment, &variant)
+		})
+	}
+	return formatted
+}
+
+// attachmentFileURL is the download route of an attachment, or of one of its image
+// sizes when variant is not nil.
+//
+// Attachments are never linked to the blob store itself, the route checks that
+// the user can see the attachments of the project first.
+func attachmentFileURL(attachment *models.ProjectAttachment, variant *models.ImageVariant) string {
+	url := fmt.Sprintf("/api/projects/%d/attachments/%d/file", attachment.ProjectID, attachment.ID)
+	if variant != nil {
+		url += "?size=" + variant.Size + "&format=" + variant.Format
+	}
+	return url
+}
+
+// findImageVariant returns the variant of the size in the format, any format when
+// format is empty, or nil when there is none.
+func findImageVariant(variants []models.ImageVariant, size, format string) *models.ImageVariant {
+	for i := range variants {
+		if variants[i].Size == size && (format == "" || variants[i].Format == format) {
+			return &variants[i]
+		}
+	}
+	return nil
+}
+
+// attachmentKind works out the kind of an upload from the detected content type
+// and the extension of its name.
+func attachmentKind(fileName, contentType string) (string, error) {
+	if _, ok := imageExtensions[contentType]; ok {
+		return models.AttachmentImage, nil
+	}
+	kind, ok := attachmentExtensions[strings.ToLower(path.Ext(fileName))]
+	if !ok {
+		return "", utils.ErrUnsupportedFile
+	}
+	if expected, ok := attachmentContentTypes[kind]; ok && expected != contentType {
+		return "", utils.ErrUnsupportedFile
+	}
+	return kind, nil
+}
+
+// safeFileName keeps the name readable in URLs and safe as a storage key.
+func safeFileName(fileName string) string {
+	name := path.Base(strings.ReplaceAll(fileName, "\\", "/"))
+	name = strings.Trim(unsafeFileNameChars.ReplaceAllString(name, "_"), "._")
+	if name == "" {
+		return "file"
+	}
+	return name
+}
+
+// countingWriter counts the bytes written to it.
+type countingWriter struct {
+	n int64
+}
+
+func (w *countingWriter) Write(p []byte) (int, error) {
+	w.n += int64(len(p))
+	return len(p), nil
+}
@@ -0,0 +1,185 @@
+package service
+
+import (
+	"encoding/json"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/aruncs31s/esdcprojectmodule/dto"
+	repository "github.com/aruncs31s/esdcprojectmodule/interfaces/repository"
+	"github.com/aruncs31s/esdcprojectmodule/interfaces/service"
+	"github.com/aruncs31s/esdcprojectmodule/models"
+	userRepo "github.com/aruncs31s/esdcusermodule/repository"
+	"gorm.io/gorm"
+)
+
+type projectAuditService struct {
+	projectRepo     repository.ProjectRepository
+	contributorRepo repository.ProjectContributorRepository
+	auditRepo       repository.ProjectAuditRepository
+	userRepo        userRepo.UserRepository
+	// retention is how long entries are kept, 0 keeps them forever.
+	retention time.Duration
+}
+
+func NewProjectAuditService(
+	projectRepo repository.ProjectRepository,
+	contributorRepo repository.ProjectContributorRepository,
+	auditRepo repository.ProjectAuditRepository,
+	userRepo userRepo.UserRepository,
+	retention time.Duration,
+) service.ProjectAuditService {
+	return &projectAuditService{
+		projectRepo:     projectRepo,
+		contributorRepo: contributorRepo,
+		auditRepo:       auditRepo,
+		userRepo:        userRepo,
+		retention:       retention,
+	}
+}
+
+func (s *projectAuditService) Snapshot(projectID uint) (*models.ProjectAuditSnapshot, error) {
+	project, err := s.projectRepo.GetByIDIncludingPrivate(projectID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	rows, users, err := s.contributorRepo.GetContributors(projectID)
+	if err != nil {
+		return nil, err
+	}
+	snapshot := &models.ProjectAuditSnapshot{
+		Title:        project.Title,
+		Description:  project.Description,
+		Image:        project.Image,
+		GithubLink:   project.GithubLink,
+		LiveURL:      project.LiveURL,
+		Category:     project.Category,
+		Status:       project.Status,
+		Visibility:   models.VisibilityName(project.Visibility),
+		Cost:         project.Cost,
+		Version:      project.Version,
+		Likes:        project.Likes,
+		CreatedBy:    project.CreatedBy,
+		ForkedFrom:   project.ForkedFrom,
+		Tags:         make([]string, 0),
+		Technologies: make([]string, 0),
+		Contributors: make(map[string]string, len(users)),
+	}
+	if project.Tags != nil {
+		for _, tag := range *project.Tags {
+			snapshot.Tags = append(snapshot.Tags, tag.Name)
+		}
+	}
+	if project.Technologies != nil {
+		for _, tech := range *project.Technologies {
+			snapshot.Technologies = append(snapshot.Technologies, tech.Name)
+		}
+	}
+	for i, user := range users {
+		snapshot.Contributors[user.Username] = rows[i].Role
+	}
+	return snapshot, nil
+}
+
+func (s *projectAuditService) Record(entry *models.ProjectAuditEntry) error {
+	if entry.Actor != "" && entry.Actor != models.AuditSystemActor {
+		actorID, err := s.userRepo.FindUserIDByUsername(entry.Actor)
+		if err == nil {
+			entry.ActorID = &actorID
+		} else {
+			log.Printf("Error looking up audit actor %s: %v", entry.Actor, err)
+		}
+	}
+	// Owners change the visibility with a regular update, record it as a visibility
+	// change so it can be found with the action filter.
+	if entry.Action == models.AuditProjectUpdate && entry.Before != nil && entry.After != nil &&
+		entry.Before.Visibility != entry.After.Visibility {
+		entry.Action = models.AuditProjectVisibility
+	}
+	return s.auditRepo.Create(entry)
+}
+
+func (s *projectAuditService) RecordSystem(action string, projectID uint, before *models.ProjectAuditSnapshot) error {
+	after, err := s.Snapshot(projectID)
+	if err != nil {
+		return err
+	}
+	return s.Record(&models.ProjectAuditEntry{
+		Actor:     models.AuditSystemActor,
+		Action:    action,
+		ProjectID: &projectID,
+		Before:    before,
+		After:     after,
+	})
+}
+
+func (s *projectAuditService) GetEntries(username string, filter dto.ProjectAuditFilter, limit, offset int) ([]dto.ProjectAuditEntry, error) {
+	if _, err := getAdminID(s.userRepo, username); err != nil {
+		return nil, err
+	}
+	auditFilter := models.ProjectAuditFilter{
+		Action:    filter.Action,
+		ProjectID: filter.ProjectID,
+		RequestID: filter.RequestID,
+		From:      filter.From,
+		To:        filter.To,
+	}
+	if filter.Actor == models.AuditSystemActor {
+		auditFilter.System = true
+	} else if filter.Actor != "" {
+		actorID, err := s.userRepo.FindUserIDByUsername(filter.Actor)
+		if err != nil {
+			return nil, err
+		}
+		auditFilter.ActorID = &actorID
+	}
+	entries, err := s.auditRepo.Get(auditFilter, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	formatted := make([]dto.ProjectAuditEntry, 0, len(entries))
+	for _, entry := range entries {
+		auditEntry, err := formatAuditEntry(entry)
+		if err != nil {
+			return nil, err
+		}
+		formatted = append(formatted, auditEntry)
+	}
+	return formatted, nil
+}
+
+func (s *projectAuditService) Prune(now time.Time) (int64, error) {
+	if s.retention <= 0 {
+		return 0, nil
+	}
+	return s.auditRepo.DeleteBefore(now.Add(-s.retention))
+}
+
+func formatAuditEntry(entry models.ProjectAuditEntry) (dto.ProjectAuditEntry, error) {
+	// A nil snapshot is marshalled to null.
+	before, err := json.Marshal(entry.Before)
+	if err != nil {
+		return dto.ProjectAuditEntry{}, err
+	}
+	after, err := json.Marshal(entry.After)
+	if err != nil {
+		return dto.ProjectAuditEntry{}, err
+	}
+	return dto.ProjectAuditEntry{
+		ID:        entry.ID,
+		RequestID: entry.RequestID,
+		Actor:     entry.Actor,
+		Action:    entry.Action,
+		ProjectID: entry.ProjectID,
+		Method:    entry.Method,
+		Path:      entry.Path,
+		Status:    entry.Status,
+		Before:    before,
+		After:     after,
+		CreatedAt: entry.CreatedAt,
+	}, nil
+}
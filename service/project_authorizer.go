@@ -0,0 +1,139 @@
+package service
+
+import (
+	"errors"
+
+	model "github.com/aruncs31s/esdcmodels"
+	repository "github.com/aruncs31s/esdcprojectmodule/interfaces/repository"
+	"github.com/aruncs31s/esdcprojectmodule/interfaces/service"
+	"github.com/aruncs31s/esdcprojectmodule/models"
+	utils "github.com/aruncs31s/esdcprojectmodule/utils"
+	userRepo "github.com/aruncs31s/esdcusermodule/repository"
+	"gorm.io/gorm"
+)
+
+// rolePermissions is the role × action matrix.
+//
+// Anyone can view and like a listed project (public and not a draft), so those
+// are not listed for non contributors. Admins can do everything.
+var rolePermissions = map[string]map[models.ProjectAction]bool{
+	models.RoleOwner: {
+		models.ActionView:               true,
+		models.ActionLike:               true,
+		models.ActionViewAttachments:    true,
+		models.ActionEdit:               true,
+		models.ActionManageContributors: true,
+		models.ActionChangeVisibility:   true,
+		models.ActionDelete:             true,
+		models.ActionTransferOwnership:  true,
+		models.ActionPublishRelease:     true,
+	},
+	models.RoleMaintainer: {
+		models.ActionView:               true,
+		models.ActionLike:               true,
+		models.ActionViewAttachments:    true,
+		models.ActionEdit:               true,
+		models.ActionManageContributors: true,
+		models.ActionChangeVisibility:   true,
+		models.ActionPublishRelease:     true,
+	},
+	models.RoleEditor: {
+		models.ActionView:            true,
+		models.ActionLike:            true,
+		models.ActionEdit:            true,
+		models.ActionViewAttachments: true,
+	},
+	models.RoleViewer: {
+		models.ActionView:            true,
+		models.ActionLike:            true,
+		models.ActionViewAttachments: true,
+	},
+}
+
+// publicActions are allowed to everyone on a listed project.
+var publicActions = map[models.ProjectAction]bool{
+	models.ActionView: true,
+	models.ActionLike: true,
+}
+
+// RoleAllows reports whether the role grants the action.
+func RoleAllows(role string, action models.ProjectAction) bool {
+	return rolePermissions[role][action]
+}
+
+type projectAuthorizer struct {
+	projectRepo     repository.ProjectRepository
+	contributorRepo repository.ProjectContributorRepository
+	userRepo        userRepo.UserRepository
+}
+
+func NewProjectAuthorizer(
+	projectRepo repository.ProjectRepository,
+	contributorRepo repository.ProjectContributorRepository,
+	userRepo userRepo.UserRepository,
+) service.ProjectAuthorizer {
+	return &projectAuthorizer{
+		projectRepo:     projectRepo,
+		contributorRepo: contributorRepo,
+		userRepo:        userRepo,
+	}
+}
+
+func (a *projectAuthorizer) RoleOf(userID, projectID uint) (string, error) {
+	project, err := a.projectRepo.GetByIDIncludingPrivate(projectID)
+	if err != nil {
+		return "", err
+	}
+	return a.roleOf(userID, project)
+}
+
+func (a *projectAuthorizer) Can(userID, projectID uint, action models.ProjectAction) (bool, error) {
+	project, err := a.projectRepo.GetByIDIncludingPrivate(projectID)
+	if err != nil {
+		return false, err
+	}
+	user, err := a.userRepo.FindByID(userID)
+	if err != nil {
+		return false, err
+	}
+	if user.Role == models.UserRoleAdmin {
+		return true, nil
+	}
+	if models.IsListed(project) && publicActions[action] {
+		return true, nil
+	}
+	role, err := a.roleOf(userID, project)
+	if err != nil {
+		return false, err
+	}
+	return RoleAllows(role, action), nil
+}
+
+func (a *projectAuthorizer) Authorize(userID, projectID uint, action models.ProjectAction) error {
+	allowed, err := a.Can(userID, projectID, action)
+	if err != nil {
+		return err
+	}
+	if !allowed {
+		return utils.ErrActionNotAllowed
+	}
+	return nil
+}
+
+func (a *projectAuthorizer) roleOf(userID uint, project model.Project) (string, error) {
+	if project.CreatedBy == userID {
+		return models.RoleOwner, nil
+	}
+	role, err := a.contributorRepo.GetRole(project.ID, userID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	// Only the creator is the owner, a stale owner row counts as maintainer.
+	if role == models.RoleOwner {
+		return models.RoleMaintainer, nil
+	}
+	return role, nil
+}
@@ -0,0 +1,196 @@
+package service
+
+import (
+	"errors"
+	"testing"
+
+	model "github.com/aruncs31s/esdcmodels"
+	repository "github.com/aruncs31s/esdcprojectmodule/interfaces/repository"
+	"github.com/aruncs31s/esdcprojectmodule/models"
+	utils "github.com/aruncs31s/esdcprojectmodule/utils"
+	userRepo "github.com/aruncs31s/esdcusermodule/repository"
+	"gorm.io/gorm"
+)
+
+const (
+	authzOwner uint = iota + 1
+	authzMaintainer
+	authzEditor
+	authzViewer
+	authzOutsider
+	authzAdmin
+	authzStaleOwner
+)
+
+const (
+	authzPublicProject uint = iota + 1
+	authzPrivateProject
+	authzDraftProject
+)
+
+type fakeAuthzProjectRepository struct {
+	repository.ProjectRepository
+}
+
+func (fakeAuthzProjectRepository) GetByIDIncludingPrivate(id uint) (model.Project, error) {
+	project := model.Project{ID: id, CreatedBy: authzOwner, Status: models.StatusActive, Visibility: models.VisibilityPublic}
+	switch id {
+	case authzPublicProject:
+	case authzPrivateProject:
+		project.Visibility = models.VisibilityPrivate
+	case authzDraftProject:
+		project.Status = models.StatusDraft
+	default:
+		return model.Project{}, gorm.ErrRecordNotFound
+	}
+	return project, nil
+}
+
+type fakeAuthzContributorRepository struct {
+	repository.ProjectContributorRepository
+}
+
+func (fakeAuthzContributorRepository) GetRole(projectID, userID uint) (string, error) {
+	switch userID {
+	case authzMaintainer:
+		return models.RoleMaintainer, nil
+	case authzEditor:
+		return models.RoleEditor, nil
+	case authzViewer:
+		return models.RoleViewer, nil
+	case authzStaleOwner:
+		return models.RoleOwner, nil
+	}
+	return "", gorm.ErrRecordNotFound
+}
+
+type fakeAuthzUserRepository struct {
+	userRepo.UserRepository
+}
+
+func (fakeAuthzUserRepository) FindByID(id uint) (*model.User, error) {
+	if id == authzAdmin {
+		return &model.User{ID: id, Role: models.UserRoleAdmin}, nil
+	}
+	return &model.User{ID: id, Role: "user"}, nil
+}
+
+func newTestAuthorizer() *projectAuthorizer {
+	return NewProjectAuthorizer(
+		fakeAuthzProjectRepository{},
+		fakeAuthzContributorRepository{},
+		fakeAuthzUserRepository{},
+	).(*projectAuthorizer)
+}
+
+var authzActions = []models.ProjectAction{
+	models.ActionView,
+	models.ActionLike,
+	models.ActionViewAttachments,
+	models.ActionEdit,
+	models.ActionManageContributors,
+	models.ActionChangeVisibility,
+	models.ActionDelete,
+	models.ActionTransferOwnership,
+	models.ActionPublishRelease,
+}
+
+// authzUnlistedMatrix lists, in authzActions order, what each user may do on a project
+// that is not listed. "x" allows the action and "." denies it.
+var authzUnlistedMatrix = map[uint]string{
+	authzOwner:      "xxxxxxxxx",
+	authzMaintainer: "xxxxxx..x",
+	authzStaleOwner: "xxxxxx..x",
+	authzEditor:     "xxxx.....",
+	authzViewer:     "xxx......",
+	authzOutsider:   ".........",
+	authzAdmin:      "xxxxxxxxx",
+}
+
+// On a listed project everyone can also view and like.
+var authzListedMatrix = map[uint]string{
+	authzOwner:      "xxxxxxxxx",
+	authzMaintainer: "xxxxxx..x",
+	authzStaleOwner: "xxxxxx..x",
+	authzEditor:     "xxxx.....",
+	authzViewer:     "xxx......",
+	authzOutsider:   "xx.......",
+	authzAdmin:      "xxxxxxxxx",
+}
+
+func TestProjectAuthorizerCan(t *testing.T) {
+	authorizer := newTestAuthorizer()
+	users := map[uint]string{
+		authzOwner:      "owner",
+		authzMaintainer: "maintainer",
+		authzStaleOwner: "stale owner row",
+		authzEditor:     "editor",
+		authzViewer:     "viewer",
+		authzOutsider:   "non contributor",
+		authzAdmin:      "admin",
+	}
+	projects := []struct {
+		name   string
+		id     uint
+		matrix map[uint]string
+	}{
+		{"public", authzPublicProject, authzListedMatrix},
+		{"private", authzPrivateProject, authzUnlistedMatrix},
+		{"draft", authzDraftProject, authzUnlistedMatrix},
+	}
+	for _, project := range projects {
+		for userID, userName := range users {
+			for i, action := range authzActions {
+				want := project.matrix[userID][i] == 'x'
+				t.Run(project.name+"/"+userName+"/"+string(action), func(t *testing.T) {
+					got, err := authorizer.Can(userID, project.id, action)
+					if err != nil {
+						t.Fatal(err)
+					}
+					if got != want {
+						t.Fatalf("Can() = %v, want %v", got, want)
+					}
+					err = authorizer.Authorize(userID, project.id, action)
+					if want && err != nil {
+						t.Fatalf("Authorize() = %v, want nil", err)
+					}
+					if !want && !errors.Is(err, utils.ErrActionNotAllowed) {
+						t.Fatalf("Authorize() = %v, want ErrActionNotAllowed", err)
+					}
+				})
+			}
+		}
+	}
+}
+
+func TestProjectAuthorizerRoleOf(t *testing.T) {
+	authorizer := newTestAuthorizer()
+	tests := []struct {
+		userID uint
+		want   string
+	}{
+		{authzOwner, models.RoleOwner},
+		{authzMaintainer, models.RoleMaintainer},
+		{authzStaleOwner, models.RoleMaintainer},
+		{authzEditor, models.RoleEditor},
+		{authzViewer, models.RoleViewer},
+		{authzOutsider, ""},
+		{authzAdmin, ""},
+	}
+	for _, tt := range tests {
+		got, err := authorizer.RoleOf(tt.userID, authzPrivateProject)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != tt.want {
+			t.Fatalf("RoleOf(%d) = %q, want %q", tt.userID, got, tt.want)
+		}
+	}
+}
+
+func TestProjectAuthorizerMissingProject(t *testing.T) {
+	_, err := newTestAuthorizer().Can(authzAdmin, 99, models.ActionView)
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		t.Fatalf("Can() error = %v, want gorm.ErrRecordNotFound", err)
+	}
+}
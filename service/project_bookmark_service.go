@@ -0,0 +1,266 @@
+package service
+
+import (
+	"errors"
+	"strings"
+
+	model "github.com/aruncs31s/esdcmodels"
+	"github.com/aruncs31s/esdcprojectmodule/dto"
+	repository "github.com/aruncs31s/esdcprojectmodule/interfaces/repository"
+	"github.com/aruncs31s/esdcprojectmodule/interfaces/service"
+	"github.com/aruncs31s/esdcprojectmodule/models"
+	utils "github.com/aruncs31s/esdcprojectmodule/utils"
+	userRepo "github.com/aruncs31s/esdcusermodule/repository"
+	"gorm.io/gorm"
+)
+
+type projectBookmarkService struct {
+	bookmarkRepo repository.ProjectBookmarkRepository
+	forkRepo     repository.ProjectForkRepository
+	userRepo     userRepo.UserRepository
+	authorizer   service.ProjectAuthorizer
+}
+
+func NewProjectBookmarkService(
+	bookmarkRepo repository.ProjectBookmarkRepository,
+	forkRepo repository.ProjectForkRepository,
+	userRepo userRepo.UserRepository,
+	authorizer service.ProjectAuthorizer,
+) service.ProjectBookmarkService {
+	return &projectBookmarkService{
+		bookmarkRepo: bookmarkRepo,
+		forkRepo:     forkRepo,
+		userRepo:     userRepo,
+		authorizer:   authorizer,
+	}
+}
+
+func (s *projectBookmarkService) GetBookmarks(username string, folderID *uint, limit, offset int) ([]dto.ProjectBookmark, error) {
+	userID, err := s.userRepo.FindUserIDByUsername(username)
+	if err != nil {
+		return nil, err
+	}
+	if folderID != nil {
+		if _, err := s.getFolder(userID, *folderID); err != nil {
+			return nil, err
+		}
+	}
+	bookmarks, err := s.bookmarkRepo.GetBookmarks(userID, folderID, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	return s.formatBookmarks(userID, bookmarks)
+}
+
+func (s *projectBookmarkService) Bookmark(username string, request dto.ProjectBookmarkRequest) (*dto.ProjectBookmark, error) {
+	userID, err := s.userRepo.FindUserIDByUsername(username)
+	if err != nil {
+		return nil, err
+	}
+	allowed, err := s.authorizer.Can(userID, request.ProjectID, models.ActionView)
+	if err != nil {
+		return nil, err
+	}
+	if !allowed {
+		// Projects the user can not see are not found rather than forbidden.
+		return nil, gorm.ErrRecordNotFound
+	}
+	bookmark := models.ProjectBookmark{
+		UserID:    userID,
+		ProjectID: request.ProjectID,
+		FolderID:  request.FolderID,
+	}
+	if request.FolderID != nil {
+		folder, err := s.getFolder(userID, *request.FolderID)
+		if err != nil {
+			return nil, err
+		}
+		bookmark.Folder = &folder
+	}
+	projects, err := s.bookmarkRepo.GetProjects([]uint{request.ProjectID})
+	if err != nil {
+		return nil, err
+	}
+	if len(projects) == 0 {
+		return nil, gorm.ErrRecordNotFound
+	}
+	bookmark.ProjectTitle = projects[0].Title
+	if err := s.bookmarkRepo.Save(&bookmark); err != nil {
+		return nil, err
+	}
+	formatted, err := s.formatBookmarks(userID, []models.ProjectBookmark{bookmark})
+	if err != nil {
+		return nil, err
+	}
+	return &formatted[0], nil
+}
+
+func (s *projectBookmarkService) RemoveBookmark(username string, projectID uint) error {
+	userID, err := s.userRepo.FindUserIDByUsername(username)
+	if err != nil {
+		return err
+	}
+	err = s.bookmarkRepo.Delete(userID, projectID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return utils.ErrBookmarkNotFound
+	}
+	return err
+}
+
+func (s *projectBookmarkService) GetFolders(username string) ([]dto.ProjectBookmarkFolder, error) {
+	userID, err := s.userRepo.FindUserIDByUsername(username)
+	if err != nil {
+		return nil, err
+	}
+	folders, err := s.bookmarkRepo.GetFolders(userID)
+	if err != nil {
+		return nil, err
+	}
+	formatted := make([]dto.ProjectBookmarkFolder, 0, len(folders))
+	for _, folder := range folders {
+		formatted = append(formatted, formatBookmarkFolder(folder))
+	}
+	return formatted, nil
+}
+
+func (s *projectBookmarkService) CreateFolder(username, name string) (*dto.ProjectBookmarkFolder, error) {
+	userID, err := s.userRepo.FindUserIDByUsername(username)
+	if err != nil {
+		return nil, err
+	}
+	name, err = s.checkFolderName(userID, name, 0)
+	if err != nil {
+		return nil, err
+	}
+	folder := models.ProjectBookmarkFolder{UserID: userID, Name: name}
+	if err := s.bookmarkRepo.CreateFolder(&folder); err != nil {
+		return nil, err
+	}
+	formatted := formatBookmarkFolder(folder)
+	return &formatted, nil
+}
+
+func (s *projectBookmarkService) RenameFolder(username string, folderID uint, name string) (*dto.ProjectBookmarkFolder, error) {
+	userID, err := s.userRepo.FindUserIDByUsername(username)
+	if err != nil {
+		return nil, err
+	}
+	folder, err := s.getFolder(userID, folderID)
+	if err != nil {
+		return nil, err
+	}
+	folder.Name, err = s.checkFolderName(userID, name, folderID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.bookmarkRepo.UpdateFolder(&folder); err != nil {
+		return nil, err
+	}
+	formatted := formatBookmarkFolder(folder)
+	return &formatted, nil
+}
+
+func (s *projectBookmarkService) DeleteFolder(username string, folderID uint) error {
+	userID, err := s.userRepo.FindUserIDByUsername(username)
+	if err != nil {
+		return err
+	}
+	err = s.bookmarkRepo.DeleteFolder(userID, folderID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return utils.ErrFolderNotFound
+	}
+	return err
+}
+
+func (s *projectBookmarkService) getFolder(userID, folderID uint) (models.ProjectBookmarkFolder, error) {
+	folder, err := s.bookmarkRepo.GetFolder(userID, folderID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return models.ProjectBookmarkFolder{}, utils.ErrFolderNotFound
+	}
+	return folder, err
+}
+
+// checkFolderName returns the trimmed name, or an error when it is empty or already used by the user.
+func (s *projectBookmarkService) checkFolderName(userID uint, name string, exceptID uint) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return "", utils.ErrEmptyFolderName
+	}
+	exists, err := s.bookmarkRepo.FolderNameExists(userID, name, exceptID)
+	if err != nil {
+		return "", err
+	}
+	if exists {
+		return "", utils.ErrFolderNameTaken
+	}
+	return name, nil
+}
+
+// formatBookmarks sets the state of each bookmark as seen by the user, the project
+// is only included while the user can still see it.
+func (s *projectBookmarkService) formatBookmarks(userID uint, bookmarks []models.ProjectBookmark) ([]dto.ProjectBookmark, error) {
+	projectIDs := make([]uint, len(bookmarks))
+	for i, bookmark := range bookmarks {
+		projectIDs[i] = bookmark.ProjectID
+	}
+	projects, err := s.bookmarkRepo.GetProjects(projectIDs)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[uint]model.Project, len(projects))
+	for _, project := range projects {
+		byID[project.ID] = project
+	}
+	available := make([]model.Project, 0, len(projects))
+	formatted := make([]dto.ProjectBookmark, 0, len(bookmarks))
+	for _, bookmark := range bookmarks {
+		entry := dto.ProjectBookmark{
+			ID:        bookmark.ID,
+			ProjectID: bookmark.ProjectID,
+			Title:     bookmark.ProjectTitle,
+			State:     models.BookmarkDeleted,
+			CreatedAt: bookmark.CreatedAt,
+		}
+		if bookmark.Folder != nil {
+			folder := formatBookmarkFolder(*bookmark.Folder)
+			entry.Folder = &folder
+		}
+		if project, ok := byID[bookmark.ProjectID]; ok {
+			entry.State = models.BookmarkUnavailable
+			visible := models.IsListed(project)
+			if !visible {
+				visible, err = s.authorizer.Can(userID, project.ID, models.ActionView)
+				if err != nil {
+					return nil, err
+				}
+			}
+			if visible {
+				entry.State = models.BookmarkAvailable
+				entry.Title = project.Title
+				available = append(available, project)
+			}
+		}
+		formatted = append(formatted, entry)
+	}
+	// The projects are formatted together to look up their fork counts at once.
+	availableProjects := getFormatedProjects(&available)
+	if err := setForkCounts(s.forkRepo, availableProjects); err != nil {
+		return nil, err
+	}
+	next := 0
+	for i := range formatted {
+		if formatted[i].State == models.BookmarkAvailable {
+			formatted[i].Project = &availableProjects[next]
+			next++
+		}
+	}
+	return formatted, nil
+}
+
+func formatBookmarkFolder(folder models.ProjectBookmarkFolder) dto.ProjectBookmarkFolder {
+	return dto.ProjectBookmarkFolder{
+		ID:        folder.ID,
+		Name:      folder.Name,
+		CreatedAt: folder.CreatedAt,
+	}
+}
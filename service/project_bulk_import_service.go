@@ -0,0 +1,224 @@
+package service
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	model "github.com/aruncs31s/esdcmodels"
+	"github.com/aruncs31s/esdcprojectmodule/dto"
+	repository "github.com/aruncs31s/esdcprojectmodule/interfaces/repository"
+	"github.com/aruncs31s/esdcprojectmodule/interfaces/service"
+	"github.com/aruncs31s/esdcprojectmodule/models"
+	utils "github.com/aruncs31s/esdcprojectmodule/utils"
+	userRepo "github.com/aruncs31s/esdcusermodule/repository"
+)
+
+// maxImportRows is how many projects one bulk import can create.
+const maxImportRows = 1000
+
+type projectBulkImportService struct {
+	projectRepo repository.ProjectRepository
+	importRepo  repository.ProjectBulkImportRepository
+	userRepo    userRepo.UserRepository
+}
+
+func NewProjectBulkImportService(
+	projectRepo repository.ProjectRepository,
+	importRepo repository.ProjectBulkImportRepository,
+	userRepo userRepo.UserRepository,
+) service.ProjectBulkImportService {
+	return &projectBulkImportService{
+		projectRepo: projectRepo,
+		importRepo:  importRepo,
+		userRepo:    userRepo,
+	}
+}
+
+// importRow is a row that passed the checks, with its users resolved.
+type importRow struct {
+	index      int
+	creator    model.User
+	inviteeIDs []uint
+}
+
+func (s *projectBulkImportService) ImportProjects(username string, rows []dto.ProjectImportRow, options dto.ProjectImportOptions) (*dto.ProjectImportResult, error) {
+	adminID, err := getAdminID(s.userRepo, username)
+	if err != nil {
+		return nil, err
+	}
+	if options.Mode == "" {
+		options.Mode = models.ImportAllOrNothing
+	}
+	if options.Mode != models.ImportAllOrNothing && options.Mode != models.ImportBestEffort {
+		return nil, utils.ErrInvalidImportMode
+	}
+	if len(rows) == 0 {
+		return nil, utils.ErrNoImportRows
+	}
+	if len(rows) > maxImportRows {
+		return nil, utils.ErrTooManyImportRows
+	}
+	users, err := s.findUsers(rows)
+	if err != nil {
+		return nil, err
+	}
+	result := &dto.ProjectImportResult{
+		Mode:   options.Mode,
+		DryRun: options.DryRun,
+		Rows:   make([]dto.ProjectImportRowResult, len(rows)),
+	}
+	now := time.Now()
+	valid := make([]importRow, 0, len(rows))
+	for i, row := range rows {
+		checked, errs := checkImportRow(row, users, now)
+		result.Rows[i] = dto.ProjectImportRowResult{Row: i + 1, Title: row.Title, Success: len(errs) == 0}
+		for _, err := range errs {
+			result.Rows[i].Errors = append(result.Rows[i].Errors, err.Error())
+		}
+		if len(errs) > 0 {
+			result.Failed++
+			continue
+		}
+		checked.index = i
+		valid = append(valid, checked)
+	}
+	if options.DryRun || len(valid) == 0 || (options.Mode == models.ImportAllOrNothing && result.Failed > 0) {
+		return result, nil
+	}
+	// Tags and technologies are found or created before the projects, an import that
+	// is rolled back may leave new ones behind.
+	projects := make([]models.NewProject, 0, len(valid))
+	for _, row := range valid {
+		project, err := s.buildProject(rows[row.index].ProjectCreation, row, adminID)
+		if err != nil {
+			return nil, err
+		}
+		projects = append(projects, project)
+	}
+	failed, err := s.importRepo.CreateAll(projects, options.Mode == models.ImportAllOrNothing)
+	if err != nil && len(failed) == 0 {
+		return nil, err
+	}
+	for i, row := range valid {
+		rowResult := &result.Rows[row.index]
+		if rowErr, ok := failed[i]; ok {
+			rowResult.Success = false
+			rowResult.Errors = append(rowResult.Errors, rowErr.Error())
+			result.Failed++
+			continue
+		}
+		if err == nil {
+			projectID := projects[i].Project.ID
+			rowResult.ProjectID = &projectID
+			result.Created++
+		}
+	}
+	result.Committed = result.Created > 0
+	return result, nil
+}
+
+// findUsers looks up the creators and contributors of every row at once, by username.
+func (s *projectBulkImportService) findUsers(rows []dto.ProjectImportRow) (map[string]model.User, error) {
+	names := make([]string, 0, len(rows))
+	for _, row := range rows {
+		names = append(names, strings.TrimSpace(row.Creator))
+		if row.Contributors != nil {
+			for _, name := range *row.Contributors {
+				names = append(names, strings.TrimSpace(name))
+			}
+		}
+	}
+	found, err := s.userRepo.FindUsersByUsernames(names)
+	if err != nil {
+		return nil, err
+	}
+	users := make(map[string]model.User, len(*found))
+	for _, user := range *found {
+		users[user.Username] = user
+	}
+	return users, nil
+}
+
+// checkImportRow applies the rules of project creation to the row and resolves its
+// creator and contributors, returning every problem found. Imported projects also
+// need a title, a row without one is most likely a stray line of the file.
+func checkImportRow(row dto.ProjectImportRow, users map[string]model.User, now time.Time) (importRow, []error) {
+	var checked importRow
+	var errs []error
+	errs = append(errs, row.CellErrors...)
+	if strings.TrimSpace(row.Title) == "" {
+		errs = append(errs, utils.ErrEmptyTitle)
+	}
+	if err := validateProjectCreation(row.ProjectCreation, now); err != nil {
+		errs = append(errs, err)
+	}
+	creatorName := strings.TrimSpace(row.Creator)
+	creator, ok := users[creatorName]
+	switch {
+	case creatorName == "":
+		errs = append(errs, utils.ErrNoCreator)
+	case !ok:
+		errs = append(errs, fmt.Errorf("%w %s", utils.ErrUnknownUser, creatorName))
+	default:
+		checked.creator = creator
+	}
+	if row.Contributors == nil {
+		return checked, errs
+	}
+	invited := map[uint]bool{}
+	for _, name := range *row.Contributors {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		contributor, ok := users[name]
+		if !ok {
+			errs = append(errs, fmt.Errorf("%w %s", utils.ErrUnknownUser, name))
+			continue
+		}
+		// The creator is the owner already.
+		if contributor.ID == creator.ID || invited[contributor.ID] {
+			continue
+		}
+		invited[contributor.ID] = true
+		checked.inviteeIDs = append(checked.inviteeIDs, contributor.ID)
+	}
+	return checked, errs
+}
+
+// buildProject makes the project of a checked row the way CreateProject does.
+func (s *projectBulkImportService) buildProject(project dto.ProjectCreation, row importRow, adminID uint) (models.NewProject, error) {
+	tags, err := getTags(project.Tags, s.projectRepo)
+	if err != nil {
+		return models.NewProject{}, err
+	}
+	technologies, err := getTechnologies(project.Technologies, s.projectRepo)
+	if err != nil {
+		return models.NewProject{}, err
+	}
+	status := models.StatusActive
+	if project.Draft || project.PublishAt != nil {
+		status = models.StatusDraft
+	}
+	creatorID := row.creator.ID
+	return models.NewProject{
+		Project: model.Project{
+			Title:        project.Title,
+			Image:        project.Image,
+			Description:  project.Description,
+			GithubLink:   project.GithubLink,
+			Tags:         &tags,
+			CreatedBy:    creatorID,
+			ModifiedBy:   &creatorID,
+			Status:       status,
+			Category:     project.Category,
+			LiveURL:      project.LiveURL,
+			Technologies: &technologies,
+			Contributors: &[]model.User{row.creator},
+		},
+		InviteeIDs: row.inviteeIDs,
+		InvitedBy:  adminID,
+		PublishAt:  project.PublishAt,
+	}, nil
+}
@@ -0,0 +1,254 @@
+package service
+
+import (
+	"log"
+	"slices"
+	"strings"
+
+	model "github.com/aruncs31s/esdcmodels"
+	"github.com/aruncs31s/esdcprojectmodule/dto"
+	repository "github.com/aruncs31s/esdcprojectmodule/interfaces/repository"
+	"github.com/aruncs31s/esdcprojectmodule/interfaces/service"
+	"github.com/aruncs31s/esdcprojectmodule/models"
+	utils "github.com/aruncs31s/esdcprojectmodule/utils"
+	userRepo "github.com/aruncs31s/esdcusermodule/repository"
+)
+
+const (
+	// maxBulkProjects is how many projects one bulk operation can select.
+	maxBulkProjects = 1000
+	// bulkChunkSize is how many projects are changed in one transaction.
+	bulkChunkSize = 100
+)
+
+type projectBulkService struct {
+	projectRepo repository.ProjectRepository
+	bulkRepo    repository.ProjectBulkRepository
+	userRepo    userRepo.UserRepository
+	// auditService snapshots the projects before they are changed, for their audit entries.
+	auditService service.ProjectAuditService
+}
+
+func NewProjectBulkService(
+	projectRepo repository.ProjectRepository,
+	bulkRepo repository.ProjectBulkRepository,
+	userRepo userRepo.UserRepository,
+	auditService service.ProjectAuditService,
+) service.ProjectBulkService {
+	return &projectBulkService{
+		projectRepo:  projectRepo,
+		bulkRepo:     bulkRepo,
+		userRepo:     userRepo,
+		auditService: auditService,
+	}
+}
+
+func (s *projectBulkService) Run(username string, request dto.BulkProjectRequest) (*dto.BulkProjectResult, error) {
+	adminID, err := getAdminID(s.userRepo, username)
+	if err != nil {
+		return nil, err
+	}
+	operation, err := s.parseOperation(request, adminID)
+	if err != nil {
+		return nil, err
+	}
+	ids, err := s.selectProjects(request)
+	if err != nil {
+		return nil, err
+	}
+	result := &dto.BulkProjectResult{
+		Operation: operation.Kind,
+		DryRun:    request.DryRun,
+		Affected:  []uint{},
+		Items:     make([]dto.BulkProjectItem, 0, len(ids)),
+	}
+	if !request.DryRun {
+		result.Before = make(map[uint]*models.ProjectAuditSnapshot, len(ids))
+	}
+	tagName := strings.TrimSpace(request.Value)
+	for chunk := range slices.Chunk(ids, bulkChunkSize) {
+		projects, err := s.bulkRepo.GetProjects(chunk)
+		if err != nil {
+			return nil, err
+		}
+		items := make(map[uint]*dto.BulkProjectItem, len(chunk))
+		var apply []uint
+		for _, projectID := range chunk {
+			item := &dto.BulkProjectItem{ProjectID: projectID, Success: true}
+			items[projectID] = item
+			index := slices.IndexFunc(projects, func(project model.Project) bool { return project.ID == projectID })
+			if index < 0 {
+				item.Success = false
+				item.Error = utils.ErrProjectNotFound.Error()
+				continue
+			}
+			changes, err := checkBulkOperation(operation, projects[index], tagName)
+			switch {
+			case err != nil:
+				item.Success = false
+				item.Error = err.Error()
+			case !changes:
+				item.Unchanged = true
+			default:
+				apply = append(apply, projectID)
+			}
+		}
+		if len(apply) > 0 && !request.DryRun {
+			if operation.Kind == models.BulkRemoveTag {
+				operation.TagID = findTagID(projects, tagName)
+			}
+			for _, projectID := range apply {
+				before, err := s.auditService.Snapshot(projectID)
+				if err != nil {
+					log.Printf("Error taking the audit snapshot of project %d: %v", projectID, err)
+				}
+				result.Before[projectID] = before
+			}
+			failed, err := s.bulkRepo.Apply(operation, apply)
+			if err != nil {
+				failed = make(map[uint]error, len(apply))
+				for _, projectID := range apply {
+					failed[projectID] = err
+				}
+			}
+			for projectID, err := range failed {
+				items[projectID].Success = false
+				items[projectID].Error = err.Error()
+			}
+		}
+		for _, projectID := range chunk {
+			item := items[projectID]
+			switch {
+			case !item.Success:
+				result.Failed++
+			case item.Unchanged:
+				result.Unchanged++
+			default:
+				result.Succeeded++
+				result.Affected = append(result.Affected, projectID)
+			}
+			result.Items = append(result.Items, *item)
+		}
+	}
+	return result, nil
+}
+
+// parseOperation checks the value of the operation. The tag of add_tag is only
+// created when the operation is not a dry run.
+func (s *projectBulkService) parseOperation(request dto.BulkProjectRequest, adminID uint) (models.BulkOperation, error) {
+	operation := models.BulkOperation{Kind: request.Operation, By: adminID}
+	value := strings.TrimSpace(request.Value)
+	switch request.Operation {
+	case models.BulkSetStatus:
+		if err := checkAdminStatus(value); err != nil {
+			return operation, err
+		}
+		operation.Status = value
+	case models.BulkSetVisibility:
+		visibility, ok := models.ParseVisibility(value)
+		if !ok {
+			return operation, utils.ErrInvalidVisibility
+		}
+		operation.Visibility = visibility
+	case models.BulkAddTag, models.BulkRemoveTag:
+		if value == "" {
+			return operation, utils.ErrEmptyTag
+		}
+		if request.Operation == models.BulkAddTag && !request.DryRun {
+			tag, err := s.projectRepo.FindOrCreateTag(value)
+			if err != nil {
+				return operation, err
+			}
+			operation.TagID = tag.ID
+		}
+	case models.BulkTrash, models.BulkRestore:
+	case models.BulkTransferOwner:
+		if value == "" {
+			return operation, utils.ErrNoNewOwner
+		}
+		newOwnerID, err := s.userRepo.FindUserIDByUsername(value)
+		if err != nil {
+			return operation, err
+		}
+		operation.NewOwnerID = newOwnerID
+	default:
+		return operation, utils.ErrInvalidBulkOperation
+	}
+	return operation, nil
+}
+
+// selectProjects returns the IDs given in the request without duplicates, or the
+// IDs of the projects matching its filter.
+func (s *projectBulkService) selectProjects(request dto.BulkProjectRequest) ([]uint, error) {
+	if (len(request.ProjectIDs) == 0) == (request.Filter == nil) {
+		return nil, utils.ErrBulkTarget
+	}
+	if request.Filter == nil {
+		ids := make([]uint, 0, len(request.ProjectIDs))
+		for _, projectID := range request.ProjectIDs {
+			if !slices.Contains(ids, projectID) {
+				ids = append(ids, projectID)
+			}
+		}
+		if len(ids) > maxBulkProjects {
+			return nil, utils.ErrTooManyBulkProjects
+		}
+		return ids, nil
+	}
+	filter, err := toProjectFilter(s.userRepo, *request.Filter)
+	if err != nil {
+		return nil, err
+	}
+	ids, err := s.bulkRepo.GetIDs(filter, maxBulkProjects+1)
+	if err != nil {
+		return nil, err
+	}
+	if len(ids) > maxBulkProjects {
+		return nil, utils.ErrTooManyBulkProjects
+	}
+	return ids, nil
+}
+
+// checkBulkOperation reports whether the operation changes the project, or why it
+// can not be applied to it.
+func checkBulkOperation(operation models.BulkOperation, project model.Project, tagName string) (bool, error) {
+	trashed := project.Status == models.StatusTrashed
+	switch operation.Kind {
+	case models.BulkSetStatus:
+		if trashed {
+			return false, utils.ErrProjectTrashed
+		}
+		return project.Status != operation.Status, nil
+	case models.BulkSetVisibility:
+		return project.Visibility != operation.Visibility, nil
+	case models.BulkAddTag:
+		return findTagID([]model.Project{project}, tagName) == 0, nil
+	case models.BulkRemoveTag:
+		return findTagID([]model.Project{project}, tagName) != 0, nil
+	case models.BulkTrash:
+		return !trashed, nil
+	case models.BulkRestore:
+		if !trashed {
+			return false, utils.ErrProjectNotTrashed
+		}
+		return true, nil
+	case models.BulkTransferOwner:
+		return project.CreatedBy != operation.NewOwnerID, nil
+	}
+	return false, utils.ErrInvalidBulkOperation
+}
+
+// findTagID returns the ID of the tag named name on any of the projects, or 0.
+func findTagID(projects []model.Project, name string) uint {
+	for _, project := range projects {
+		if project.Tags == nil {
+			continue
+		}
+		for _, tag := range *project.Tags {
+			if tag.Name == name {
+				return tag.ID
+			}
+		}
+	}
+	return 0
+}
@@ -0,0 +1,333 @@
+package service
+
+import (
+	"errors"
+	"strings"
+
+	model "github.com/aruncs31s/esdcmodels"
+	"github.com/aruncs31s/esdcprojectmodule/dto"
+	repository "github.com/aruncs31s/esdcprojectmodule/interfaces/repository"
+	"github.com/aruncs31s/esdcprojectmodule/interfaces/service"
+	"github.com/aruncs31s/esdcprojectmodule/models"
+	utils "github.com/aruncs31s/esdcprojectmodule/utils"
+	userRepo "github.com/aruncs31s/esdcusermodule/repository"
+	"gorm.io/gorm"
+)
+
+type projectCollectionService struct {
+	collectionRepo repository.ProjectCollectionRepository
+	forkRepo       repository.ProjectForkRepository
+	userRepo       userRepo.UserRepository
+	authorizer     service.ProjectAuthorizer
+}
+
+func NewProjectCollectionService(
+	collectionRepo repository.ProjectCollectionRepository,
+	forkRepo repository.ProjectForkRepository,
+	userRepo userRepo.UserRepository,
+	authorizer service.ProjectAuthorizer,
+) service.ProjectCollectionService {
+	return &projectCollectionService{
+		collectionRepo: collectionRepo,
+		forkRepo:       forkRepo,
+		userRepo:       userRepo,
+		authorizer:     authorizer,
+	}
+}
+
+func (s *projectCollectionService) GetPublicCollections(limit, offset int) ([]dto.ProjectCollection, error) {
+	collections, err := s.collectionRepo.GetPublic(limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	return formatCollections(collections), nil
+}
+
+func (s *projectCollectionService) GetPublicCollection(slug string) (*dto.ProjectCollectionDetail, error) {
+	collection, err := s.getBySlug(slug)
+	if err != nil {
+		return nil, err
+	}
+	if collection.Visibility != models.VisibilityPublic {
+		return nil, utils.ErrCollectionNotFound
+	}
+	projects, err := s.collectionRepo.GetListedProjects(collection.ID)
+	if err != nil {
+		return nil, err
+	}
+	return s.formatDetail(collection, projects)
+}
+
+func (s *projectCollectionService) GetCollections(username string, limit, offset int) ([]dto.ProjectCollection, error) {
+	user, err := s.userRepo.FindByUsername(username)
+	if err != nil {
+		return nil, err
+	}
+	collections, err := s.collectionRepo.GetManaged(user.ID, user.Role == models.UserRoleAdmin, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	return formatCollections(collections), nil
+}
+
+func (s *projectCollectionService) GetCollection(username, slug string) (*dto.ProjectCollectionDetail, error) {
+	user, err := s.userRepo.FindByUsername(username)
+	if err != nil {
+		return nil, err
+	}
+	collection, err := s.getBySlug(slug)
+	if err != nil {
+		return nil, err
+	}
+	if collection.Visibility != models.VisibilityPublic && !canManageCollection(user, collection) {
+		return nil, utils.ErrCollectionNotFound
+	}
+	return s.getDetail(user.ID, collection)
+}
+
+func (s *projectCollectionService) CreateCollection(username string, request dto.ProjectCollectionRequest) (*dto.ProjectCollectionDetail, error) {
+	user, err := s.userRepo.FindByUsername(username)
+	if err != nil {
+		return nil, err
+	}
+	if request.AdminOwned && user.Role != models.UserRoleAdmin {
+		return nil, utils.ErrAdminOnly
+	}
+	title := strings.TrimSpace(request.Title)
+	if title == "" {
+		return nil, utils.ErrEmptyTitle
+	}
+	slug := strings.TrimSpace(request.Slug)
+	if slug == "" {
+		slug = utils.Slugify(title)
+	}
+	if err := s.checkSlug(slug, 0); err != nil {
+		return nil, err
+	}
+	visibility := models.VisibilityPrivate
+	if request.Visibility != "" {
+		var ok bool
+		if visibility, ok = models.ParseVisibility(request.Visibility); !ok {
+			return nil, utils.ErrInvalidVisibility
+		}
+	}
+	if err := s.checkProjects(user.ID, request.ProjectIDs); err != nil {
+		return nil, err
+	}
+	collection := models.ProjectCollection{
+		Slug:        slug,
+		Title:       title,
+		Description: strings.TrimSpace(request.Description),
+		Cover:       trimCover(request.Cover),
+		Visibility:  visibility,
+		CreatedBy:   user.ID,
+	}
+	if !request.AdminOwned {
+		collection.OwnerID = &user.ID
+		collection.Owner = user
+	}
+	if err := s.collectionRepo.Create(&collection, request.ProjectIDs); err != nil {
+		return nil, err
+	}
+	return s.getDetail(user.ID, collection)
+}
+
+func (s *projectCollectionService) UpdateCollection(username, slug string, request dto.ProjectCollectionUpdate) (*dto.ProjectCollectionDetail, error) {
+	user, collection, err := s.getManaged(username, slug)
+	if err != nil {
+		return nil, err
+	}
+	if request.Slug != nil {
+		newSlug := strings.TrimSpace(*request.Slug)
+		if err := s.checkSlug(newSlug, collection.ID); err != nil {
+			return nil, err
+		}
+		collection.Slug = newSlug
+	}
+	if request.Title != nil {
+		title := strings.TrimSpace(*request.Title)
+		if title == "" {
+			return nil, utils.ErrEmptyTitle
+		}
+		collection.Title = title
+	}
+	if request.Description != nil {
+		collection.Description = strings.TrimSpace(*request.Description)
+	}
+	if request.Cover != nil {
+		collection.Cover = trimCover(request.Cover)
+	}
+	if request.Visibility != nil {
+		visibility, ok := models.ParseVisibility(*request.Visibility)
+		if !ok {
+			return nil, utils.ErrInvalidVisibility
+		}
+		collection.Visibility = visibility
+	}
+	if err := s.collectionRepo.Update(&collection); err != nil {
+		return nil, err
+	}
+	return s.getDetail(user.ID, collection)
+}
+
+func (s *projectCollectionService) DeleteCollection(username, slug string) error {
+	_, collection, err := s.getManaged(username, slug)
+	if err != nil {
+		return err
+	}
+	return s.collectionRepo.Delete(collection.ID)
+}
+
+func (s *projectCollectionService) SetProjects(username, slug string, projectIDs []uint) (*dto.ProjectCollectionDetail, error) {
+	user, collection, err := s.getManaged(username, slug)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkProjects(user.ID, projectIDs); err != nil {
+		return nil, err
+	}
+	if err := s.collectionRepo.SetProjects(collection.ID, projectIDs); err != nil {
+		return nil, err
+	}
+	return s.getDetail(user.ID, collection)
+}
+
+func (s *projectCollectionService) getBySlug(slug string) (models.ProjectCollection, error) {
+	collection, err := s.collectionRepo.GetBySlug(slug)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return models.ProjectCollection{}, utils.ErrCollectionNotFound
+	}
+	return collection, err
+}
+
+// getManaged returns the user and the collection, or an error when the user can not change it.
+func (s *projectCollectionService) getManaged(username, slug string) (*model.User, models.ProjectCollection, error) {
+	user, err := s.userRepo.FindByUsername(username)
+	if err != nil {
+		return nil, models.ProjectCollection{}, err
+	}
+	collection, err := s.getBySlug(slug)
+	if err != nil {
+		return nil, models.ProjectCollection{}, err
+	}
+	if !canManageCollection(user, collection) {
+		// Private collections of others are not found rather than forbidden.
+		if collection.Visibility != models.VisibilityPublic {
+			return nil, models.ProjectCollection{}, utils.ErrCollectionNotFound
+		}
+		return nil, models.ProjectCollection{}, utils.ErrCollectionNotOwner
+	}
+	return user, collection, nil
+}
+
+func (s *projectCollectionService) checkSlug(slug string, exceptID uint) error {
+	if !utils.IsSlug(slug) {
+		return utils.ErrInvalidSlug
+	}
+	exists, err := s.collectionRepo.SlugExists(slug, exceptID)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return utils.ErrSlugTaken
+	}
+	return nil
+}
+
+// checkProjects makes sure the projects are listed once and the user can see each of them.
+func (s *projectCollectionService) checkProjects(userID uint, projectIDs []uint) error {
+	seen := make(map[uint]bool, len(projectIDs))
+	for _, projectID := range projectIDs {
+		if seen[projectID] {
+			return utils.ErrDuplicateProject
+		}
+		seen[projectID] = true
+		allowed, err := s.authorizer.Can(userID, projectID, models.ActionView)
+		if err != nil {
+			return err
+		}
+		if !allowed {
+			return utils.ErrProjectNotVisible
+		}
+	}
+	return nil
+}
+
+// getDetail returns the collection with the projects the user can see.
+func (s *projectCollectionService) getDetail(userID uint, collection models.ProjectCollection) (*dto.ProjectCollectionDetail, error) {
+	projects, err := s.collectionRepo.GetProjects(collection.ID)
+	if err != nil {
+		return nil, err
+	}
+	visible := make([]model.Project, 0, len(projects))
+	for _, project := range projects {
+		if !models.IsListed(project) {
+			allowed, err := s.authorizer.Can(userID, project.ID, models.ActionView)
+			if err != nil {
+				return nil, err
+			}
+			if !allowed {
+				continue
+			}
+		}
+		visible = append(visible, project)
+	}
+	return s.formatDetail(collection, visible)
+}
+
+func (s *projectCollectionService) formatDetail(collection models.ProjectCollection, projects []model.Project) (*dto.ProjectCollectionDetail, error) {
+	formatted := getFormatedProjects(&projects)
+	if err := setForkCounts(s.forkRepo, formatted); err != nil {
+		return nil, err
+	}
+	return &dto.ProjectCollectionDetail{
+		ProjectCollection: formatCollection(collection),
+		Projects:          formatted,
+	}, nil
+}
+
+// canManageCollection reports whether the user can change the collection, admins can change every collection.
+func canManageCollection(user *model.User, collection models.ProjectCollection) bool {
+	if user.Role == models.UserRoleAdmin {
+		return true
+	}
+	return collection.OwnerID != nil && *collection.OwnerID == user.ID
+}
+
+func trimCover(cover *string) *string {
+	if cover == nil {
+		return nil
+	}
+	trimmed := strings.TrimSpace(*cover)
+	if trimmed == "" {
+		return nil
+	}
+	return &trimmed
+}
+
+func formatCollections(collections []models.ProjectCollection) []dto.ProjectCollection {
+	formatted := make([]dto.ProjectCollection, 0, len(collections))
+	for _, collection := range collections {
+		formatted = append(formatted, formatCollection(collection))
+	}
+	return formatted
+}
+
+func formatCollection(collection models.ProjectCollection) dto.ProjectCollection {
+	formatted := dto.ProjectCollection{
+		ID:          collection.ID,
+		Slug:        collection.Slug,
+		Title:       collection.Title,
+		Description: collection.Description,
+		Cover:       collection.Cover,
+		Visibility:  models.VisibilityName(collection.Visibility),
+		AdminOwned:  collection.IsAdminOwned(),
+		CreatedAt:   collection.CreatedAt,
+		UpdatedAt:   collection.UpdatedAt,
+	}
+	if collection.Owner != nil {
+		owner := utils.GetCreatorDetails(*collection.Owner)
+		formatted.Owner = &owner
+	}
+	return formatted
+}
@@ -0,0 +1,166 @@
+package service
+
+import (
+	"errors"
+
+	"github.com/aruncs31s/esdcprojectmodule/dto"
+	repository "github.com/aruncs31s/esdcprojectmodule/interfaces/repository"
+	"github.com/aruncs31s/esdcprojectmodule/interfaces/service"
+	"github.com/aruncs31s/esdcprojectmodule/models"
+	utils "github.com/aruncs31s/esdcprojectmodule/utils"
+	userRepo "github.com/aruncs31s/esdcusermodule/repository"
+	"gorm.io/gorm"
+)
+
+type projectContributorService struct {
+	projectRepo     repository.ProjectRepository
+	contributorRepo repository.ProjectContributorRepository
+	userRepo        userRepo.UserRepository
+	authorizer      service.ProjectAuthorizer
+}
+
+func NewProjectContributorService(
+	projectRepo repository.ProjectRepository,
+	contributorRepo repository.ProjectContributorRepository,
+	userRepo userRepo.UserRepository,
+	authorizer service.ProjectAuthorizer,
+) service.ProjectContributorService {
+	return &projectContributorService{
+		projectRepo:     projectRepo,
+		contributorRepo: contributorRepo,
+		userRepo:        userRepo,
+		authorizer:      authorizer,
+	}
+}
+
+func (s *projectContributorService) GetContributors(username string, projectID uint) ([]dto.ProjectContributor, error) {
+	userID, err := s.userRepo.FindUserIDByUsername(username)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.authorizer.Authorize(userID, projectID, models.ActionView); err != nil {
+		return nil, err
+	}
+	project, err := s.projectRepo.GetByIDIncludingPrivate(projectID)
+	if err != nil {
+		return nil, err
+	}
+	rows, users, err := s.contributorRepo.GetContributors(projectID)
+	if err != nil {
+		return nil, err
+	}
+	contributors := make([]dto.ProjectContributor, len(rows))
+	for i, row := range rows {
+		role := row.Role
+		if users[i].ID == project.CreatedBy {
+			role = models.RoleOwner
+		} else if role == models.RoleOwner {
+			role = models.RoleMaintainer
+		}
+		contributors[i] = dto.ProjectContributor{
+			Contributor: utils.GetCreatorDetails(users[i]),
+			Role:        role,
+		}
+	}
+	return contributors, nil
+}
+
+func (s *projectContributorService) UpdateRole(username string, projectID, contributorID uint, role string) error {
+	if !models.IsValidRole(role) {
+		return utils.ErrInvalidRole
+	}
+	if role == models.RoleOwner {
+		return utils.ErrOwnerRole
+	}
+	actorID, actorRole, err := s.getManagerRole(username, projectID)
+	if err != nil {
+		return err
+	}
+	targetRole, err := s.authorizer.RoleOf(contributorID, projectID)
+	if err != nil {
+		return err
+	}
+	if targetRole == "" {
+		return utils.ErrContributorNotFound
+	}
+	if targetRole == models.RoleOwner {
+		return utils.ErrOwnerRole
+	}
+	if actorID == contributorID || !canGrantRole(actorRole, targetRole) || !canGrantRole(actorRole, role) {
+		return utils.ErrActionNotAllowed
+	}
+	return s.contributorRepo.SetRole(projectID, contributorID, role)
+}
+
+func (s *projectContributorService) RemoveContributor(username string, projectID, contributorID uint) error {
+	userID, err := s.userRepo.FindUserIDByUsername(username)
+	if err != nil {
+		return err
+	}
+	targetRole, err := s.authorizer.RoleOf(contributorID, projectID)
+	if err != nil {
+		return err
+	}
+	if targetRole == "" {
+		return utils.ErrContributorNotFound
+	}
+	if targetRole == models.RoleOwner {
+		return utils.ErrOwnerRole
+	}
+	// Leaving a project only needs the user to be a contributor.
+	if userID != contributorID {
+		_, actorRole, err := s.getManagerRole(username, projectID)
+		if err != nil {
+			return err
+		}
+		if !canGrantRole(actorRole, targetRole) {
+			return utils.ErrActionNotAllowed
+		}
+	}
+	if err := s.contributorRepo.Remove(projectID, contributorID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return utils.ErrContributorNotFound
+		}
+		return err
+	}
+	return nil
+}
+
+// getManagerRole checks that the user can manage the contributors and returns the user's role.
+//
+// Admins are treated as owners.
+func (s *projectContributorService) getManagerRole(username string, projectID uint) (uint, string, error) {
+	user, err := s.userRepo.FindByUsername(username)
+	if err != nil {
+		return 0, "", err
+	}
+	if err := s.authorizer.Authorize(user.ID, projectID, models.ActionManageContributors); err != nil {
+		return 0, "", err
+	}
+	if user.Role == models.UserRoleAdmin {
+		return user.ID, models.RoleOwner, nil
+	}
+	role, err := s.authorizer.RoleOf(user.ID, projectID)
+	return user.ID, role, err
+}
+
+// roleRank orders the roles, a higher rank has more permissions.
+func roleRank(role string) int {
+	switch role {
+	case models.RoleOwner:
+		return 4
+	case models.RoleMaintainer:
+		return 3
+	case models.RoleEditor:
+		return 2
+	case models.RoleViewer:
+		return 1
+	}
+	return 0
+}
+
+// canGrantRole reports whether a user with actorRole can give out or change the role.
+// Only roles below the actor's own role can be granted.
+func canGrantRole(actorRole, role string) bool {
+	return roleRank(role) < roleRank(actorRole)
+}
@@ -0,0 +1,101 @@
+package service
+
+import (
+	model "github.com/aruncs31s/esdcmodels"
+	"github.com/aruncs31s/esdcprojectmodule/dto"
+	repository "github.com/aruncs31s/esdcprojectmodule/interfaces/repository"
+	"github.com/aruncs31s/esdcprojectmodule/interfaces/service"
+	"github.com/aruncs31s/esdcprojectmodule/models"
+	userRepo "github.com/aruncs31s/esdcusermodule/repository"
+)
+
+// exportBatchSize is how many projects an export reads from the database at a time.
+const exportBatchSize = 200
+
+type projectExportService struct {
+	exportRepo repository.ProjectExportRepository
+	userRepo   userRepo.UserRepository
+}
+
+func NewProjectExportService(
+	exportRepo repository.ProjectExportRepository,
+	userRepo userRepo.UserRepository,
+) service.ProjectExportService {
+	return &projectExportService{
+		exportRepo: exportRepo,
+		userRepo:   userRepo,
+	}
+}
+
+func (s *projectExportService) ExportProjects(username string, filter dto.AdminProjectFilter, each func(row dto.ProjectExportRow) error) error {
+	if _, err := getAdminID(s.userRepo, username); err != nil {
+		return err
+	}
+	projectFilter, err := toProjectFilter(s.userRepo, filter)
+	if err != nil {
+		return err
+	}
+	return s.export(projectFilter, each)
+}
+
+func (s *projectExportService) ExportMyProjects(username string, filter dto.AdminProjectFilter, each func(row dto.ProjectExportRow) error) error {
+	userID, err := s.userRepo.FindUserIDByUsername(username)
+	if err != nil {
+		return err
+	}
+	filter.Creator = ""
+	projectFilter, err := toProjectFilter(s.userRepo, filter)
+	if err != nil {
+		return err
+	}
+	projectFilter.CreatedBy = userID
+	return s.export(projectFilter, each)
+}
+
+func (s *projectExportService) export(filter models.ProjectFilter, each func(row dto.ProjectExportRow) error) error {
+	return s.exportRepo.EachProject(filter, exportBatchSize, func(project model.Project) error {
+		return each(formatExportRow(project))
+	})
+}
+
+func formatExportRow(project model.Project) dto.ProjectExportRow {
+	row := dto.ProjectExportRow{
+		ID:           project.ID,
+		Title:        project.Title,
+		Description:  project.Description,
+		Category:     project.Category,
+		Status:       project.Status,
+		Visibility:   models.VisibilityName(project.Visibility),
+		Version:      project.Version,
+		Cost:         project.Cost,
+		Likes:        project.Likes,
+		Views:        project.Views,
+		GithubLink:   project.GithubLink,
+		Creator:      project.Creator.Username,
+		Tags:         []string{},
+		Technologies: []string{},
+		Contributors: []string{},
+		ForkedFromID: project.ForkedFrom,
+		CreatedAt:    project.CreatedAt,
+		UpdatedAt:    project.UpdatedAt,
+	}
+	if project.LiveURL != nil {
+		row.LiveURL = *project.LiveURL
+	}
+	if project.Tags != nil {
+		for _, tag := range *project.Tags {
+			row.Tags = append(row.Tags, tag.Name)
+		}
+	}
+	if project.Technologies != nil {
+		for _, technology := range *project.Technologies {
+			row.Technologies = append(row.Technologies, technology.Name)
+		}
+	}
+	if project.Contributors != nil {
+		for _, contributor := range *project.Contributors {
+			row.Contributors = append(row.Contributors, contributor.Username)
+		}
+	}
+	return row
+}
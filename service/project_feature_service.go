@@ -0,0 +1,116 @@
+package service
+
+import (
+	"errors"
+	"time"
+
+	"github.com/aruncs31s/esdcprojectmodule/dto"
+	repository "github.com/aruncs31s/esdcprojectmodule/interfaces/repository"
+	"github.com/aruncs31s/esdcprojectmodule/interfaces/service"
+	"github.com/aruncs31s/esdcprojectmodule/models"
+	utils "github.com/aruncs31s/esdcprojectmodule/utils"
+	userRepo "github.com/aruncs31s/esdcusermodule/repository"
+	"gorm.io/gorm"
+)
+
+type projectFeatureService struct {
+	projectRepo repository.ProjectRepository
+	featureRepo repository.ProjectFeatureRepository
+	forkRepo    repository.ProjectForkRepository
+	userRepo    userRepo.UserRepository
+}
+
+func NewProjectFeatureService(
+	projectRepo repository.ProjectRepository,
+	featureRepo repository.ProjectFeatureRepository,
+	forkRepo repository.ProjectForkRepository,
+	userRepo userRepo.UserRepository,
+) service.ProjectFeatureService {
+	return &projectFeatureService{
+		projectRepo: projectRepo,
+		featureRepo: featureRepo,
+		forkRepo:    forkRepo,
+		userRepo:    userRepo,
+	}
+}
+
+func (s *projectFeatureService) GetFeatured(limit, offset int) ([]dto.ProjectResponseForPublic, error) {
+	projects, err := s.featureRepo.GetActive(time.Now(), limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	featured := getFormatedProjects(projects)
+	if err := setForkCounts(s.forkRepo, featured); err != nil {
+		return nil, err
+	}
+	return featured, nil
+}
+
+func (s *projectFeatureService) GetFeatures(username string, limit, offset int) ([]dto.ProjectFeature, error) {
+	if _, err := getAdminID(s.userRepo, username); err != nil {
+		return nil, err
+	}
+	features, err := s.featureRepo.GetAll(limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	formatted := make([]dto.ProjectFeature, 0, len(features))
+	for _, feature := range features {
+		formatted = append(formatted, formatFeature(feature, now))
+	}
+	return formatted, nil
+}
+
+func (s *projectFeatureService) FeatureProject(username string, projectID uint, request dto.ProjectFeatureRequest) (*dto.ProjectFeature, error) {
+	adminID, err := getAdminID(s.userRepo, username)
+	if err != nil {
+		return nil, err
+	}
+	project, err := s.projectRepo.GetByIDIncludingPrivate(projectID)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	feature := models.ProjectFeature{
+		ProjectID:  projectID,
+		Position:   request.Position,
+		StartsAt:   now,
+		EndsAt:     request.EndsAt,
+		FeaturedBy: adminID,
+		Project:    project,
+	}
+	if request.StartsAt != nil {
+		feature.StartsAt = *request.StartsAt
+	}
+	if feature.EndsAt != nil && !feature.EndsAt.After(feature.StartsAt) {
+		return nil, utils.ErrInvalidFeatureRange
+	}
+	if err := s.featureRepo.Save(&feature); err != nil {
+		return nil, err
+	}
+	formatted := formatFeature(feature, now)
+	return &formatted, nil
+}
+
+func (s *projectFeatureService) UnfeatureProject(username string, projectID uint) error {
+	if _, err := getAdminID(s.userRepo, username); err != nil {
+		return err
+	}
+	err := s.featureRepo.Delete(projectID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return utils.ErrFeatureNotFound
+	}
+	return err
+}
+
+func formatFeature(feature models.ProjectFeature, now time.Time) dto.ProjectFeature {
+	return dto.ProjectFeature{
+		ProjectID: feature.ProjectID,
+		Title:     feature.Project.Title,
+		Position:  feature.Position,
+		StartsAt:  feature.StartsAt,
+		EndsAt:    feature.EndsAt,
+		Active:    feature.IsActive(now) && models.IsListed(feature.Project),
+	}
+}
@@ -0,0 +1,229 @@
+package service
+
+import (
+	"sort"
+	"strings"
+
+	model "github.com/aruncs31s/esdcmodels"
+	"github.com/aruncs31s/esdcprojectmodule/dto"
+	repository "github.com/aruncs31s/esdcprojectmodule/interfaces/repository"
+	"github.com/aruncs31s/esdcprojectmodule/interfaces/service"
+	userRepo "github.com/aruncs31s/esdcusermodule/repository"
+)
+
+// feedCandidateLimit caps how many public projects are ranked per request.
+const feedCandidateLimit = 500
+
+// Weights used when scoring a candidate project against a FeedProfile.
+const (
+	feedWeightOwnTechnology   = 3
+	feedWeightLikedTechnology = 1
+	feedWeightLikedTag        = 2
+	feedWeightLikedCategory   = 1
+	feedWeightCollaborator    = 4
+)
+
+type projectFeedService struct {
+	feedRepo repository.ProjectFeedRepository
+	userRepo userRepo.UserRepository
+}
+
+func NewProjectFeedService(
+	feedRepo repository.ProjectFeedRepository,
+	userRepo userRepo.UserRepository,
+) service.ProjectFeedService {
+	return &projectFeedService{
+		feedRepo: feedRepo,
+		userRepo: userRepo,
+	}
+}
+
+func (s *projectFeedService) GetFeed(username string, limit, offset int) ([]*dto.ProjectResponse, error) {
+	userID, err := s.userRepo.FindUserIDByUsername(username)
+	if err != nil {
+		return nil, err
+	}
+	liked, err := s.feedRepo.GetLikedProjects(userID)
+	if err != nil {
+		return nil, err
+	}
+	involved, err := s.feedRepo.GetInvolvedProjects(userID)
+	if err != nil {
+		return nil, err
+	}
+	profile := BuildFeedProfile(userID, liked, involved)
+	candidates, err := s.feedRepo.GetFeedCandidates(userID, profile.ExcludedIDs(), feedCandidateLimit)
+	if err != nil {
+		return nil, err
+	}
+	ranked := RankFeed(profile, candidates)
+
+	// The feed is paged in memory, so a page below 1 must not index before
+	// the start of the ranked slice.
+	offset = max(offset, 0)
+	feed := make([]*dto.ProjectResponse, 0)
+	for i := offset; i < len(ranked) && i < offset+limit; i++ {
+		feed = append(feed, getProjectResponseForPersonal(ranked[i], false))
+	}
+	return feed, nil
+}
+
+// FeedProfile holds the signals used to rank projects for a single user.
+type FeedProfile struct {
+	UserID            uint
+	LikedIDs          map[uint]bool
+	InvolvedIDs       map[uint]bool
+	OwnTechnologies   map[string]bool
+	LikedTechnologies map[string]bool
+	LikedTags         map[string]bool
+	LikedCategories   map[string]bool
+	Collaborators     map[uint]bool
+}
+
+// BuildFeedProfile collects the feed signals from the projects a user liked
+// and the projects the user created or contributes to.
+func BuildFeedProfile(userID uint, liked, involved []model.Project) FeedProfile {
+	profile := FeedProfile{
+		UserID:            userID,
+		LikedIDs:          map[uint]bool{},
+		InvolvedIDs:       map[uint]bool{},
+		OwnTechnologies:   map[string]bool{},
+		LikedTechnologies: map[string]bool{},
+		LikedTags:         map[string]bool{},
+		LikedCategories:   map[string]bool{},
+		Collaborators:     map[uint]bool{},
+	}
+	for _, project := range liked {
+		profile.LikedIDs[project.ID] = true
+		addTechnologies(profile.LikedTechnologies, project.Technologies)
+		addTags(profile.LikedTags, project.Tags)
+		if project.Category != "" {
+			profile.LikedCategories[normalizeFeedKey(project.Category)] = true
+		}
+	}
+	for _, project := range involved {
+		profile.InvolvedIDs[project.ID] = true
+		addTechnologies(profile.OwnTechnologies, project.Technologies)
+		if project.CreatedBy != userID {
+			profile.Collaborators[project.CreatedBy] = true
+		}
+		if project.Contributors != nil {
+			for _, contributor := range *project.Contributors {
+				if contributor.ID != userID {
+					profile.Collaborators[contributor.ID] = true
+				}
+			}
+		}
+	}
+	return profile
+}
+
+// ExcludedIDs returns the sorted IDs of projects that must not show up in the feed.
+func (p FeedProfile) ExcludedIDs() []uint {
+	ids := make([]uint, 0, len(p.LikedIDs)+len(p.InvolvedIDs))
+	for id := range p.LikedIDs {
+		ids = append(ids, id)
+	}
+	for id := range p.InvolvedIDs {
+		if !p.LikedIDs[id] {
+			ids = append(ids, id)
+		}
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
+
+// ScoreProject returns how relevant a project is to the profile.
+func (p FeedProfile) ScoreProject(project model.Project) int {
+	score := 0
+	if project.Technologies != nil {
+		for _, tech := range *project.Technologies {
+			key := normalizeFeedKey(tech.Name)
+			if p.OwnTechnologies[key] {
+				score += feedWeightOwnTechnology
+			}
+			if p.LikedTechnologies[key] {
+				score += feedWeightLikedTechnology
+			}
+		}
+	}
+	if project.Tags != nil {
+		for _, tag := range *project.Tags {
+			if p.LikedTags[normalizeFeedKey(tag.Name)] {
+				score += feedWeightLikedTag
+			}
+		}
+	}
+	if p.LikedCategories[normalizeFeedKey(project.Category)] {
+		score += feedWeightLikedCategory
+	}
+	if p.Collaborators[project.CreatedBy] {
+		score += feedWeightCollaborator
+	} else if project.Contributors != nil {
+		for _, contributor := range *project.Contributors {
+			if p.Collaborators[contributor.ID] {
+				score += feedWeightCollaborator
+				break
+			}
+		}
+	}
+	return score
+}
+
+// RankFeed drops excluded projects and orders the rest by score.
+//
+// Ties are broken by likes, then views, then the newest project ID so the
+// result is deterministic for the same input.
+func RankFeed(profile FeedProfile, candidates []model.Project) []model.Project {
+	type scored struct {
+		project model.Project
+		score   int
+	}
+	ranked := make([]scored, 0, len(candidates))
+	for _, project := range candidates {
+		if profile.LikedIDs[project.ID] || profile.InvolvedIDs[project.ID] || project.CreatedBy == profile.UserID {
+			continue
+		}
+		ranked = append(ranked, scored{project: project, score: profile.ScoreProject(project)})
+	}
+	sort.SliceStable(ranked, func(i, j int) bool {
+		a, b := ranked[i], ranked[j]
+		if a.score != b.score {
+			return a.score > b.score
+		}
+		if a.project.Likes != b.project.Likes {
+			return a.project.Likes > b.project.Likes
+		}
+		if a.project.Views != b.project.Views {
+			return a.project.Views > b.project.Views
+		}
+		return a.project.ID > b.project.ID
+	})
+	projects := make([]model.Project, len(ranked))
+	for i, r := range ranked {
+		projects[i] = r.project
+	}
+	return projects
+}
+
+func addTechnologies(set map[string]bool, technologies *[]model.Technologies) {
+	if technologies == nil {
+		return
+	}
+	for _, tech := range *technologies {
+		set[normalizeFeedKey(tech.Name)] = true
+	}
+}
+
+func addTags(set map[string]bool, tags *[]model.Tag) {
+	if tags == nil {
+		return
+	}
+	for _, tag := range *tags {
+		set[normalizeFeedKey(tag.Name)] = true
+	}
+}
+
+func normalizeFeedKey(name string) string {
+	return strings.ToLower(strings.TrimSpace(name))
+}
@@ -0,0 +1,196 @@
+package service
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	model "github.com/aruncs31s/esdcmodels"
+	userRepo "github.com/aruncs31s/esdcusermodule/repository"
+)
+
+var feedTestNow = time.Date(2026, time.March, 2, 10, 0, 0, 0, time.UTC)
+
+func feedProject(id, createdBy uint, category string, technologies, tags []string, contributors ...uint) model.Project {
+	techs := make([]model.Technologies, len(technologies))
+	for i, name := range technologies {
+		techs[i] = model.Technologies{Name: name}
+	}
+	tagList := make([]model.Tag, len(tags))
+	for i, name := range tags {
+		tagList[i] = model.Tag{Name: name}
+	}
+	users := make([]model.User, len(contributors))
+	for i, userID := range contributors {
+		users[i] = model.User{ID: userID}
+	}
+	return model.Project{
+		ID:           id,
+		CreatedBy:    createdBy,
+		Category:     category,
+		Technologies: &techs,
+		Tags:         &tagList,
+		Contributors: &users,
+		CreatedAt:    feedTestNow.Add(-time.Duration(id) * time.Hour),
+	}
+}
+
+func feedTestProfile() FeedProfile {
+	liked := []model.Project{
+		feedProject(10, 5, "IoT", []string{"Rust"}, []string{"Robotics"}),
+	}
+	involved := []model.Project{
+		feedProject(20, 1, "Web", []string{"Go", " gin "}, nil, 7),
+		feedProject(21, 8, "Web", nil, nil, 1),
+	}
+	return BuildFeedProfile(1, liked, involved)
+}
+
+func TestBuildFeedProfile(t *testing.T) {
+	profile := feedTestProfile()
+	if !reflect.DeepEqual(profile.ExcludedIDs(), []uint{10, 20, 21}) {
+		t.Fatalf("excluded IDs = %v", profile.ExcludedIDs())
+	}
+	if !profile.OwnTechnologies["gin"] || !profile.OwnTechnologies["go"] {
+		t.Fatalf("own technologies = %v", profile.OwnTechnologies)
+	}
+	if !profile.Collaborators[7] || !profile.Collaborators[8] || profile.Collaborators[1] {
+		t.Fatalf("collaborators = %v", profile.Collaborators)
+	}
+}
+
+func TestScoreProject(t *testing.T) {
+	profile := feedTestProfile()
+	tests := []struct {
+		name    string
+		project model.Project
+		want    int
+	}{
+		{"no signal", feedProject(30, 9, "General", []string{"Java"}, []string{"misc"}), 0},
+		{"own technology", feedProject(31, 9, "General", []string{"GO"}, nil), feedWeightOwnTechnology},
+		{"liked technology", feedProject(32, 9, "General", []string{"rust"}, nil), feedWeightLikedTechnology},
+		{"liked tag", feedProject(33, 9, "General", nil, []string{"robotics"}), feedWeightLikedTag},
+		{"liked category", feedProject(34, 9, "iot", nil, nil), feedWeightLikedCategory},
+		{"collaborator creator", feedProject(35, 7, "General", nil, nil), feedWeightCollaborator},
+		{"collaborator contributor counted once", feedProject(36, 9, "General", nil, nil, 7, 8), feedWeightCollaborator},
+		{
+			"every signal",
+			feedProject(37, 8, "IoT", []string{"Go", "Rust"}, []string{"Robotics"}, 7),
+			feedWeightOwnTechnology + feedWeightLikedTechnology + feedWeightLikedTag + feedWeightLikedCategory + feedWeightCollaborator,
+		},
+		{"nil relations", model.Project{ID: 38, CreatedBy: 9}, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := profile.ScoreProject(tt.project); got != tt.want {
+				t.Fatalf("ScoreProject() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRankFeed(t *testing.T) {
+	profile := feedTestProfile()
+	withStats := func(project model.Project, likes, views int) model.Project {
+		project.Likes = likes
+		project.Views = views
+		return project
+	}
+	tests := []struct {
+		name       string
+		candidates []model.Project
+		want       []uint
+	}{
+		{"empty", nil, []uint{}},
+		{
+			"drops liked, involved and own projects",
+			[]model.Project{
+				feedProject(10, 5, "IoT", nil, nil),
+				feedProject(20, 1, "Web", nil, nil),
+				feedProject(40, 1, "Web", nil, nil),
+				feedProject(41, 9, "General", nil, nil),
+			},
+			[]uint{41},
+		},
+		{
+			"orders by score",
+			[]model.Project{
+				feedProject(50, 9, "General", nil, nil),
+				feedProject(51, 9, "General", []string{"Go"}, nil),
+				feedProject(52, 7, "General", nil, nil),
+				feedProject(53, 9, "General", nil, []string{"Robotics"}),
+			},
+			[]uint{52, 51, 53, 50},
+		},
+		{
+			"breaks ties by likes, views then newest ID",
+			[]model.Project{
+				withStats(feedProject(60, 9, "General", nil, nil), 1, 0),
+				withStats(feedProject(61, 9, "General", nil, nil), 1, 5),
+				withStats(feedProject(62, 9, "General", nil, nil), 3, 0),
+				withStats(feedProject(63, 9, "General", nil, nil), 1, 5),
+			},
+			[]uint{62, 63, 61, 60},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := make([]uint, 0)
+			for _, project := range RankFeed(profile, tt.candidates) {
+				got = append(got, project.ID)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("RankFeed() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+type fakeFeedRepository struct {
+	candidates []model.Project
+}
+
+func (r *fakeFeedRepository) GetLikedProjects(uint) ([]model.Project, error)    { return nil, nil }
+func (r *fakeFeedRepository) GetInvolvedProjects(uint) ([]model.Project, error) { return nil, nil }
+func (r *fakeFeedRepository) GetFeedCandidates(uint, []uint, int) ([]model.Project, error) {
+	return r.candidates, nil
+}
+
+type fakeFeedUserRepository struct {
+	userRepo.UserRepository
+}
+
+func (fakeFeedUserRepository) FindUserIDByUsername(string) (uint, error) { return 1, nil }
+
+func TestGetFeedPaging(t *testing.T) {
+	candidates := []model.Project{
+		feedProject(1, 9, "General", nil, nil),
+		feedProject(2, 9, "General", nil, nil),
+		feedProject(3, 9, "General", nil, nil),
+	}
+	s := NewProjectFeedService(&fakeFeedRepository{candidates: candidates}, fakeFeedUserRepository{})
+	tests := []struct {
+		name          string
+		limit, offset int
+		want          int
+	}{
+		{"first page", 2, 0, 2},
+		{"last page", 2, 2, 1},
+		{"past the end", 2, 4, 0},
+		{"page zero", 2, -2, 2},
+		{"negative page", 10, -30, 3},
+		{"zero page size", 0, 0, 0},
+		{"negative page size", -10, 10, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			feed, err := s.GetFeed("alice", tt.limit, tt.offset)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(feed) != tt.want {
+				t.Fatalf("len(GetFeed()) = %d, want %d", len(feed), tt.want)
+			}
+		})
+	}
+}
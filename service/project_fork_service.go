@@ -0,0 +1,91 @@
+package service
+
+import (
+	model "github.com/aruncs31s/esdcmodels"
+	"github.com/aruncs31s/esdcprojectmodule/dto"
+	repository "github.com/aruncs31s/esdcprojectmodule/interfaces/repository"
+	"github.com/aruncs31s/esdcprojectmodule/interfaces/service"
+	userRepo "github.com/aruncs31s/esdcusermodule/repository"
+)
+
+// forkTitleSuffix is appended to the title of a forked project.
+const forkTitleSuffix = " (fork)"
+
+type projectForkService struct {
+	projectRepo repository.ProjectRepository
+	forkRepo    repository.ProjectForkRepository
+	userRepo    userRepo.UserRepository
+}
+
+func NewProjectForkService(
+	projectRepo repository.ProjectRepository,
+	forkRepo repository.ProjectForkRepository,
+	userRepo userRepo.UserRepository,
+) service.ProjectForkService {
+	return &projectForkService{
+		projectRepo: projectRepo,
+		forkRepo:    forkRepo,
+		userRepo:    userRepo,
+	}
+}
+
+func (s *projectForkService) ForkProject(username string, projectID uint) (*dto.ProjectResponse, error) {
+	user, err := s.userRepo.FindByUsername(username)
+	if err != nil {
+		return nil, err
+	}
+	// GetByID does not return private projects or drafts, so only listed projects can be forked.
+	source, err := s.projectRepo.GetByID(projectID)
+	if err != nil {
+		return nil, err
+	}
+	fork := model.Project{
+		Title:        source.Title + forkTitleSuffix,
+		Description:  source.Description,
+		Category:     source.Category,
+		Tags:         source.Tags,
+		Technologies: source.Technologies,
+		Contributors: &[]model.User{*user},
+		CreatedBy:    user.ID,
+		ModifiedBy:   &user.ID,
+		Status:       "active",
+		Visibility:   source.Visibility,
+		ForkedFrom:   &source.ID,
+	}
+	if err := s.forkRepo.CreateFork(&fork); err != nil {
+		return nil, err
+	}
+	fork.Creator = *user
+	return getProjectResponseForPersonal(fork, false), nil
+}
+
+func (s *projectForkService) GetForks(projectID uint, limit, offset int) (*[]dto.ProjectResponseForPublic, error) {
+	if _, err := s.projectRepo.GetByID(projectID); err != nil {
+		return nil, err
+	}
+	forks, err := s.forkRepo.GetForks(projectID, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	projects := getFormatedProjects(forks)
+	if err := setForkCounts(s.forkRepo, projects); err != nil {
+		return nil, err
+	}
+	return &projects, nil
+}
+
+// setForkCounts fills ForkCount of the formatted projects from the fork counters.
+func setForkCounts(forkRepo repository.ProjectForkRepository, projects []dto.ProjectResponseForPublic) error {
+	ids := make([]uint, len(projects))
+	for i, project := range projects {
+		ids[i] = project.ID
+	}
+	counts, err := forkRepo.GetForkCounts(ids)
+	if err != nil {
+		return err
+	}
+	for i := range projects {
+		projects[i].ForkCount = counts[projects[i].ID]
+	}
+	return nil
+}
@@ -0,0 +1,81 @@
+package service
+
+import (
+	"testing"
+
+	model "github.com/aruncs31s/esdcmodels"
+	repositoryInterface "github.com/aruncs31s/esdcprojectmodule/interfaces/repository"
+	"github.com/aruncs31s/esdcprojectmodule/models"
+	"github.com/aruncs31s/esdcprojectmodule/repository"
+	userRepo "github.com/aruncs31s/esdcusermodule/repository"
+)
+
+func TestForkProjectKeepsVisibility(t *testing.T) {
+	db := newCreateTestDB(t)
+	source := model.Project{Title: "Rover", CreatedBy: 1, Status: models.StatusActive}
+	if err := db.Create(&source).Error; err != nil {
+		t.Fatal(err)
+	}
+	// The column default replaces a zero visibility on create.
+	if err := db.Model(&source).Update("visibility", models.VisibilityPublic).Error; err != nil {
+		t.Fatal(err)
+	}
+	s := NewProjectForkService(repository.NewProjectRepository(db), repository.NewProjectForkRepository(db), userRepo.NewUserRepository(db))
+
+	fork, err := s.ForkProject("bob", source.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var stored model.Project
+	if err := db.First(&stored, fork.ID).Error; err != nil {
+		t.Fatal(err)
+	}
+	if stored.Visibility != models.VisibilityPublic {
+		t.Errorf("fork visibility = %d, want public", stored.Visibility)
+	}
+	var revision models.ProjectRevision
+	if err := db.Where("project_id = ?", fork.ID).First(&revision).Error; err != nil {
+		t.Fatal(err)
+	}
+	if revision.Visibility != models.VisibilityPublic {
+		t.Errorf("first revision visibility = %d, want public", revision.Visibility)
+	}
+}
+
+type fakePublicProjectRepository struct {
+	repositoryInterface.PublicProjectRepository
+}
+
+func (r *fakePublicProjectRepository) GetProject(id uint) (*model.Project, error) {
+	return &model.Project{ID: id}, nil
+}
+
+type fakeForkCountRepository struct {
+	repositoryInterface.ProjectForkRepository
+	counts map[uint]int
+}
+
+func (r *fakeForkCountRepository) GetForkCounts([]uint) (map[uint]int, error) {
+	return r.counts, nil
+}
+
+func TestPublicProjectsServiceForkCounts(t *testing.T) {
+	publicRepo := &fakePublicProjectRepository{}
+
+	project, err := NewPublicProjectsService(publicRepo, nil).GetProject(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if project.ForkCount != 0 {
+		t.Errorf("ForkCount without fork counts = %d, want 0", project.ForkCount)
+	}
+
+	forkRepo := &fakeForkCountRepository{counts: map[uint]int{1: 3}}
+	project, err = NewPublicProjectsService(publicRepo, nil, WithForkCounts(forkRepo)).GetProject(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if project.ForkCount != 3 {
+		t.Errorf("ForkCount = %d, want 3", project.ForkCount)
+	}
+}
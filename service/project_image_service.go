@@ -0,0 +1,256 @@
+package service
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"io"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/aruncs31s/esdcprojectmodule/dto"
+	"github.com/aruncs31s/esdcprojectmodule/imaging"
+	repository "github.com/aruncs31s/esdcprojectmodule/interfaces/repository"
+	"github.com/aruncs31s/esdcprojectmodule/interfaces/service"
+	"github.com/aruncs31s/esdcprojectmodule/interfaces/storage"
+	"github.com/aruncs31s/esdcprojectmodule/models"
+	utils "github.com/aruncs31s/esdcprojectmodule/utils"
+	userRepo "github.com/aruncs31s/esdcusermodule/repository"
+	"gorm.io/gorm"
+)
+
+const (
+	// MaxImageBytes is the largest image that can be uploaded.
+	MaxImageBytes = 5 << 20
+	// maxImagePixels guards against small files that decode to huge images.
+	maxImagePixels = 40_000_000
+	originalSize   = "original"
+	// coverSize is the variant the image column of the project points at.
+	coverSize = "large"
+)
+
+// imageSizes are the variants made of every upload, by the longest side in pixels.
+var imageSizes = []struct {
+	name    string
+	maxSide int
+}{
+	{"thumb", 320},
+	{"medium", 800},
+	{"large", 1600},
+}
+
+// imageExtensions are the accepted upload types.
+var imageExtensions = map[string]string{
+	"image/jpeg": ".jpg",
+	"image/png":  ".png",
+	"image/gif":  ".gif",
+	"image/webp": ".webp",
+}
+
+type projectImageService struct {
+	projectRepo repository.ProjectRepository
+	imageRepo   repository.ProjectImageRepository
+	userRepo    userRepo.UserRepository
+	authorizer  service.ProjectAuthorizer
+	blobStore   storage.BlobStore
+}
+
+func NewProjectImageService(
+	projectRepo repository.ProjectRepository,
+	imageRepo repository.ProjectImageRepository,
+	userRepo userRepo.UserRepository,
+	authorizer service.ProjectAuthorizer,
+	blobStore storage.BlobStore,
+) service.ProjectImageService {
+	return &projectImageService{
+		projectRepo: projectRepo,
+		imageRepo:   imageRepo,
+		userRepo:    userRepo,
+		authorizer:  authorizer,
+		blobStore:   blobStore,
+	}
+}
+
+func (s *projectImageService) UploadImage(username string, projectID uint, file io.Reader, contentType string) (*dto.ProjectImage, error) {
+	userID, err := s.userRepo.FindUserIDByUsername(username)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.authorizer.Authorize(userID, projectID, models.ActionEdit); err != nil {
+		return nil, err
+	}
+	if _, ok := imageExtensions[contentType]; !ok {
+		return nil, utils.ErrUnsupportedImage
+	}
+	data, err := io.ReadAll(io.LimitReader(file, MaxImageBytes+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > MaxImageBytes {
+		return nil, utils.ErrImageTooLarge
+	}
+	// Trust the bytes, not the declared type.
+	detectedType := http.DetectContentType(data)
+	extension, ok := imageExtensions[detectedType]
+	if !ok {
+		return nil, utils.ErrUnsupportedImage
+	}
+	config, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, utils.ErrUnsupportedImage
+	}
+	if config.Width*config.Height > maxImagePixels {
+		return nil, utils.ErrImageTooLarge
+	}
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, utils.ErrUnsupportedImage
+	}
+
+	prefix := fmt.Sprintf("projects/%d/images/%d/", projectID, time.Now().UnixNano())
+	uploaded := make([]models.ImageVariant, 0, 1+2*len(imageSizes))
+	original := models.ImageVariant{
+		Size:   originalSize,
+		Format: strings.TrimPrefix(detectedType, "image/"),
+		Width:  config.Width,
+		Height: config.Height,
+		Key:    prefix + originalSize + extension,
+	}
+	if err := s.blobStore.Put(original.Key, bytes.NewReader(data), detectedType); err != nil {
+		return nil, err
+	}
+	uploaded = append(uploaded, original)
+	variants, err := storeImageVariants(s.blobStore, img, prefix, detectedType)
+	uploaded = append(uploaded, variants...)
+	if err != nil {
+		deleteImageVariants(s.blobStore, uploaded)
+		return nil, err
+	}
+
+	projectImage := &models.ProjectImage{
+		ProjectID:  projectID,
+		Variants:   uploaded,
+		UploadedBy: userID,
+	}
+	coverURL := ""
+	for _, variant := range variants {
+		if variant.Size == coverSize {
+			coverURL = s.blobStore.URL(variant.Key)
+			break
+		}
+	}
+	previous, err := s.imageRepo.Replace(projectImage, coverURL)
+	if err != nil {
+		deleteImageVariants(s.blobStore, uploaded)
+		return nil, err
+	}
+	if previous != nil {
+		deleteImageVariants(s.blobStore, previous.Variants)
+	}
+	return s.formatImage(projectImage), nil
+}
+
+func (s *projectImageService) GetImage(username string, projectID uint) (*dto.ProjectImage, error) {
+	if username == "" {
+		if _, err := s.projectRepo.GetByID(projectID); err != nil {
+			return nil, err
+		}
+	} else {
+		userID, err := s.userRepo.FindUserIDByUsername(username)
+		if err != nil {
+			return nil, err
+		}
+		if err := s.authorizer.Authorize(userID, projectID, models.ActionView); err != nil {
+			return nil, err
+		}
+	}
+	projectImage, err := s.imageRepo.GetByProject(projectID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, utils.ErrImageNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return s.formatImage(projectImage), nil
+}
+
+// storeImageVariants resizes the image to every size and stores each one in the
+// primary format and as WebP. The variants stored so far are returned on error too.
+func storeImageVariants(blobStore storage.BlobStore, img image.Image, prefix, contentType string) ([]models.ImageVariant, error) {
+	// JPEG has no transparency, so everything else keeps it in PNG.
+	var primary imaging.Encoder = imaging.PNGEncoder{}
+	if contentType == "image/jpeg" {
+		primary = imaging.JPEGEncoder{Quality: 85}
+	}
+	encoders := []imaging.Encoder{primary, imaging.WebPEncoder{}}
+	variants := make([]models.ImageVariant, 0, len(imageSizes)*len(encoders))
+	for _, size := range imageSizes {
+		resized := imaging.Fit(img, size.maxSide)
+		for _, encoder := range encoders {
+			var buf bytes.Buffer
+			if err := encoder.Encode(&buf, resized); err != nil {
+				return variants, err
+			}
+			variant := models.ImageVariant{
+				Size:   size.name,
+				Format: encoder.Format(),
+				Width:  resized.Bounds().Dx(),
+				Height: resized.Bounds().Dy(),
+				Key:    prefix + size.name + encoder.Extension(),
+			}
+			if err := blobStore.Put(variant.Key, &buf, encoder.ContentType()); err != nil {
+				return variants, err
+			}
+			variants = append(variants, variant)
+		}
+	}
+	return variants, nil
+}
+
+// deleteImageVariants removes stored files, failures are only logged since the
+// image itself is already replaced or never got saved.
+func deleteImageVariants(blobStore storage.BlobStore, variants []models.ImageVariant) {
+	for _, variant := range variants {
+		if err := blobStore.Delete(variant.Key); err != nil {
+			log.Printf("Error deleting image %s: %v", variant.Key, err)
+		}
+	}
+}
+
+func (s *projectImageService) formatImage(projectImage *models.ProjectImage) *dto.ProjectImage {
+	original, sizes := formatImageVariants(projectImage.Variants, func(variant models.ImageVariant) string {
+		return s.blobStore.URL(variant.Key)
+	})
+	return &dto.ProjectImage{
+		ProjectID:  projectImage.ProjectID,
+		Original:   original,
+		Sizes:      sizes,
+		UploadedAt: projectImage.CreatedAt,
+	}
+}
+
+// formatImageVariants groups the variants by size, the original is returned on its own.
+// url gives the address each variant is served from.
+func formatImageVariants(variants []models.ImageVariant, url func(models.ImageVariant) string) (dto.ImageSize, map[string]dto.ImageSize) {
+	var original dto.ImageSize
+	sizes := make(map[string]dto.ImageSize)
+	for _, variant := range variants {
+		size := sizes[variant.Size]
+		if variant.Size == originalSize {
+			size = original
+		}
+		if size.URLs == nil {
+			size = dto.ImageSize{Width: variant.Width, Height: variant.Height, URLs: make(map[string]string)}
+		}
+		size.URLs[variant.Format] = url(variant)
+		if variant.Size == originalSize {
+			original = size
+		} else {
+			sizes[variant.Size] = size
+		}
+	}
+	return original, sizes
+}
@@ -0,0 +1,143 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/aruncs31s/esdcprojectmodule/dto"
+	"github.com/aruncs31s/esdcprojectmodule/interfaces/provider"
+	repository "github.com/aruncs31s/esdcprojectmodule/interfaces/repository"
+	"github.com/aruncs31s/esdcprojectmodule/interfaces/service"
+	"github.com/aruncs31s/esdcprojectmodule/models"
+	repoProvider "github.com/aruncs31s/esdcprojectmodule/provider"
+	utils "github.com/aruncs31s/esdcprojectmodule/utils"
+	"gorm.io/gorm"
+)
+
+type projectImportService struct {
+	projectService service.ProjectService
+	metadataRepo   repository.ProjectRepoMetadataRepository
+	provider       provider.RepoMetadataProvider
+}
+
+func NewProjectImportService(
+	projectService service.ProjectService,
+	metadataRepo repository.ProjectRepoMetadataRepository,
+	provider provider.RepoMetadataProvider,
+) service.ProjectImportService {
+	return &projectImportService{
+		projectService: projectService,
+		metadataRepo:   metadataRepo,
+		provider:       provider,
+	}
+}
+
+func (s *projectImportService) Import(username, url string) (*dto.ProjectResponse, error) {
+	url = strings.TrimSpace(url)
+	if url == "" {
+		return nil, utils.ErrNoImportURL
+	}
+	if !s.provider.Supports(url) {
+		return nil, utils.ErrUnsupportedRepoLink
+	}
+	if err := s.checkNotLinked(username, url); err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), repoFetchTimeout)
+	defer cancel()
+	fetched, err := s.provider.Fetch(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+	readme, err := s.provider.FetchReadme(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+	draft := importedProject(url, fetched, readme)
+	// The canonical link differs from the given one when the repository was renamed or moved.
+	if draft.GithubLink != url {
+		if err := s.checkNotLinked(username, draft.GithubLink); err != nil {
+			return nil, err
+		}
+	}
+	project, err := s.projectService.CreateProject(username, draft)
+	if err != nil {
+		return nil, err
+	}
+	// The fetched values are stored right away instead of waiting for the next sync.
+	metadata := &models.ProjectRepoMetadata{
+		ProjectID: project.ID,
+		Provider:  s.provider.Name(),
+		RepoURL:   draft.GithubLink,
+		SyncedAt:  time.Now(),
+	}
+	setRepoMetadata(metadata, fetched)
+	if err := s.metadataRepo.Save(metadata); err != nil {
+		return nil, err
+	}
+	return s.projectService.GetProject(project.ID, username)
+}
+
+// checkNotLinked returns utils.ErrRepoAlreadyLinked when a project the user can view
+// already links to the repository, so one repository is imported once.
+//
+// Projects the user cannot view are left out, the error must not reveal them.
+func (s *projectImportService) checkNotLinked(username, link string) error {
+	repoName, ok := repoProvider.GitHubRepoName(link)
+	if !ok {
+		return nil
+	}
+	projects, err := s.metadataRepo.FindLinked(repoName)
+	if err != nil {
+		return err
+	}
+	for _, project := range projects {
+		if name, ok := repoProvider.GitHubRepoName(project.GithubLink); !ok || name != repoName {
+			continue
+		}
+		_, err := s.projectService.GetProject(project.ID, username)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		return utils.ErrRepoAlreadyLinked
+	}
+	return nil
+}
+
+// importedProject builds the draft to create from what the provider reported.
+func importedProject(url string, fetched *provider.RepoMetadata, readme string) dto.ProjectCreation {
+	draft := dto.ProjectCreation{
+		Title:       fetched.Name,
+		Description: fetched.Description,
+		GithubLink:  url,
+		Draft:       true,
+	}
+	if fetched.URL != "" {
+		draft.GithubLink = fetched.URL
+	}
+	if strings.TrimSpace(readme) != "" {
+		draft.Description = readme
+	}
+	if fetched.Homepage != "" {
+		homepage := fetched.Homepage
+		draft.LiveURL = &homepage
+	}
+	if len(fetched.Topics) > 0 {
+		tags := append([]string(nil), fetched.Topics...)
+		draft.Tags = &tags
+	}
+	technologies := fetched.Languages
+	if len(technologies) == 0 && fetched.Language != "" {
+		technologies = []string{fetched.Language}
+	}
+	if len(technologies) > 0 {
+		technologies = append([]string(nil), technologies...)
+		draft.Technologies = &technologies
+	}
+	return draft
+}
@@ -0,0 +1,187 @@
+package service
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	model "github.com/aruncs31s/esdcmodels"
+	"github.com/aruncs31s/esdcprojectmodule/dto"
+	"github.com/aruncs31s/esdcprojectmodule/interfaces/service"
+	"github.com/aruncs31s/esdcprojectmodule/models"
+	repoProvider "github.com/aruncs31s/esdcprojectmodule/provider"
+	utils "github.com/aruncs31s/esdcprojectmodule/utils"
+	"gorm.io/gorm"
+)
+
+type fakeImportProjectService struct {
+	service.ProjectService
+	created []dto.ProjectCreation
+	// private projects are not found by GetProject.
+	private map[uint]bool
+}
+
+func (s *fakeImportProjectService) CreateProject(user string, project dto.ProjectCreation) (*model.Project, error) {
+	s.created = append(s.created, project)
+	return &model.Project{ID: uint(len(s.created))}, nil
+}
+
+func (s *fakeImportProjectService) GetProject(id uint, user string) (*dto.ProjectResponse, error) {
+	if s.private[id] {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return &dto.ProjectResponse{ID: id}, nil
+}
+
+type fakeImportMetadataRepository struct {
+	linked []model.Project
+	saved  []models.ProjectRepoMetadata
+}
+
+func (r *fakeImportMetadataRepository) Get(uint) (*models.ProjectRepoMetadata, error) {
+	return nil, errors.New("not used")
+}
+
+func (r *fakeImportMetadataRepository) Save(metadata *models.ProjectRepoMetadata) error {
+	r.saved = append(r.saved, *metadata)
+	return nil
+}
+
+func (r *fakeImportMetadataRepository) GetStale(time.Time, int) ([]model.Project, error) {
+	return nil, nil
+}
+
+func (r *fakeImportMetadataRepository) FindLinked(fullName string) ([]model.Project, error) {
+	var projects []model.Project
+	for _, project := range r.linked {
+		if strings.Contains(strings.ToLower(project.GithubLink), fullName) {
+			projects = append(projects, project)
+		}
+	}
+	return projects, nil
+}
+
+// newFakeGitHub serves club/robot, which was renamed from club/old-robot, and club/bare.
+func newFakeGitHub(t *testing.T) *httptest.Server {
+	t.Helper()
+	mux := http.NewServeMux()
+	robot := `{"name":"robot","html_url":"https://github.com/club/robot","description":"Line follower",
+		"homepage":"https://robot.example.com","language":"C++","topics":["robotics","arduino"]}`
+	mux.HandleFunc("GET /repos/club/robot", func(w http.ResponseWriter, r *http.Request) { w.Write([]byte(robot)) })
+	mux.HandleFunc("GET /repos/club/old-robot", func(w http.ResponseWriter, r *http.Request) { w.Write([]byte(robot)) })
+	mux.HandleFunc("GET /repos/club/robot/languages", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"C++":900,"Python":100}`))
+	})
+	mux.HandleFunc("GET /repos/club/old-robot/languages", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"C++":900,"Python":100}`))
+	})
+	mux.HandleFunc("GET /repos/club/robot/readme", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("# Robot\nFollows lines."))
+	})
+	mux.HandleFunc("GET /repos/club/bare", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"name":"bare","html_url":"https://github.com/club/bare","description":"Bare repo","language":"Go"}`))
+	})
+	mux.HandleFunc("GET /repos/club/bare/languages", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{}`))
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestProjectImportServiceImport(t *testing.T) {
+	server := newFakeGitHub(t)
+	projects := &fakeImportProjectService{}
+	metadata := &fakeImportMetadataRepository{}
+	s := NewProjectImportService(projects, metadata, repoProvider.NewGitHubProvider(server.URL, "", server.Client()))
+
+	if _, err := s.Import("alice", "github.com/club/robot"); err != nil {
+		t.Fatal(err)
+	}
+	live := "https://robot.example.com"
+	want := dto.ProjectCreation{
+		Title:        "robot",
+		Description:  "# Robot\nFollows lines.",
+		GithubLink:   "https://github.com/club/robot",
+		LiveURL:      &live,
+		Tags:         &[]string{"robotics", "arduino"},
+		Technologies: &[]string{"C++", "Python"},
+		Draft:        true,
+	}
+	if !reflect.DeepEqual(projects.created[0], want) {
+		t.Fatalf("draft = %+v, want %+v", projects.created[0], want)
+	}
+	if len(metadata.saved) != 1 || metadata.saved[0].ProjectID != 1 || metadata.saved[0].RepoURL != want.GithubLink {
+		t.Fatalf("saved metadata = %+v", metadata.saved)
+	}
+
+	// Without a README or languages the description and language are used.
+	if _, err := s.Import("alice", "https://github.com/club/bare"); err != nil {
+		t.Fatal(err)
+	}
+	bare := projects.created[1]
+	if bare.Description != "Bare repo" || bare.Technologies == nil || !reflect.DeepEqual(*bare.Technologies, []string{"Go"}) || bare.Tags != nil {
+		t.Fatalf("draft = %+v", bare)
+	}
+}
+
+func TestProjectImportServiceErrors(t *testing.T) {
+	server := newFakeGitHub(t)
+	tests := []struct {
+		name   string
+		linked []model.Project
+		url    string
+		want   error
+	}{
+		{"no url", nil, "  ", utils.ErrNoImportURL},
+		{"not github", nil, "https://gitlab.com/club/robot", utils.ErrUnsupportedRepoLink},
+		{"missing repository", nil, "https://github.com/club/missing", utils.ErrRepoNotFound},
+		{
+			"already linked",
+			[]model.Project{{ID: 7, GithubLink: "https://github.com/Club/Robot.git"}},
+			"https://github.com/club/robot",
+			utils.ErrRepoAlreadyLinked,
+		},
+		{
+			"already linked under the new name",
+			[]model.Project{{ID: 7, GithubLink: "github.com/club/robot"}},
+			"https://github.com/club/old-robot",
+			utils.ErrRepoAlreadyLinked,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			projects := &fakeImportProjectService{}
+			metadata := &fakeImportMetadataRepository{linked: tt.linked}
+			s := NewProjectImportService(projects, metadata, repoProvider.NewGitHubProvider(server.URL, "", server.Client()))
+			if _, err := s.Import("alice", tt.url); !errors.Is(err, tt.want) {
+				t.Fatalf("Import() error = %v, want %v", err, tt.want)
+			}
+			if len(projects.created) != 0 || len(metadata.saved) != 0 {
+				t.Fatalf("Import() created %d projects and saved %d metadata", len(projects.created), len(metadata.saved))
+			}
+		})
+	}
+
+	t.Run("similar repository is not a match", func(t *testing.T) {
+		projects := &fakeImportProjectService{}
+		metadata := &fakeImportMetadataRepository{linked: []model.Project{{ID: 7, GithubLink: "https://github.com/club/robot-arm"}}}
+		s := NewProjectImportService(projects, metadata, repoProvider.NewGitHubProvider(server.URL, "", server.Client()))
+		if _, err := s.Import("alice", "https://github.com/club/robot"); err != nil {
+			t.Fatalf("Import() error = %v", err)
+		}
+	})
+
+	t.Run("project the user cannot view is not revealed", func(t *testing.T) {
+		projects := &fakeImportProjectService{private: map[uint]bool{7: true}}
+		metadata := &fakeImportMetadataRepository{linked: []model.Project{{ID: 7, GithubLink: "https://github.com/club/robot"}}}
+		s := NewProjectImportService(projects, metadata, repoProvider.NewGitHubProvider(server.URL, "", server.Client()))
+		if _, err := s.Import("alice", "https://github.com/club/robot"); err != nil {
+			t.Fatalf("Import() error = %v", err)
+		}
+	})
+}
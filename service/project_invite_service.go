@@ -0,0 +1,298 @@
+package service
+
+import (
+	"errors"
+	"strings"
+
+	model "github.com/aruncs31s/esdcmodels"
+	"github.com/aruncs31s/esdcprojectmodule/dto"
+	repository "github.com/aruncs31s/esdcprojectmodule/interfaces/repository"
+	"github.com/aruncs31s/esdcprojectmodule/interfaces/service"
+	"github.com/aruncs31s/esdcprojectmodule/models"
+	utils "github.com/aruncs31s/esdcprojectmodule/utils"
+	userRepo "github.com/aruncs31s/esdcusermodule/repository"
+	"gorm.io/gorm"
+)
+
+type projectInviteService struct {
+	projectRepo repository.ProjectRepository
+	inviteRepo  repository.ProjectInviteRepository
+	userRepo    userRepo.UserRepository
+	authorizer  service.ProjectAuthorizer
+}
+
+func NewProjectInviteService(
+	projectRepo repository.ProjectRepository,
+	inviteRepo repository.ProjectInviteRepository,
+	userRepo userRepo.UserRepository,
+	authorizer service.ProjectAuthorizer,
+) service.ProjectInviteService {
+	return &projectInviteService{
+		projectRepo: projectRepo,
+		inviteRepo:  inviteRepo,
+		userRepo:    userRepo,
+		authorizer:  authorizer,
+	}
+}
+
+func (s *projectInviteService) InviteContributors(username string, projectID uint, usernames []string, role string) (*dto.InviteContributorsResult, error) {
+	if len(usernames) == 0 {
+		return nil, utils.ErrNoUsernames
+	}
+	if role == "" {
+		role = models.RoleEditor
+	}
+	if !models.IsValidRole(role) {
+		return nil, utils.ErrInvalidRole
+	}
+	if role == models.RoleOwner {
+		return nil, utils.ErrOwnerRole
+	}
+	userID, project, err := s.getManagedProject(username, projectID)
+	if err != nil {
+		return nil, err
+	}
+	actorRole, err := s.authorizer.RoleOf(userID, projectID)
+	if err != nil {
+		return nil, err
+	}
+	// Admins that do not contribute can invite with any role.
+	if actorRole != "" && !canGrantRole(actorRole, role) {
+		return nil, utils.ErrActionNotAllowed
+	}
+	invites, notFound, skipped, err := inviteUsers(s.inviteRepo, s.userRepo, project, userID, usernames, role)
+	if err != nil {
+		return nil, err
+	}
+	result := &dto.InviteContributorsResult{
+		Invited:  make([]dto.ProjectInvite, 0, len(invites)),
+		NotFound: notFound,
+		Skipped:  skipped,
+	}
+	for _, invite := range invites {
+		// Reload to get the invitee and inviter details.
+		stored, err := s.inviteRepo.GetByID(invite.ID)
+		if err != nil {
+			return nil, err
+		}
+		result.Invited = append(result.Invited, formatInvite(stored))
+	}
+	return result, nil
+}
+
+func (s *projectInviteService) GetMyInvites(username string) ([]dto.ProjectInvite, error) {
+	userID, err := s.userRepo.FindUserIDByUsername(username)
+	if err != nil {
+		return nil, err
+	}
+	invites, err := s.inviteRepo.GetPendingForUser(userID)
+	if err != nil {
+		return nil, err
+	}
+	return formatInvites(invites), nil
+}
+
+func (s *projectInviteService) GetProjectInvites(username string, projectID uint) ([]dto.ProjectInvite, error) {
+	if _, _, err := s.getManagedProject(username, projectID); err != nil {
+		return nil, err
+	}
+	invites, err := s.inviteRepo.GetByProject(projectID)
+	if err != nil {
+		return nil, err
+	}
+	return formatInvites(invites), nil
+}
+
+func (s *projectInviteService) AcceptInvite(username string, inviteID uint) error {
+	invite, err := s.getInviteForInvitee(username, inviteID)
+	if err != nil {
+		return err
+	}
+	if err := s.inviteRepo.Accept(invite); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return utils.ErrInviteNotPending
+		}
+		return err
+	}
+	return nil
+}
+
+func (s *projectInviteService) DeclineInvite(username string, inviteID uint) error {
+	if _, err := s.getInviteForInvitee(username, inviteID); err != nil {
+		return err
+	}
+	return s.updateInviteStatus(inviteID, models.InviteStatusDeclined)
+}
+
+func (s *projectInviteService) RevokeInvite(username string, projectID, inviteID uint) error {
+	if _, _, err := s.getManagedProject(username, projectID); err != nil {
+		return err
+	}
+	invite, err := s.inviteRepo.GetByID(inviteID)
+	if err != nil || invite.ProjectID != projectID {
+		return utils.ErrInviteNotFound
+	}
+	if !invite.IsPending() {
+		return utils.ErrInviteNotPending
+	}
+	return s.updateInviteStatus(inviteID, models.InviteStatusRevoked)
+}
+
+func (s *projectInviteService) updateInviteStatus(inviteID uint, status string) error {
+	if err := s.inviteRepo.UpdateStatus(inviteID, status); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return utils.ErrInviteNotPending
+		}
+		return err
+	}
+	return nil
+}
+
+func (s *projectInviteService) getInviteForInvitee(username string, inviteID uint) (*models.ProjectInvite, error) {
+	userID, err := s.userRepo.FindUserIDByUsername(username)
+	if err != nil {
+		return nil, err
+	}
+	invite, err := s.inviteRepo.GetByID(inviteID)
+	// Do not reveal invites that belong to someone else.
+	if err != nil || invite.InviteeID != userID {
+		return nil, utils.ErrInviteNotFound
+	}
+	if !invite.IsPending() {
+		return nil, utils.ErrInviteNotPending
+	}
+	return invite, nil
+}
+
+// getManagedProject returns the project if the user can manage its contributors.
+func (s *projectInviteService) getManagedProject(username string, projectID uint) (uint, model.Project, error) {
+	userID, err := s.userRepo.FindUserIDByUsername(username)
+	if err != nil {
+		return 0, model.Project{}, err
+	}
+	if err := s.authorizer.Authorize(userID, projectID, models.ActionManageContributors); err != nil {
+		return 0, model.Project{}, err
+	}
+	project, err := s.projectRepo.GetByIDIncludingPrivate(projectID)
+	if err != nil {
+		return 0, model.Project{}, err
+	}
+	return userID, project, nil
+}
+
+// inviteUsers creates pending invites to the project for the given usernames.
+//
+// It returns the created invites, the usernames that do not exist and the usernames
+// that were skipped because they already contribute or already have a pending invite.
+func inviteUsers(
+	inviteRepo repository.ProjectInviteRepository,
+	userRepo userRepo.UserRepository,
+	project model.Project,
+	inviterID uint,
+	usernames []string,
+	role string,
+) ([]models.ProjectInvite, []string, []string, error) {
+	excluded := map[uint]bool{project.CreatedBy: true}
+	if project.Contributors != nil {
+		for _, contributor := range *project.Contributors {
+			excluded[contributor.ID] = true
+		}
+	}
+	pending, err := inviteRepo.GetPendingInviteeIDs(project.ID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	for _, id := range pending {
+		excluded[id] = true
+	}
+	invitees, notFound, skipped, err := findInvitees(userRepo, usernames, excluded)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	invites := make([]models.ProjectInvite, 0, len(invitees))
+	for _, inviteeID := range invitees {
+		invites = append(invites, models.ProjectInvite{
+			ProjectID: project.ID,
+			InviteeID: inviteeID,
+			InvitedBy: inviterID,
+			Role:      role,
+			Status:    models.InviteStatusPending,
+		})
+	}
+	if err := inviteRepo.Create(invites); err != nil {
+		return nil, nil, nil, err
+	}
+	return invites, notFound, skipped, nil
+}
+
+// findInvitees looks up the users to invite by their usernames.
+//
+// It returns the IDs of the users to invite, the usernames that do not exist and the
+// usernames that were skipped because their user is excluded. Repeated and blank
+// usernames are ignored.
+func findInvitees(userRepo userRepo.UserRepository, usernames []string, excluded map[uint]bool) ([]uint, []string, []string, error) {
+	notFound := make([]string, 0)
+	skipped := make([]string, 0)
+
+	requested := make([]string, 0, len(usernames))
+	seen := map[string]bool{}
+	for _, name := range usernames {
+		name = strings.TrimSpace(name)
+		if name == "" || seen[name] {
+			continue
+		}
+		seen[name] = true
+		requested = append(requested, name)
+	}
+	if len(requested) == 0 {
+		return nil, notFound, skipped, nil
+	}
+
+	users, err := userRepo.FindUsersByUsernames(requested)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	found := map[string]model.User{}
+	for _, user := range *users {
+		found[user.Username] = user
+	}
+
+	invitees := make([]uint, 0)
+	for _, name := range requested {
+		user, ok := found[name]
+		if !ok {
+			notFound = append(notFound, name)
+			continue
+		}
+		if excluded[user.ID] {
+			skipped = append(skipped, name)
+			continue
+		}
+		excluded[user.ID] = true
+		invitees = append(invitees, user.ID)
+	}
+	return invitees, notFound, skipped, nil
+}
+
+func formatInvites(invites []models.ProjectInvite) []dto.ProjectInvite {
+	formatted := make([]dto.ProjectInvite, len(invites))
+	for i := range invites {
+		formatted[i] = formatInvite(&invites[i])
+	}
+	return formatted
+}
+
+func formatInvite(invite *models.ProjectInvite) dto.ProjectInvite {
+	return dto.ProjectInvite{
+		ID:           invite.ID,
+		ProjectID:    invite.ProjectID,
+		ProjectTitle: invite.Project.Title,
+		Invitee:      utils.GetCreatorDetails(invite.Invitee),
+		InvitedBy:    utils.GetCreatorDetails(invite.Inviter),
+		Role:         invite.Role,
+		Status:       invite.Status,
+		CreatedAt:    invite.CreatedAt,
+		RespondedAt:  invite.RespondedAt,
+	}
+}
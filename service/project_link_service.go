@@ -0,0 +1,255 @@
+package service
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	model "github.com/aruncs31s/esdcmodels"
+	"github.com/aruncs31s/esdcprojectmodule/dto"
+	repository "github.com/aruncs31s/esdcprojectmodule/interfaces/repository"
+	"github.com/aruncs31s/esdcprojectmodule/interfaces/service"
+	"github.com/aruncs31s/esdcprojectmodule/models"
+	utils "github.com/aruncs31s/esdcprojectmodule/utils"
+	userRepo "github.com/aruncs31s/esdcusermodule/repository"
+)
+
+const (
+	linkCheckBatchSize = 100
+	// linkCheckWorkers is how many links are checked at once, the checker still
+	// limits the requests to one host.
+	linkCheckWorkers = 8
+	linkCheckTimeout = 15 * time.Second
+)
+
+type projectLinkService struct {
+	projectRepo repository.ProjectRepository
+	linkRepo    repository.ProjectLinkRepository
+	userRepo    userRepo.UserRepository
+	authorizer  service.ProjectAuthorizer
+	checker     *LinkChecker
+	maxAge      time.Duration
+}
+
+// NewProjectLinkService checks the links with checker, links checked longer than
+// maxAge ago are checked again by CheckStale.
+func NewProjectLinkService(
+	projectRepo repository.ProjectRepository,
+	linkRepo repository.ProjectLinkRepository,
+	userRepo userRepo.UserRepository,
+	authorizer service.ProjectAuthorizer,
+	checker *LinkChecker,
+	maxAge time.Duration,
+) service.ProjectLinkService {
+	return &projectLinkService{
+		projectRepo: projectRepo,
+		linkRepo:    linkRepo,
+		userRepo:    userRepo,
+		authorizer:  authorizer,
+		checker:     checker,
+		maxAge:      maxAge,
+	}
+}
+
+func (s *projectLinkService) GetLinks(username string, projectID uint) ([]dto.LinkHealth, error) {
+	userID, err := s.userRepo.FindUserIDByUsername(username)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.authorizer.Authorize(userID, projectID, models.ActionEdit); err != nil {
+		return nil, err
+	}
+	project, err := s.projectRepo.GetByIDIncludingPrivate(projectID)
+	if err != nil {
+		return nil, err
+	}
+	checks, err := s.linkRepo.GetChecks(projectID)
+	if err != nil {
+		return nil, err
+	}
+	links := projectLinks(project)
+	health := make([]dto.LinkHealth, 0, len(checks))
+	for _, check := range checks {
+		// A check of a link the project no longer has would be misleading.
+		if links[check.Kind] == check.URL {
+			health = append(health, formatLinkHealth(check))
+		}
+	}
+	return health, nil
+}
+
+func (s *projectLinkService) GetBrokenLinks(username string, limit, offset int) ([]dto.ProjectBrokenLinks, error) {
+	userID, err := s.userRepo.FindUserIDByUsername(username)
+	if err != nil {
+		return nil, err
+	}
+	checks, err := s.linkRepo.GetBroken(userID, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	return groupBrokenLinks(checks), nil
+}
+
+func (s *projectLinkService) GetReport(username string, limit, offset int) (*dto.LinkReport, error) {
+	user, err := s.userRepo.FindByUsername(username)
+	if err != nil {
+		return nil, err
+	}
+	if user.Role != models.UserRoleAdmin {
+		return nil, utils.ErrAdminOnly
+	}
+	checked, broken, err := s.linkRepo.CountChecks()
+	if err != nil {
+		return nil, err
+	}
+	checks, err := s.linkRepo.GetBroken(0, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	return &dto.LinkReport{
+		CheckedLinks: checked,
+		BrokenLinks:  broken,
+		Projects:     groupBrokenLinks(checks),
+	}, nil
+}
+
+func (s *projectLinkService) CheckStale(now time.Time) (int, error) {
+	checked := 0
+	for {
+		projects, err := s.linkRepo.GetUnchecked(now.Add(-s.maxAge), linkCheckBatchSize)
+		if err != nil {
+			return checked, err
+		}
+		if err := s.checkProjects(projects, now); err != nil {
+			return checked, err
+		}
+		checked += len(projects)
+		if len(projects) < linkCheckBatchSize {
+			return checked, nil
+		}
+	}
+}
+
+type linkJob struct {
+	projectID uint
+	kind      string
+	url       string
+}
+
+// checkProjects checks the links of the projects concurrently and saves the results.
+func (s *projectLinkService) checkProjects(projects []model.Project, now time.Time) error {
+	jobs := make([]linkJob, 0, 2*len(projects))
+	for _, project := range projects {
+		links := projectLinks(project)
+		for _, kind := range []string{models.LinkKindGitHub, models.LinkKindLive} {
+			link := links[kind]
+			if link == "" {
+				if err := s.linkRepo.DeleteCheck(project.ID, kind); err != nil {
+					return err
+				}
+				continue
+			}
+			jobs = append(jobs, linkJob{projectID: project.ID, kind: kind, url: link})
+		}
+	}
+	results := make([]LinkResult, len(jobs))
+	next := make(chan int)
+	var wg sync.WaitGroup
+	for range min(linkCheckWorkers, len(jobs)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range next {
+				ctx, cancel := context.WithTimeout(context.Background(), linkCheckTimeout)
+				results[i] = s.checker.Check(ctx, jobs[i].url)
+				cancel()
+			}
+		}()
+	}
+	for i := range jobs {
+		next <- i
+	}
+	close(next)
+	wg.Wait()
+
+	for i, job := range jobs {
+		if err := s.saveResult(job, results[i], now); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *projectLinkService) saveResult(job linkJob, result LinkResult, now time.Time) error {
+	check := &models.ProjectLinkCheck{
+		ProjectID:  job.projectID,
+		Kind:       job.kind,
+		URL:        job.url,
+		StatusCode: result.StatusCode,
+		LatencyMs:  result.Latency.Milliseconds(),
+		Healthy:    result.Healthy(),
+		CheckedAt:  now,
+	}
+	if result.Err != nil {
+		check.Error = result.Err.Error()
+	}
+	if !check.Healthy {
+		// A link that keeps failing keeps the time it first failed.
+		check.FailingSince = &now
+		previous, err := s.linkRepo.GetChecks(job.projectID)
+		if err != nil {
+			return err
+		}
+		for _, p := range previous {
+			if p.Kind == job.kind && p.URL == job.url && p.FailingSince != nil {
+				check.FailingSince = p.FailingSince
+			}
+		}
+	}
+	return s.linkRepo.SaveCheck(check)
+}
+
+// projectLinks returns the links of the project that are checked, by kind.
+func projectLinks(project model.Project) map[string]string {
+	links := map[string]string{
+		models.LinkKindGitHub: project.GithubLink,
+	}
+	if project.LiveURL != nil {
+		links[models.LinkKindLive] = *project.LiveURL
+	}
+	return links
+}
+
+// groupBrokenLinks groups the checks by project, keeping the order of the first check of each.
+func groupBrokenLinks(checks []models.ProjectLinkCheck) []dto.ProjectBrokenLinks {
+	grouped := make([]dto.ProjectBrokenLinks, 0)
+	index := map[uint]int{}
+	for _, check := range checks {
+		i, ok := index[check.ProjectID]
+		if !ok {
+			i = len(grouped)
+			index[check.ProjectID] = i
+			grouped = append(grouped, dto.ProjectBrokenLinks{
+				ProjectID: check.ProjectID,
+				Title:     check.Project.Title,
+				CreatedBy: check.Project.CreatedBy,
+				Links:     make([]dto.LinkHealth, 0, 1),
+			})
+		}
+		grouped[i].Links = append(grouped[i].Links, formatLinkHealth(check))
+	}
+	return grouped
+}
+
+func formatLinkHealth(check models.ProjectLinkCheck) dto.LinkHealth {
+	return dto.LinkHealth{
+		Kind:         check.Kind,
+		URL:          check.URL,
+		Healthy:      check.Healthy,
+		StatusCode:   check.StatusCode,
+		Error:        check.Error,
+		LatencyMs:    check.LatencyMs,
+		CheckedAt:    check.CheckedAt,
+		FailingSince: check.FailingSince,
+	}
+}
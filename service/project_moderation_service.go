@@ -0,0 +1,304 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	model "github.com/aruncs31s/esdcmodels"
+	"github.com/aruncs31s/esdcprojectmodule/dto"
+	repository "github.com/aruncs31s/esdcprojectmodule/interfaces/repository"
+	"github.com/aruncs31s/esdcprojectmodule/interfaces/service"
+	"github.com/aruncs31s/esdcprojectmodule/models"
+	utils "github.com/aruncs31s/esdcprojectmodule/utils"
+	userRepo "github.com/aruncs31s/esdcusermodule/repository"
+	"gorm.io/gorm"
+)
+
+type projectModerationService struct {
+	projectRepo    repository.ProjectRepository
+	moderationRepo repository.ProjectModerationRepository
+	userRepo       userRepo.UserRepository
+	adminService   service.AdminProjectService
+	// auditService records the projects hidden by reports, the request that hid
+	// them is audited as the report of its user.
+	auditService service.ProjectAuditService
+	// autoHideReports is the number of distinct reporters that hides a project, 0 never hides.
+	autoHideReports int
+}
+
+func NewProjectModerationService(
+	projectRepo repository.ProjectRepository,
+	moderationRepo repository.ProjectModerationRepository,
+	userRepo userRepo.UserRepository,
+	adminService service.AdminProjectService,
+	auditService service.ProjectAuditService,
+	autoHideReports int,
+) service.ProjectModerationService {
+	return &projectModerationService{
+		projectRepo:     projectRepo,
+		moderationRepo:  moderationRepo,
+		userRepo:        userRepo,
+		adminService:    adminService,
+		auditService:    auditService,
+		autoHideReports: autoHideReports,
+	}
+}
+
+func (s *projectModerationService) ReportProject(username string, projectID uint, request dto.ProjectReportRequest) (*dto.ProjectReport, error) {
+	userID, err := s.userRepo.FindUserIDByUsername(username)
+	if err != nil {
+		return nil, err
+	}
+	// Only listed projects can be reported, the rest is not visible to the reporter anyway.
+	project, err := s.projectRepo.GetByID(projectID)
+	if err != nil {
+		return nil, err
+	}
+	if project.CreatedBy == userID {
+		return nil, utils.ErrReportOwnProject
+	}
+	if !models.IsReportReason(request.Reason) {
+		return nil, utils.ErrInvalidReason
+	}
+	reported, err := s.moderationRepo.HasOpenReport(projectID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if reported {
+		return nil, utils.ErrAlreadyReported
+	}
+	report := &models.ProjectReport{
+		ProjectID:  projectID,
+		ReporterID: userID,
+		Reason:     request.Reason,
+		Details:    strings.TrimSpace(request.Details),
+		Status:     models.ReportStatusOpen,
+	}
+	reporters, err := s.moderationRepo.CreateReport(report)
+	if err != nil {
+		return nil, err
+	}
+	if s.autoHideReports > 0 && reporters >= s.autoHideReports {
+		decision := &models.ProjectModerationDecision{
+			ProjectID:      project.ID,
+			ProjectTitle:   project.Title,
+			OwnerID:        project.CreatedBy,
+			Action:         models.ModerationHide,
+			Note:           fmt.Sprintf("Hidden automatically after %d reports.", reporters),
+			PreviousStatus: project.Status,
+		}
+		before, err := s.auditService.Snapshot(project.ID)
+		if err != nil {
+			log.Printf("Error taking the audit snapshot of project %d: %v", project.ID, err)
+		}
+		if err := s.moderationRepo.Decide(decision, models.StatusHidden, moderationNotification(decision)); err != nil {
+			return nil, err
+		}
+		if err := s.auditService.RecordSystem(models.AuditProjectAutoHide, project.ID, before); err != nil {
+			log.Printf("Error recording the automatic hide of project %d: %v", project.ID, err)
+		}
+	}
+	formatted := formatReport(*report, nil)
+	return &formatted, nil
+}
+
+func (s *projectModerationService) GetQueue(username string, limit, offset int) ([]dto.ModerationQueueEntry, error) {
+	if _, err := getAdminID(s.userRepo, username); err != nil {
+		return nil, err
+	}
+	summaries, err := s.moderationRepo.GetQueue(limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	projectIDs := make([]uint, len(summaries))
+	for i, summary := range summaries {
+		projectIDs[i] = summary.ProjectID
+	}
+	reports, err := s.moderationRepo.GetOpenReports(projectIDs)
+	if err != nil {
+		return nil, err
+	}
+	entries := make([]dto.ModerationQueueEntry, 0, len(summaries))
+	for _, summary := range summaries {
+		project, err := s.projectRepo.GetByIDIncludingPrivate(summary.ProjectID)
+		if err != nil {
+			return nil, err
+		}
+		entry := dto.ModerationQueueEntry{
+			ProjectID: project.ID,
+			Title:     project.Title,
+			Owner:     utils.GetCreatorDetails(project.Creator),
+			Status:    project.Status,
+			Reports:   summary.Reports,
+			Reasons:   make(map[string]int),
+		}
+		// Reports are newest first, so the first one seen is the last reported.
+		for _, report := range reports {
+			if report.ProjectID != project.ID {
+				continue
+			}
+			if entry.LastReportedAt.IsZero() {
+				entry.LastReportedAt = report.CreatedAt
+			}
+			entry.Reasons[report.Reason]++
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+func (s *projectModerationService) GetHistory(username string, projectID uint) (*dto.ProjectModerationHistory, error) {
+	if _, err := getAdminID(s.userRepo, username); err != nil {
+		return nil, err
+	}
+	reports, err := s.moderationRepo.GetReports(projectID)
+	if err != nil {
+		return nil, err
+	}
+	decisions, err := s.moderationRepo.GetDecisions(projectID)
+	if err != nil {
+		return nil, err
+	}
+	history := &dto.ProjectModerationHistory{
+		Reports:   make([]dto.ProjectReport, 0, len(reports)),
+		Decisions: make([]dto.ModerationDecision, 0, len(decisions)),
+	}
+	for _, report := range reports {
+		reporter := utils.GetCreatorDetails(report.Reporter)
+		history.Reports = append(history.Reports, formatReport(report, &reporter))
+	}
+	for _, decision := range decisions {
+		moderator := ""
+		if decision.Moderator != nil {
+			moderator = decision.Moderator.Username
+		}
+		history.Decisions = append(history.Decisions, formatDecision(decision, moderator))
+	}
+	return history, nil
+}
+
+func (s *projectModerationService) Decide(username string, projectID uint, request dto.ModerationDecisionRequest) (*dto.ModerationDecision, error) {
+	adminID, err := getAdminID(s.userRepo, username)
+	if err != nil {
+		return nil, err
+	}
+	project, err := s.projectRepo.GetByIDIncludingPrivate(projectID)
+	if err != nil {
+		return nil, err
+	}
+	decision := &models.ProjectModerationDecision{
+		ProjectID:      project.ID,
+		ProjectTitle:   project.Title,
+		OwnerID:        project.CreatedBy,
+		Action:         request.Action,
+		Note:           strings.TrimSpace(request.Note),
+		DecidedBy:      &adminID,
+		PreviousStatus: project.Status,
+	}
+	status := ""
+	switch request.Action {
+	case models.ModerationHide:
+		status = models.StatusHidden
+		if project.Status == models.StatusHidden {
+			// Confirming an automatic hide, keep the status to restore to.
+			decision.PreviousStatus, err = s.statusBeforeHide(projectID)
+			if err != nil {
+				return nil, err
+			}
+		}
+	case models.ModerationWarn, models.ModerationDismiss:
+		if project.Status == models.StatusHidden {
+			status, err = s.statusBeforeHide(projectID)
+			if err != nil {
+				return nil, err
+			}
+		}
+	case models.ModerationRemove:
+	default:
+		return nil, utils.ErrInvalidModerationAction
+	}
+	// The files of the project can't be removed in a transaction, so the decision is
+	// only recorded once the project is gone.
+	if decision.Action == models.ModerationRemove {
+		if err := s.adminService.DeleteProject(username, projectID); err != nil {
+			return nil, err
+		}
+	}
+	if err := s.moderationRepo.Decide(decision, status, moderationNotification(decision)); err != nil {
+		return nil, err
+	}
+	formatted := formatDecision(*decision, username)
+	return &formatted, nil
+}
+
+// statusBeforeHide returns the status a hidden project goes back to when it is restored.
+func (s *projectModerationService) statusBeforeHide(projectID uint) (string, error) {
+	decision, err := s.moderationRepo.GetLastHide(projectID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		// Hidden by changing the status directly.
+		return models.StatusActive, nil
+	}
+	if err != nil {
+		return "", err
+	}
+	if decision.PreviousStatus == "" || decision.PreviousStatus == models.StatusHidden {
+		return models.StatusActive, nil
+	}
+	return decision.PreviousStatus, nil
+}
+
+// moderationNotification tells the owner of the project about the decision.
+func moderationNotification(decision *models.ProjectModerationDecision) *model.Notification {
+	var title, message string
+	switch {
+	case decision.IsAutomatic():
+		title = "Your project is hidden pending review"
+		message = fmt.Sprintf("%q was reported by several users and is hidden until a moderator reviews it.", decision.ProjectTitle)
+	case decision.Action == models.ModerationHide:
+		title = "Your project was hidden"
+		message = fmt.Sprintf("%q was hidden by a moderator and is only visible to you and its contributors.", decision.ProjectTitle)
+	case decision.Action == models.ModerationWarn:
+		title = "Warning about your project"
+		message = fmt.Sprintf("A moderator reviewed the reports about %q and issued a warning.", decision.ProjectTitle)
+	case decision.Action == models.ModerationRemove:
+		title = "Your project was removed"
+		message = fmt.Sprintf("%q was removed by a moderator.", decision.ProjectTitle)
+	default:
+		title = "Reports about your project were dismissed"
+		message = fmt.Sprintf("A moderator reviewed the reports about %q and took no action.", decision.ProjectTitle)
+	}
+	if decision.Note != "" && !decision.IsAutomatic() {
+		message += "\n\n" + decision.Note
+	}
+	return &model.Notification{
+		UserID:  decision.OwnerID,
+		Title:   title,
+		Message: message,
+	}
+}
+
+func formatReport(report models.ProjectReport, reporter *dto.Contributor) dto.ProjectReport {
+	return dto.ProjectReport{
+		ID:        report.ID,
+		ProjectID: report.ProjectID,
+		Reporter:  reporter,
+		Reason:    report.Reason,
+		Details:   report.Details,
+		Status:    report.Status,
+		CreatedAt: report.CreatedAt,
+	}
+}
+
+func formatDecision(decision models.ProjectModerationDecision, moderator string) dto.ModerationDecision {
+	return dto.ModerationDecision{
+		ID:        decision.ID,
+		ProjectID: decision.ProjectID,
+		Action:    decision.Action,
+		Note:      decision.Note,
+		DecidedBy: moderator,
+		Automatic: decision.IsAutomatic(),
+		CreatedAt: decision.CreatedAt,
+	}
+}
@@ -0,0 +1,88 @@
+package service
+
+import (
+	"errors"
+	"testing"
+
+	model "github.com/aruncs31s/esdcmodels"
+	"github.com/aruncs31s/esdcprojectmodule/dto"
+	"github.com/aruncs31s/esdcprojectmodule/interfaces/service"
+	"github.com/aruncs31s/esdcprojectmodule/models"
+	"github.com/aruncs31s/esdcprojectmodule/repository"
+	userRepo "github.com/aruncs31s/esdcusermodule/repository"
+	"gorm.io/gorm"
+)
+
+var errDeleteFailed = errors.New("delete failed")
+
+type fakeModerationAdminService struct {
+	service.AdminProjectService
+	deleted []uint
+}
+
+func (s *fakeModerationAdminService) DeleteProject(username string, projectID uint) error {
+	s.deleted = append(s.deleted, projectID)
+	return errDeleteFailed
+}
+
+// newModerationTestService returns the service with an active project of alice
+// and the admin carol.
+func newModerationTestService(t *testing.T) (*projectModerationService, *fakeModerationAdminService, *gorm.DB, uint) {
+	t.Helper()
+	db := newCreateTestDB(t)
+	if err := db.AutoMigrate(&model.Notification{}); err != nil {
+		t.Fatal(err)
+	}
+	admin := model.User{ID: 3, Name: "Carol", Username: "carol", Email: "carol@example.com", Password: "x", Role: models.UserRoleAdmin}
+	if err := db.Create(&admin).Error; err != nil {
+		t.Fatal(err)
+	}
+	project := model.Project{Title: "Rover", CreatedBy: 1, Status: models.StatusActive}
+	if err := db.Create(&project).Error; err != nil {
+		t.Fatal(err)
+	}
+	adminService := &fakeModerationAdminService{}
+	s := &projectModerationService{
+		projectRepo:    repository.NewProjectRepository(db),
+		moderationRepo: repository.NewProjectModerationRepository(db),
+		userRepo:       userRepo.NewUserRepository(db),
+		adminService:   adminService,
+	}
+	return s, adminService, db, project.ID
+}
+
+func TestModerationDecideWritesRevision(t *testing.T) {
+	s, _, db, projectID := newModerationTestService(t)
+
+	if _, err := s.Decide("carol", projectID, dto.ModerationDecisionRequest{Action: models.ModerationHide}); err != nil {
+		t.Fatal(err)
+	}
+	var revision models.ProjectRevision
+	if err := db.Where("project_id = ?", projectID).Order("revision DESC").First(&revision).Error; err != nil {
+		t.Fatal(err)
+	}
+	if revision.Status != models.StatusHidden || revision.ModifiedBy != 3 {
+		t.Errorf("revision = status %q by %d, want %q by 3", revision.Status, revision.ModifiedBy, models.StatusHidden)
+	}
+}
+
+func TestModerationRemoveRecordsNothingWhenDeleteFails(t *testing.T) {
+	s, adminService, db, projectID := newModerationTestService(t)
+
+	_, err := s.Decide("carol", projectID, dto.ModerationDecisionRequest{Action: models.ModerationRemove})
+	if !errors.Is(err, errDeleteFailed) {
+		t.Fatalf("Decide() error = %v, want %v", err, errDeleteFailed)
+	}
+	if len(adminService.deleted) != 1 || adminService.deleted[0] != projectID {
+		t.Errorf("deleted = %v, want [%d]", adminService.deleted, projectID)
+	}
+	for _, table := range []interface{}{&models.ProjectModerationDecision{}, &model.Notification{}} {
+		var count int64
+		if err := db.Model(table).Count(&count).Error; err != nil {
+			t.Fatal(err)
+		}
+		if count != 0 {
+			t.Errorf("%T has %d rows after the failed remove, want 0", table, count)
+		}
+	}
+}
@@ -0,0 +1,127 @@
+package service
+
+import (
+	"errors"
+
+	"github.com/aruncs31s/esdcprojectmodule/dto"
+	repository "github.com/aruncs31s/esdcprojectmodule/interfaces/repository"
+	"github.com/aruncs31s/esdcprojectmodule/interfaces/service"
+	utils "github.com/aruncs31s/esdcprojectmodule/utils"
+	userRepo "github.com/aruncs31s/esdcusermodule/repository"
+	"gorm.io/gorm"
+)
+
+type projectPinService struct {
+	projectRepo repository.ProjectRepository
+	pinRepo     repository.ProjectPinRepository
+	forkRepo    repository.ProjectForkRepository
+	userRepo    userRepo.UserRepository
+	// maxPins is the number of projects a user can pin.
+	maxPins int
+}
+
+func NewProjectPinService(
+	projectRepo repository.ProjectRepository,
+	pinRepo repository.ProjectPinRepository,
+	forkRepo repository.ProjectForkRepository,
+	userRepo userRepo.UserRepository,
+	maxPins int,
+) service.ProjectPinService {
+	return &projectPinService{
+		projectRepo: projectRepo,
+		pinRepo:     pinRepo,
+		forkRepo:    forkRepo,
+		userRepo:    userRepo,
+		maxPins:     maxPins,
+	}
+}
+
+func (s *projectPinService) GetPinned(username string) ([]dto.ProjectResponseForPublic, error) {
+	userID, err := s.userRepo.FindUserIDByUsername(username)
+	if err != nil {
+		return nil, err
+	}
+	return s.getPinned(userID)
+}
+
+func (s *projectPinService) PinProject(username string, projectID uint) ([]dto.ProjectResponseForPublic, error) {
+	userID, err := s.userRepo.FindUserIDByUsername(username)
+	if err != nil {
+		return nil, err
+	}
+	project, err := s.projectRepo.GetByIDIncludingPrivate(projectID)
+	if err != nil {
+		return nil, err
+	}
+	if project.CreatedBy != userID {
+		return nil, utils.ErrPinNotOwner
+	}
+	pinned, err := s.pinRepo.GetPinned(userID)
+	if err != nil {
+		return nil, err
+	}
+	for _, pinnedProject := range pinned {
+		if pinnedProject.ID == projectID {
+			return s.getPinned(userID)
+		}
+	}
+	if len(pinned) >= s.maxPins {
+		return nil, utils.ErrTooManyPins
+	}
+	if err := s.pinRepo.Pin(userID, projectID); err != nil {
+		return nil, err
+	}
+	return s.getPinned(userID)
+}
+
+func (s *projectPinService) UnpinProject(username string, projectID uint) error {
+	userID, err := s.userRepo.FindUserIDByUsername(username)
+	if err != nil {
+		return err
+	}
+	err = s.pinRepo.Unpin(userID, projectID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return utils.ErrPinNotFound
+	}
+	return err
+}
+
+func (s *projectPinService) ReorderPins(username string, projectIDs []uint) ([]dto.ProjectResponseForPublic, error) {
+	userID, err := s.userRepo.FindUserIDByUsername(username)
+	if err != nil {
+		return nil, err
+	}
+	pinned, err := s.pinRepo.GetPinned(userID)
+	if err != nil {
+		return nil, err
+	}
+	remaining := make(map[uint]bool, len(pinned))
+	for _, project := range pinned {
+		remaining[project.ID] = true
+	}
+	if len(projectIDs) != len(remaining) {
+		return nil, utils.ErrInvalidPinOrder
+	}
+	for _, projectID := range projectIDs {
+		if !remaining[projectID] {
+			return nil, utils.ErrInvalidPinOrder
+		}
+		delete(remaining, projectID)
+	}
+	if err := s.pinRepo.Reorder(userID, projectIDs); err != nil {
+		return nil, err
+	}
+	return s.getPinned(userID)
+}
+
+func (s *projectPinService) getPinned(userID uint) ([]dto.ProjectResponseForPublic, error) {
+	projects, err := s.pinRepo.GetPinned(userID)
+	if err != nil {
+		return nil, err
+	}
+	pinned := getFormatedProjects(&projects)
+	if err := setForkCounts(s.forkRepo, pinned); err != nil {
+		return nil, err
+	}
+	return pinned, nil
+}
@@ -0,0 +1,42 @@
+package service
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/aruncs31s/esdcprojectmodule/imaging"
+	repository "github.com/aruncs31s/esdcprojectmodule/interfaces/repository"
+	"github.com/aruncs31s/esdcprojectmodule/interfaces/service"
+	"gorm.io/gorm"
+)
+
+// Sizes of the generated placeholders, covers use the 16:9 shape of the uploaded covers.
+const (
+	CoverWidth   = 800
+	CoverHeight  = 450
+	AvatarWidth  = 256
+	AvatarHeight = 256
+)
+
+type projectPlaceholderService struct {
+	publicProjectRepo repository.PublicProjectRepository
+}
+
+func NewProjectPlaceholderService(publicProjectRepo repository.PublicProjectRepository) service.ProjectPlaceholderService {
+	return &projectPlaceholderService{
+		publicProjectRepo: publicProjectRepo,
+	}
+}
+
+func (s *projectPlaceholderService) Cover(projectID uint) (imaging.Identicon, error) {
+	category, err := s.publicProjectRepo.GetCategory(projectID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return imaging.Identicon{}, err
+	}
+	// The seed is prefixed so a project and a user with the same name do not share artwork.
+	return imaging.NewIdenticon("project:"+strconv.FormatUint(uint64(projectID), 10), category), nil
+}
+
+func (s *projectPlaceholderService) Avatar(username string) imaging.Identicon {
+	return imaging.NewIdenticon("user:"+username, "")
+}
@@ -0,0 +1,132 @@
+package service
+
+import (
+	"errors"
+	"log"
+	"time"
+
+	"github.com/aruncs31s/esdcprojectmodule/dto"
+	repository "github.com/aruncs31s/esdcprojectmodule/interfaces/repository"
+	"github.com/aruncs31s/esdcprojectmodule/interfaces/service"
+	"github.com/aruncs31s/esdcprojectmodule/models"
+	utils "github.com/aruncs31s/esdcprojectmodule/utils"
+	userRepo "github.com/aruncs31s/esdcusermodule/repository"
+)
+
+// publishBatchSize is the number of due schedules handled per query.
+const publishBatchSize = 100
+
+type projectPublishService struct {
+	projectRepo repository.ProjectRepository
+	publishRepo repository.ProjectPublishRepository
+	userRepo    userRepo.UserRepository
+	authorizer  service.ProjectAuthorizer
+	// auditService records the drafts published on schedule, there is no request to audit them.
+	auditService service.ProjectAuditService
+}
+
+func NewProjectPublishService(
+	projectRepo repository.ProjectRepository,
+	publishRepo repository.ProjectPublishRepository,
+	userRepo userRepo.UserRepository,
+	authorizer service.ProjectAuthorizer,
+	auditService service.ProjectAuditService,
+) service.ProjectPublishService {
+	return &projectPublishService{
+		projectRepo:  projectRepo,
+		publishRepo:  publishRepo,
+		userRepo:     userRepo,
+		authorizer:   authorizer,
+		auditService: auditService,
+	}
+}
+
+func (s *projectPublishService) Publish(username string, projectID uint, publishAt *time.Time) (*dto.ProjectPublication, error) {
+	userID, err := s.getDraftManager(username, projectID)
+	if err != nil {
+		return nil, err
+	}
+	if publishAt != nil {
+		if !publishAt.After(time.Now()) {
+			return nil, utils.ErrPublishInPast
+		}
+		schedule := &models.ProjectSchedule{
+			ProjectID:   projectID,
+			PublishAt:   *publishAt,
+			ScheduledBy: userID,
+		}
+		if err := s.publishRepo.Schedule(schedule); err != nil {
+			return nil, err
+		}
+		return &dto.ProjectPublication{
+			ProjectID: projectID,
+			Status:    models.StatusDraft,
+			PublishAt: &schedule.PublishAt,
+		}, nil
+	}
+	if err := s.publishRepo.Publish(projectID, userID); err != nil {
+		return nil, err
+	}
+	return &dto.ProjectPublication{
+		ProjectID: projectID,
+		Status:    models.StatusActive,
+	}, nil
+}
+
+func (s *projectPublishService) CancelSchedule(username string, projectID uint) error {
+	if _, err := s.getDraftManager(username, projectID); err != nil {
+		return err
+	}
+	return s.publishRepo.CancelSchedule(projectID)
+}
+
+func (s *projectPublishService) PublishDue(now time.Time) (int, error) {
+	published := 0
+	for {
+		schedules, err := s.publishRepo.GetDue(now, publishBatchSize)
+		if err != nil {
+			return published, err
+		}
+		for _, schedule := range schedules {
+			before, err := s.auditService.Snapshot(schedule.ProjectID)
+			if err != nil {
+				log.Printf("Error taking the audit snapshot of project %d: %v", schedule.ProjectID, err)
+			}
+			err = s.publishRepo.Publish(schedule.ProjectID, schedule.ScheduledBy)
+			if errors.Is(err, utils.ErrNotDraft) {
+				// Published or moved out of draft some other way, the schedule is stale.
+				err = s.publishRepo.CancelSchedule(schedule.ProjectID)
+			} else if err == nil {
+				published++
+				if err := s.auditService.RecordSystem(models.AuditProjectAutoPublish, schedule.ProjectID, before); err != nil {
+					log.Printf("Error recording the scheduled publish of project %d: %v", schedule.ProjectID, err)
+				}
+			}
+			if err != nil {
+				return published, err
+			}
+		}
+		if len(schedules) < publishBatchSize {
+			return published, nil
+		}
+	}
+}
+
+// getDraftManager checks that the project is a draft the user may publish and returns the user ID.
+func (s *projectPublishService) getDraftManager(username string, projectID uint) (uint, error) {
+	userID, err := s.userRepo.FindUserIDByUsername(username)
+	if err != nil {
+		return 0, err
+	}
+	if err := s.authorizer.Authorize(userID, projectID, models.ActionChangeVisibility); err != nil {
+		return 0, err
+	}
+	project, err := s.projectRepo.GetByIDIncludingPrivate(projectID)
+	if err != nil {
+		return 0, err
+	}
+	if project.Status != models.StatusDraft {
+		return 0, utils.ErrNotDraft
+	}
+	return userID, nil
+}
@@ -0,0 +1,121 @@
+package service
+
+import (
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/aruncs31s/esdcprojectmodule/dto"
+	repository "github.com/aruncs31s/esdcprojectmodule/interfaces/repository"
+	"github.com/aruncs31s/esdcprojectmodule/interfaces/service"
+	"github.com/aruncs31s/esdcprojectmodule/models"
+	utils "github.com/aruncs31s/esdcprojectmodule/utils"
+	userRepo "github.com/aruncs31s/esdcusermodule/repository"
+)
+
+type projectReleaseService struct {
+	releaseRepo repository.ProjectReleaseRepository
+	userRepo    userRepo.UserRepository
+	authorizer  service.ProjectAuthorizer
+}
+
+func NewProjectReleaseService(
+	releaseRepo repository.ProjectReleaseRepository,
+	userRepo userRepo.UserRepository,
+	authorizer service.ProjectAuthorizer,
+) service.ProjectReleaseService {
+	return &projectReleaseService{
+		releaseRepo: releaseRepo,
+		userRepo:    userRepo,
+		authorizer:  authorizer,
+	}
+}
+
+func (s *projectReleaseService) GetReleases(username string, projectID uint, limit, offset int) ([]dto.ProjectRelease, error) {
+	userID, err := s.userRepo.FindUserIDByUsername(username)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.authorizer.Authorize(userID, projectID, models.ActionView); err != nil {
+		return nil, err
+	}
+	releases, err := s.releaseRepo.GetReleases(projectID, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	formatted := make([]dto.ProjectRelease, len(releases))
+	for i := range releases {
+		formatted[i] = formatRelease(&releases[i])
+	}
+	return formatted, nil
+}
+
+func (s *projectReleaseService) CreateRelease(username string, projectID uint, release dto.ReleaseCreation) (*dto.ProjectRelease, error) {
+	userID, err := s.userRepo.FindUserIDByUsername(username)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.authorizer.Authorize(userID, projectID, models.ActionPublishRelease); err != nil {
+		return nil, err
+	}
+	version, err := utils.ParseSemver(release.Version)
+	if err != nil {
+		return nil, utils.ErrInvalidVersion
+	}
+	artifacts, err := getReleaseArtifacts(release.Artifacts)
+	if err != nil {
+		return nil, err
+	}
+	releasedAt := time.Now()
+	if release.ReleasedAt != nil {
+		releasedAt = *release.ReleasedAt
+	}
+	newRelease := &models.ProjectRelease{
+		ProjectID:  projectID,
+		Version:    version.String(),
+		Notes:      release.Notes,
+		Artifacts:  artifacts,
+		ReleasedAt: releasedAt,
+		CreatedBy:  userID,
+	}
+	if err := s.releaseRepo.Create(newRelease); err != nil {
+		return nil, err
+	}
+	created, err := s.releaseRepo.GetLatest(projectID)
+	if err != nil {
+		return nil, err
+	}
+	formatted := formatRelease(created)
+	return &formatted, nil
+}
+
+// getReleaseArtifacts validates the artifact links of a release.
+func getReleaseArtifacts(artifacts []dto.ReleaseArtifact) ([]models.ReleaseArtifact, error) {
+	validated := make([]models.ReleaseArtifact, 0, len(artifacts))
+	for _, artifact := range artifacts {
+		name := strings.TrimSpace(artifact.Name)
+		link, err := url.Parse(strings.TrimSpace(artifact.URL))
+		if name == "" || err != nil || (link.Scheme != "http" && link.Scheme != "https") || link.Host == "" {
+			return nil, utils.ErrInvalidArtifact
+		}
+		validated = append(validated, models.ReleaseArtifact{Name: name, URL: link.String()})
+	}
+	return validated, nil
+}
+
+func formatRelease(release *models.ProjectRelease) dto.ProjectRelease {
+	artifacts := make([]dto.ReleaseArtifact, len(release.Artifacts))
+	for i, artifact := range release.Artifacts {
+		artifacts[i] = dto.ReleaseArtifact{Name: artifact.Name, URL: artifact.URL}
+	}
+	return dto.ProjectRelease{
+		ID:         release.ID,
+		ProjectID:  release.ProjectID,
+		Version:    release.Version,
+		Notes:      release.Notes,
+		Artifacts:  artifacts,
+		ReleasedAt: release.ReleasedAt,
+		CreatedBy:  utils.GetCreatorDetails(release.Creator),
+		CreatedAt:  release.CreatedAt,
+	}
+}
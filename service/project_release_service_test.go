@@ -0,0 +1,47 @@
+package service
+
+import (
+	"errors"
+	"testing"
+
+	model "github.com/aruncs31s/esdcmodels"
+	"github.com/aruncs31s/esdcprojectmodule/models"
+	"github.com/aruncs31s/esdcprojectmodule/repository"
+	utils "github.com/aruncs31s/esdcprojectmodule/utils"
+)
+
+func TestProjectReleaseRepositoryCreateVersion(t *testing.T) {
+	db := newCreateTestDB(t)
+	project := model.Project{Title: "Rover", CreatedBy: 1, Version: "0.2.5"}
+	if err := db.Create(&project).Error; err != nil {
+		t.Fatal(err)
+	}
+	releaseRepo := repository.NewProjectReleaseRepository(db)
+
+	// The first release is checked against the project version, the next ones
+	// against the latest release.
+	steps := []struct {
+		version string
+		want    error
+	}{
+		{"0.1.0", utils.ErrVersionNotIncreased},
+		{"0.2.4", utils.ErrVersionNotIncreased},
+		{"0.2.5", nil},
+		{"0.2.5", utils.ErrVersionNotIncreased},
+		{"v0.3.0", nil},
+		{"0.2.9", utils.ErrVersionNotIncreased},
+		{"0.3", utils.ErrInvalidVersion},
+	}
+	for _, step := range steps {
+		err := releaseRepo.Create(&models.ProjectRelease{ProjectID: project.ID, Version: step.version, CreatedBy: 1})
+		if !errors.Is(err, step.want) {
+			t.Fatalf("Create(%s) error = %v, want %v", step.version, err, step.want)
+		}
+	}
+	if err := db.First(&project, project.ID).Error; err != nil {
+		t.Fatal(err)
+	}
+	if project.Version != "v0.3.0" {
+		t.Errorf("project version = %q, want v0.3.0", project.Version)
+	}
+}
@@ -0,0 +1,251 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	model "github.com/aruncs31s/esdcmodels"
+	"github.com/aruncs31s/esdcprojectmodule/dto"
+	"github.com/aruncs31s/esdcprojectmodule/interfaces/provider"
+	repository "github.com/aruncs31s/esdcprojectmodule/interfaces/repository"
+	"github.com/aruncs31s/esdcprojectmodule/interfaces/service"
+	"github.com/aruncs31s/esdcprojectmodule/models"
+	utils "github.com/aruncs31s/esdcprojectmodule/utils"
+	userRepo "github.com/aruncs31s/esdcusermodule/repository"
+	"gorm.io/gorm"
+)
+
+const (
+	repoSyncBatchSize = 50
+	// repoFetchTimeout bounds one sync, the provider makes more than one request.
+	repoFetchTimeout = 30 * time.Second
+)
+
+type projectRepoMetadataService struct {
+	projectRepo  repository.ProjectRepository
+	metadataRepo repository.ProjectRepoMetadataRepository
+	userRepo     userRepo.UserRepository
+	authorizer   service.ProjectAuthorizer
+	provider     provider.RepoMetadataProvider
+	maxAge       time.Duration
+}
+
+// NewProjectRepoMetadataService syncs repositories with the provider, metadata older
+// than maxAge is synced again by SyncStale.
+func NewProjectRepoMetadataService(
+	projectRepo repository.ProjectRepository,
+	metadataRepo repository.ProjectRepoMetadataRepository,
+	userRepo userRepo.UserRepository,
+	authorizer service.ProjectAuthorizer,
+	provider provider.RepoMetadataProvider,
+	maxAge time.Duration,
+) service.ProjectRepoMetadataService {
+	return &projectRepoMetadataService{
+		projectRepo:  projectRepo,
+		metadataRepo: metadataRepo,
+		userRepo:     userRepo,
+		authorizer:   authorizer,
+		provider:     provider,
+		maxAge:       maxAge,
+	}
+}
+
+func (s *projectRepoMetadataService) GetMetadata(username string, projectID uint) (*dto.RepoMetadata, error) {
+	var project model.Project
+	var err error
+	if username == "" {
+		project, err = s.projectRepo.GetByID(projectID)
+	} else {
+		project, err = s.getProject(username, projectID, models.ActionView)
+	}
+	if err != nil {
+		return nil, err
+	}
+	metadata, err := s.getSynced(project)
+	if err != nil {
+		return nil, err
+	}
+	return formatRepoMetadata(metadata), nil
+}
+
+func (s *projectRepoMetadataService) Sync(username string, projectID uint) (*dto.RepoMetadata, error) {
+	project, err := s.getProject(username, projectID, models.ActionEdit)
+	if err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(project.GithubLink) == "" {
+		return nil, utils.ErrNoRepoLink
+	}
+	metadata, fetchErr, err := s.sync(project, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	// A manual sync reports the failure, the scheduled one only records it.
+	if fetchErr != nil {
+		return nil, fetchErr
+	}
+	return formatRepoMetadata(metadata), nil
+}
+
+func (s *projectRepoMetadataService) GetSuggestions(username string, projectID uint) (*dto.RepoSuggestions, error) {
+	project, err := s.getProject(username, projectID, models.ActionEdit)
+	if err != nil {
+		return nil, err
+	}
+	metadata, err := s.getSynced(project)
+	if err != nil {
+		return nil, err
+	}
+	existingTechnologies := map[string]bool{}
+	if project.Technologies != nil {
+		for _, technology := range *project.Technologies {
+			existingTechnologies[strings.ToLower(technology.Name)] = true
+		}
+	}
+	existingTags := map[string]bool{}
+	if project.Tags != nil {
+		for _, tag := range *project.Tags {
+			existingTags[strings.ToLower(tag.Name)] = true
+		}
+	}
+	languages := metadata.Languages
+	if len(languages) == 0 && metadata.Language != "" {
+		languages = []string{metadata.Language}
+	}
+	return &dto.RepoSuggestions{
+		Technologies: missingNames(languages, existingTechnologies),
+		Tags:         missingNames(metadata.Topics, existingTags),
+	}, nil
+}
+
+func (s *projectRepoMetadataService) SyncStale(now time.Time) (int, error) {
+	synced := 0
+	for {
+		projects, err := s.metadataRepo.GetStale(now.Add(-s.maxAge), repoSyncBatchSize)
+		if err != nil {
+			return synced, err
+		}
+		for _, project := range projects {
+			if _, _, err := s.sync(project, now); err != nil {
+				return synced, err
+			}
+			synced++
+		}
+		if len(projects) < repoSyncBatchSize {
+			return synced, nil
+		}
+	}
+}
+
+// sync fetches and stores the metadata of the project. Failures of the provider are
+// stored on the metadata and returned as fetchErr, so the project is not retried
+// before it is stale again. Rate limits are not stored and returned as err.
+func (s *projectRepoMetadataService) sync(project model.Project, now time.Time) (metadata *models.ProjectRepoMetadata, fetchErr error, err error) {
+	metadata, err = s.metadataRepo.Get(project.ID)
+	if errors.Is(err, gorm.ErrRecordNotFound) || (err == nil && metadata.RepoURL != project.GithubLink) {
+		// Values synced from another link do not belong to this one.
+		metadata, err = &models.ProjectRepoMetadata{ProjectID: project.ID}, nil
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	metadata.Provider = s.provider.Name()
+	metadata.RepoURL = project.GithubLink
+	metadata.SyncedAt = now
+	metadata.SyncError = ""
+
+	var fetched *provider.RepoMetadata
+	if s.provider.Supports(project.GithubLink) {
+		ctx, cancel := context.WithTimeout(context.Background(), repoFetchTimeout)
+		fetched, fetchErr = s.provider.Fetch(ctx, project.GithubLink)
+		cancel()
+	} else {
+		fetchErr = utils.ErrUnsupportedRepoLink
+	}
+	switch {
+	case errors.Is(fetchErr, utils.ErrRepoRateLimited):
+		return nil, nil, fetchErr
+	case fetchErr != nil:
+		metadata.SyncError = fetchErr.Error()
+	default:
+		setRepoMetadata(metadata, fetched)
+	}
+	if err := s.metadataRepo.Save(metadata); err != nil {
+		return nil, nil, err
+	}
+	return metadata, fetchErr, nil
+}
+
+// setRepoMetadata copies the values reported by the provider onto the stored metadata.
+func setRepoMetadata(metadata *models.ProjectRepoMetadata, fetched *provider.RepoMetadata) {
+	metadata.Stars = fetched.Stars
+	metadata.Forks = fetched.Forks
+	metadata.Language = fetched.Language
+	metadata.License = fetched.License
+	metadata.PushedAt = fetched.PushedAt
+	metadata.Topics = fetched.Topics
+	metadata.Languages = fetched.Languages
+}
+
+// getSynced returns the metadata of the current github link of the project.
+func (s *projectRepoMetadataService) getSynced(project model.Project) (*models.ProjectRepoMetadata, error) {
+	metadata, err := s.metadataRepo.Get(project.ID)
+	if errors.Is(err, gorm.ErrRecordNotFound) || (err == nil && metadata.RepoURL != project.GithubLink) {
+		return nil, utils.ErrRepoNotSynced
+	}
+	if err != nil {
+		return nil, err
+	}
+	return metadata, nil
+}
+
+func (s *projectRepoMetadataService) getProject(username string, projectID uint, action models.ProjectAction) (model.Project, error) {
+	userID, err := s.userRepo.FindUserIDByUsername(username)
+	if err != nil {
+		return model.Project{}, err
+	}
+	if err := s.authorizer.Authorize(userID, projectID, action); err != nil {
+		return model.Project{}, err
+	}
+	return s.projectRepo.GetByIDIncludingPrivate(projectID)
+}
+
+func formatRepoMetadata(metadata *models.ProjectRepoMetadata) *dto.RepoMetadata {
+	return &dto.RepoMetadata{
+		ProjectID: metadata.ProjectID,
+		Provider:  metadata.Provider,
+		URL:       metadata.RepoURL,
+		Stars:     metadata.Stars,
+		Forks:     metadata.Forks,
+		Language:  metadata.Language,
+		License:   metadata.License,
+		PushedAt:  metadata.PushedAt,
+		Topics:    emptyIfNil(metadata.Topics),
+		Languages: emptyIfNil(metadata.Languages),
+		SyncedAt:  metadata.SyncedAt,
+		SyncError: metadata.SyncError,
+	}
+}
+
+// missingNames returns the names that are not in existing, compared without case.
+func missingNames(names []string, existing map[string]bool) []string {
+	missing := make([]string, 0)
+	for _, name := range names {
+		key := strings.ToLower(name)
+		if name == "" || existing[key] {
+			continue
+		}
+		existing[key] = true
+		missing = append(missing, name)
+	}
+	return missing
+}
+
+func emptyIfNil(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return values
+}
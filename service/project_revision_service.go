@@ -0,0 +1,195 @@
+package service
+
+import (
+	"errors"
+	"slices"
+
+	"github.com/aruncs31s/esdcprojectmodule/dto"
+	repository "github.com/aruncs31s/esdcprojectmodule/interfaces/repository"
+	"github.com/aruncs31s/esdcprojectmodule/interfaces/service"
+	"github.com/aruncs31s/esdcprojectmodule/models"
+	utils "github.com/aruncs31s/esdcprojectmodule/utils"
+	userRepo "github.com/aruncs31s/esdcusermodule/repository"
+	"gorm.io/gorm"
+)
+
+type projectRevisionService struct {
+	projectRepo  repository.ProjectRepository
+	revisionRepo repository.ProjectRevisionRepository
+	userRepo     userRepo.UserRepository
+	authorizer   service.ProjectAuthorizer
+}
+
+func NewProjectRevisionService(
+	projectRepo repository.ProjectRepository,
+	revisionRepo repository.ProjectRevisionRepository,
+	userRepo userRepo.UserRepository,
+	authorizer service.ProjectAuthorizer,
+) service.ProjectRevisionService {
+	return &projectRevisionService{
+		projectRepo:  projectRepo,
+		revisionRepo: revisionRepo,
+		userRepo:     userRepo,
+		authorizer:   authorizer,
+	}
+}
+
+func (s *projectRevisionService) GetRevisions(username string, projectID uint, limit, offset int) ([]dto.ProjectRevision, error) {
+	if _, err := s.authorize(username, projectID, models.ActionView); err != nil {
+		return nil, err
+	}
+	revisions, err := s.revisionRepo.GetRevisions(projectID, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	formatted := make([]dto.ProjectRevision, len(revisions))
+	for i := range revisions {
+		formatted[i] = formatRevision(&revisions[i])
+	}
+	return formatted, nil
+}
+
+func (s *projectRevisionService) DiffRevisions(username string, projectID uint, from, to int) (*dto.RevisionDiff, error) {
+	if _, err := s.authorize(username, projectID, models.ActionView); err != nil {
+		return nil, err
+	}
+	fromRevision, err := s.getRevision(projectID, from)
+	if err != nil {
+		return nil, err
+	}
+	toRevision, err := s.getRevision(projectID, to)
+	if err != nil {
+		return nil, err
+	}
+	return &dto.RevisionDiff{
+		From:    from,
+		To:      to,
+		Changes: DiffRevisions(*fromRevision, *toRevision),
+	}, nil
+}
+
+func (s *projectRevisionService) RestoreRevision(username string, projectID uint, revision int) (*dto.ProjectResponse, error) {
+	userID, err := s.authorize(username, projectID, models.ActionEdit)
+	if err != nil {
+		return nil, err
+	}
+	rev, err := s.getRevision(projectID, revision)
+	if err != nil {
+		return nil, err
+	}
+	current, err := s.projectRepo.GetByIDIncludingPrivate(projectID)
+	if err != nil {
+		return nil, err
+	}
+	if rev.Visibility != current.Visibility {
+		if err := s.authorizer.Authorize(userID, projectID, models.ActionChangeVisibility); err != nil {
+			return nil, err
+		}
+	}
+	tags, err := getTags(&rev.Tags, s.projectRepo)
+	if err != nil {
+		return nil, err
+	}
+	technologies, err := getTechnologies(&rev.Technologies, s.projectRepo)
+	if err != nil {
+		return nil, err
+	}
+	fields := map[string]interface{}{
+		"title":       rev.Title,
+		"description": rev.Description,
+		"github_link": rev.GithubLink,
+		"live_url":    rev.LiveURL,
+		"status":      rev.Status,
+		"visibility":  rev.Visibility,
+		"cost":        rev.Cost,
+	}
+	// Restoring never publishes or unpublishes, the draft, hidden and trashed states stay as they are.
+	if (rev.Status == models.StatusDraft) != (current.Status == models.StatusDraft) ||
+		models.IsModeratedStatus(rev.Status) || models.IsModeratedStatus(current.Status) {
+		delete(fields, "status")
+	}
+	changes := models.ProjectChanges{
+		Fields:       fields,
+		Tags:         &tags,
+		Technologies: &technologies,
+		ModifiedBy:   userID,
+		RestoredFrom: &rev.Revision,
+	}
+	if err := s.projectRepo.Update(projectID, changes); err != nil {
+		return nil, err
+	}
+	restored, err := s.projectRepo.GetByIDIncludingPrivate(projectID)
+	if err != nil {
+		return nil, err
+	}
+	isLiked, err := s.projectRepo.IsLiked(userID, projectID)
+	if err != nil {
+		return nil, err
+	}
+	return getProjectResponseForPersonal(restored, isLiked), nil
+}
+
+func (s *projectRevisionService) authorize(username string, projectID uint, action models.ProjectAction) (uint, error) {
+	userID, err := s.userRepo.FindUserIDByUsername(username)
+	if err != nil {
+		return 0, err
+	}
+	return userID, s.authorizer.Authorize(userID, projectID, action)
+}
+
+func (s *projectRevisionService) getRevision(projectID uint, revision int) (*models.ProjectRevision, error) {
+	rev, err := s.revisionRepo.GetRevision(projectID, revision)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, utils.ErrRevisionNotFound
+	}
+	return rev, err
+}
+
+// DiffRevisions compares two revisions field by field.
+//
+// Only the fields that differ are returned, in a fixed order.
+func DiffRevisions(from, to models.ProjectRevision) []dto.FieldChange {
+	changes := make([]dto.FieldChange, 0)
+	add := func(field string, a, b interface{}, equal bool) {
+		if !equal {
+			changes = append(changes, dto.FieldChange{Field: field, From: a, To: b})
+		}
+	}
+	add("title", from.Title, to.Title, from.Title == to.Title)
+	add("description", from.Description, to.Description, from.Description == to.Description)
+	add("github_link", from.GithubLink, to.GithubLink, from.GithubLink == to.GithubLink)
+	add("live_url", from.LiveURL, to.LiveURL, stringPtrEqual(from.LiveURL, to.LiveURL))
+	add("tags", from.Tags, to.Tags, slices.Equal(from.Tags, to.Tags))
+	add("technologies", from.Technologies, to.Technologies, slices.Equal(from.Technologies, to.Technologies))
+	add("status", from.Status, to.Status, from.Status == to.Status)
+	add("visibility", models.VisibilityName(from.Visibility), models.VisibilityName(to.Visibility), from.Visibility == to.Visibility)
+	add("cost", from.Cost, to.Cost, from.Cost == to.Cost)
+	add("version", from.Version, to.Version, from.Version == to.Version)
+	return changes
+}
+
+func stringPtrEqual(a, b *string) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+func formatRevision(revision *models.ProjectRevision) dto.ProjectRevision {
+	return dto.ProjectRevision{
+		Revision:     revision.Revision,
+		Title:        revision.Title,
+		Description:  revision.Description,
+		GithubLink:   revision.GithubLink,
+		LiveURL:      revision.LiveURL,
+		Tags:         revision.Tags,
+		Technologies: revision.Technologies,
+		Status:       revision.Status,
+		Visibility:   models.VisibilityName(revision.Visibility),
+		Cost:         revision.Cost,
+		Version:      revision.Version,
+		RestoredFrom: revision.RestoredFrom,
+		ModifiedBy:   revision.ModifiedBy,
+		CreatedAt:    revision.CreatedAt,
+	}
+}
@@ -1,29 +1,36 @@
 package service
 
 import (
+	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	commonModules "github.com/aruncs31s/esdcmodels"
 	"github.com/aruncs31s/esdcprojectmodule/dto"
 	"github.com/aruncs31s/esdcprojectmodule/interfaces/repository"
 	"github.com/aruncs31s/esdcprojectmodule/interfaces/service"
+	"github.com/aruncs31s/esdcprojectmodule/models"
 	utils "github.com/aruncs31s/esdcprojectmodule/utils"
 	userRepo "github.com/aruncs31s/esdcusermodule/repository"
+	"gorm.io/gorm"
 )
 
 type projectService struct {
 	projectRepo repository.ProjectRepository
 	userRepo    userRepo.UserRepository
+	authorizer  service.ProjectAuthorizer
 }
 
 func NewProjectService(
 	projectRepo repository.ProjectRepository,
 	userRepo userRepo.UserRepository,
+	authorizer service.ProjectAuthorizer,
 ) service.ProjectService {
 	return &projectService{
 		projectRepo: projectRepo,
 		userRepo:    userRepo,
+		authorizer:  authorizer,
 	}
 }
 
@@ -32,68 +39,101 @@ func (s *projectService) CreateProject(user string, project dto.ProjectCreation)
 	if err != nil {
 		return nil, err
 	}
-	// Build []model.User slice for contributors
-	contributors, err := getContributors(s, userID, project)
+	if err := validateProjectCreation(project, time.Now()); err != nil {
+		return nil, err
+	}
+	status := models.StatusActive
+	if project.Draft || project.PublishAt != nil {
+		status = models.StatusDraft
+	}
+	// Only the creator is added directly, the requested contributors get an invite.
+	contributors, err := getContributors(s, userID)
 	if err != nil {
 		return nil, err
 	}
 
 	// This one , should if the tag exists in the db , if exists assign its value to the project or else create a new tag and assign it to the project
-	tags, err := getTags(project, s)
+	tags, err := getTags(project.Tags, s.projectRepo)
 	if err != nil {
 		return nil, err
 	}
-	technologies, err := getTechnologies(project, s)
+	technologies, err := getTechnologies(project.Technologies, s.projectRepo)
 	if err != nil {
 		return nil, err
 	}
+	// Unknown usernames must not fail the whole create, they are just not invited.
+	var inviteeIDs []uint
+	if project.Contributors != nil {
+		inviteeIDs, _, _, err = findInvitees(s.userRepo, *project.Contributors, map[uint]bool{userID: true})
+		if err != nil {
+			return nil, fmt.Errorf("error finding contributors to invite: %w", err)
+		}
+	}
 	// Create the new project
-	newProject := commonModules.Project{
-		Title:        project.Title,
-		Image:        project.Image,
-		Description:  project.Description,
-		GithubLink:   project.GithubLink,
-		Tags:         &tags,
-		CreatedBy:    userID,
-		ModifiedBy:   &userID,
-		Status:       "active", // Default status
-		Likes:        0,        // Default value
-		Views:        0,
-		Category:     project.Category, // Set category from request
-		LiveURL:      project.LiveURL,
-		Technologies: &technologies,
-		Contributors: &contributors,
-	}
-
-	// Save the project and its relationships
+	newProject := models.NewProject{
+		Project: commonModules.Project{
+			Title:        project.Title,
+			Image:        project.Image,
+			Description:  project.Description,
+			GithubLink:   project.GithubLink,
+			Tags:         &tags,
+			CreatedBy:    userID,
+			ModifiedBy:   &userID,
+			Status:       status,
+			Likes:        0, // Default value
+			Views:        0,
+			Category:     project.Category, // Set category from request
+			LiveURL:      project.LiveURL,
+			Technologies: &technologies,
+			Contributors: &contributors,
+		},
+		InviteeIDs: inviteeIDs,
+		InvitedBy:  userID,
+		PublishAt:  project.PublishAt,
+	}
+
+	// Save the project, its owner, schedule and invites together.
 	if err := s.projectRepo.Create(&newProject); err != nil {
 		return nil, err
 	}
 
-	return &newProject, nil
+	return &newProject.Project, nil
+}
+
+// validateProjectCreation checks the rules every new project follows, however it is created.
+func validateProjectCreation(project dto.ProjectCreation, now time.Time) error {
+	if project.PublishAt != nil && !project.PublishAt.After(now) {
+		return utils.ErrPublishInPast
+	}
+	return nil
 }
 
-func getTechnologies(project dto.ProjectCreation, s *projectService) ([]commonModules.Technologies, error) {
+// getTechnologies finds or creates the technologies, each name may hold a comma separated list.
+func getTechnologies(names *[]string, projectRepo repository.ProjectRepositoryMixed) ([]commonModules.Technologies, error) {
 	technologies := make([]commonModules.Technologies, 0)
-	if project.Technologies != nil {
-		splittedTechnologies := strings.Split((*project.Technologies)[0], ",")
-		for _, techName := range splittedTechnologies {
-			filteredTechName := strings.TrimSpace(techName)
-			tech, err := s.projectRepo.FindOrCreateTechnology(filteredTechName)
-			if err != nil {
-				return nil, fmt.Errorf("error creating/finding technology: %w", err)
+	if names != nil {
+		for _, name := range *names {
+			for _, techName := range strings.Split(name, ",") {
+				filteredTechName := strings.TrimSpace(techName)
+				if filteredTechName == "" {
+					continue
+				}
+				tech, err := projectRepo.FindOrCreateTechnology(filteredTechName)
+				if err != nil {
+					return nil, fmt.Errorf("error creating/finding technology: %w", err)
+				}
+				technologies = append(technologies, *tech)
 			}
-			technologies = append(technologies, *tech)
 		}
 	}
 	return technologies, nil
 }
 
-func getTags(project dto.ProjectCreation, s *projectService) ([]commonModules.Tag, error) {
+func getTags(names *[]string, projectRepo repository.ProjectRepositoryMixed) ([]commonModules.Tag, error) {
 	tags := make([]commonModules.Tag, 0)
-	if project.Tags != nil {
-		for _, tagName := range *project.Tags {
-			tag, err := s.projectRepo.FindOrCreateTag(tagName)
+	if names != nil {
+		for _, tagName := range *names {
+			tag, err := projectRepo.FindOrCreateTag(tagName)
 			// Check if this error should be avoided.
 			if err != nil {
 				return nil, fmt.Errorf("error creating/finding tag: %w", err)
@@ -104,9 +144,9 @@ func getTags(project dto.ProjectCreation, s *projectService) ([]commonModules.Ta
 	return tags, nil
 }
 
-func getContributors(s *projectService, userID uint, project dto.ProjectCreation) ([]commonModules.User, error) {
+func getContributors(s *projectService, userID uint) ([]commonModules.User, error) {
 	contributors := make([]commonModules.User, 0)
-	// First add the creator as a contributor
+	// The creator is always a contributor
 	creator, err := s.userRepo.FindByID(userID)
 	if err != nil {
 		// Move to error class.
@@ -114,22 +154,15 @@ func getContributors(s *projectService, userID uint, project dto.ProjectCreation
 	}
 
 	contributors = append(contributors, *creator)
-	// now add contributors from the request
-	if project.Contributors != nil && len(*project.Contributors) > 0 {
-		users, err := s.userRepo.FindUsersByUsernames(*project.Contributors)
-		if err != nil {
-			return nil, fmt.Errorf("error fetching contributors: %w", err)
-		}
-		if len(*users) != len(*project.Contributors) {
-			return nil, fmt.Errorf("one or more contributors not found")
-		}
-		contributors = append(contributors, *users...)
-	}
 	return contributors, nil
 }
 
 func (s *projectService) GetProject(id uint, user string) (*dto.ProjectResponse, error) {
 	project, err := s.projectRepo.GetByID(id)
+	if errors.Is(err, gorm.ErrRecordNotFound) && user != "" {
+		// Private projects and drafts are still visible to their owner and contributors.
+		project, err = s.getVisibleProject(id, user)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -151,7 +184,7 @@ func getProjectResponseForPersonal(project commonModules.Project, isLiked bool)
 		Title:               project.Title,
 		Description:         project.Description,
 		GithubLink:          project.GithubLink,
-		Image:               project.Image,
+		Image:               utils.GetProjectImage(project.ID, project.Image),
 		LiveUrl:             project.LiveURL,
 		CreatedAt:           project.CreatedAt,
 		UpdatedAt:           project.UpdatedAt,
@@ -160,6 +193,8 @@ func getProjectResponseForPersonal(project commonModules.Project, isLiked bool)
 		Cost:                project.Cost,
 		Category:            project.Category,
 		IsLiked:             isLiked,
+		Version:             project.Version,
+		ForkedFromID:        project.ForkedFrom,
 		CreatorDetails:      utils.GetCreatorDetails(project.Creator),
 		ContributorsDetails: utils.GetContributorsUsernames(project.Contributors),
 		TagsDetails:         utils.GetTagsNames(project.Tags),
@@ -167,6 +202,138 @@ func getProjectResponseForPersonal(project commonModules.Project, isLiked bool)
 	}
 }
 
+// getVisibleProject retrieves a project that is not listed, if the user is allowed to view it.
+func (s *projectService) getVisibleProject(id uint, user string) (commonModules.Project, error) {
+	userID, err := s.userRepo.FindUserIDByUsername(user)
+	if err != nil {
+		return commonModules.Project{}, err
+	}
+	allowed, err := s.authorizer.Can(userID, id, models.ActionView)
+	if err != nil {
+		return commonModules.Project{}, err
+	}
+	if !allowed {
+		return commonModules.Project{}, gorm.ErrRecordNotFound
+	}
+	return s.projectRepo.GetByIDIncludingPrivate(id)
+}
+
+func (s *projectService) UpdateProject(username string, projectID uint, project dto.ProjectUpdate) (*dto.ProjectResponse, error) {
+	userID, err := s.userRepo.FindUserIDByUsername(username)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.authorizer.Authorize(userID, projectID, models.ActionEdit); err != nil {
+		return nil, err
+	}
+	current, err := s.projectRepo.GetByIDIncludingPrivate(projectID)
+	if err != nil {
+		return nil, err
+	}
+	changes, err := getProjectChanges(project, s.projectRepo)
+	if err != nil {
+		return nil, err
+	}
+	if visibility, ok := changes.Fields["visibility"]; ok && visibility != current.Visibility {
+		if err := s.authorizer.Authorize(userID, projectID, models.ActionChangeVisibility); err != nil {
+			return nil, err
+		}
+	}
+	if status, ok := changes.Fields["status"]; ok && status != current.Status {
+		// Only moderators hide or trash projects and bring them back.
+		if newStatus, _ := status.(string); models.IsModeratedStatus(current.Status) || models.IsModeratedStatus(newStatus) {
+			return nil, utils.ErrHiddenStatus
+		}
+		// Drafts only go live through publish, moving back to draft hides the project.
+		if current.Status == models.StatusDraft {
+			return nil, utils.ErrPublishWithUpdate
+		}
+		if status == models.StatusDraft {
+			if err := s.authorizer.Authorize(userID, projectID, models.ActionChangeVisibility); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if !changes.IsEmpty() {
+		changes.ModifiedBy = userID
+		if err := s.projectRepo.Update(projectID, changes); err != nil {
+			return nil, err
+		}
+	}
+	updated, err := s.projectRepo.GetByIDIncludingPrivate(projectID)
+	if err != nil {
+		return nil, err
+	}
+	isLiked, err := s.projectRepo.IsLiked(userID, projectID)
+	if err != nil {
+		return nil, err
+	}
+	return getProjectResponseForPersonal(updated, isLiked), nil
+}
+
+// getProjectChanges converts the fields set in the update request to column values.
+func getProjectChanges(project dto.ProjectUpdate, projectRepo repository.ProjectRepository) (models.ProjectChanges, error) {
+	changes := models.ProjectChanges{Fields: map[string]interface{}{}}
+	if project.Title != nil {
+		if strings.TrimSpace(*project.Title) == "" {
+			return changes, utils.ErrEmptyTitle
+		}
+		changes.Fields["title"] = *project.Title
+	}
+	if project.Image != nil {
+		changes.Fields["image"] = *project.Image
+	}
+	if project.Description != nil {
+		changes.Fields["description"] = *project.Description
+	}
+	if project.Status != nil {
+		switch {
+		case strings.TrimSpace(*project.Status) == "":
+			return changes, utils.ErrEmptyStatus
+		case !models.IsKnownStatus(*project.Status):
+			return changes, utils.ErrInvalidStatus
+		}
+		changes.Fields["status"] = *project.Status
+	}
+	if project.Visibility != nil {
+		visibility, ok := models.ParseVisibility(*project.Visibility)
+		if !ok {
+			return changes, utils.ErrInvalidVisibility
+		}
+		changes.Fields["visibility"] = visibility
+	}
+	if project.GithubLink != nil {
+		changes.Fields["github_link"] = *project.GithubLink
+	}
+	if project.LiveURL != nil {
+		changes.Fields["live_url"] = *project.LiveURL
+	}
+	if project.Category != nil {
+		changes.Fields["category"] = *project.Category
+	}
+	if project.Cost != nil {
+		if *project.Cost < 0 {
+			return changes, utils.ErrInvalidCost
+		}
+		changes.Fields["cost"] = *project.Cost
+	}
+	if project.Tags != nil {
+		tags, err := getTags(project.Tags, projectRepo)
+		if err != nil {
+			return changes, err
+		}
+		changes.Tags = &tags
+	}
+	if project.Technologies != nil {
+		technologies, err := getTechnologies(project.Technologies, projectRepo)
+		if err != nil {
+			return changes, err
+		}
+		changes.Technologies = &technologies
+	}
+	return changes, nil
+}
+
 // HACK: AI
 func (s *projectService) ToggleLikeProject(username string, projectID uint) (bool, error) {
 	// Get the user ID from username
@@ -174,6 +341,9 @@ func (s *projectService) ToggleLikeProject(username string, projectID uint) (boo
 	if err != nil {
 		return false, err
 	}
+	if err := s.authorizer.Authorize(userID, projectID, models.ActionLike); err != nil {
+		return false, err
+	}
 	// check if already liked.
 	isLiked, err := s.projectRepo.IsLiked(userID, projectID)
 	if err != nil {
@@ -0,0 +1,135 @@
+package service
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	model "github.com/aruncs31s/esdcmodels"
+	"github.com/aruncs31s/esdcprojectmodule/dto"
+	"github.com/aruncs31s/esdcprojectmodule/models"
+	"github.com/aruncs31s/esdcprojectmodule/repository"
+	utils "github.com/aruncs31s/esdcprojectmodule/utils"
+	userRepo "github.com/aruncs31s/esdcusermodule/repository"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+func TestGetProjectChangesStatus(t *testing.T) {
+	tests := []struct {
+		status string
+		want   error
+	}{
+		{models.StatusActive, nil},
+		{models.StatusArchived, nil},
+		{models.StatusHidden, nil},
+		{"", utils.ErrEmptyStatus},
+		{"  ", utils.ErrEmptyStatus},
+		{"in_progress", utils.ErrInvalidStatus},
+		{"Active", utils.ErrInvalidStatus},
+	}
+	for _, tt := range tests {
+		status := tt.status
+		changes, err := getProjectChanges(dto.ProjectUpdate{Status: &status}, nil)
+		if !errors.Is(err, tt.want) {
+			t.Errorf("getProjectChanges(status %q) error = %v, want %v", tt.status, err, tt.want)
+			continue
+		}
+		if err == nil && changes.Fields["status"] != tt.status {
+			t.Errorf("getProjectChanges(status %q) = %v", tt.status, changes.Fields)
+		}
+	}
+}
+
+func TestErrInvalidStatusListsKnownStatuses(t *testing.T) {
+	for _, status := range models.KnownStatuses {
+		if !strings.Contains(utils.ErrInvalidStatus.Error(), status) {
+			t.Errorf("ErrInvalidStatus %q does not list %q", utils.ErrInvalidStatus, status)
+		}
+	}
+}
+
+// newCreateTestDB migrates the module tables and adds the users alice and bob.
+func newCreateTestDB(t *testing.T) *gorm.DB {
+	t.Helper()
+	db, err := gorm.Open(sqlite.Open("file::memory:"), &gorm.Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Every connection opens its own in-memory database.
+	sqlDB, err := db.DB()
+	if err != nil {
+		t.Fatal(err)
+	}
+	sqlDB.SetMaxOpenConns(1)
+	t.Cleanup(func() { sqlDB.Close() })
+	if err := db.AutoMigrate(&model.User{}, &model.Tag{}, &model.Technologies{}, &model.Project{}); err != nil {
+		t.Fatal(err)
+	}
+	if err := models.AutoMigrate(db); err != nil {
+		t.Fatal(err)
+	}
+	users := []model.User{
+		{ID: 1, Name: "Alice", Username: "alice", Email: "alice@example.com", Password: "x"},
+		{ID: 2, Name: "Bob", Username: "bob", Email: "bob@example.com", Password: "x"},
+	}
+	if err := db.Create(&users).Error; err != nil {
+		t.Fatal(err)
+	}
+	return db
+}
+
+func newCreateTestService(db *gorm.DB) *projectService {
+	return &projectService{
+		projectRepo: repository.NewProjectRepository(db),
+		userRepo:    userRepo.NewUserRepository(db),
+	}
+}
+
+func TestCreateProjectOwnerAndInvites(t *testing.T) {
+	db := newCreateTestDB(t)
+	s := newCreateTestService(db)
+
+	contributors := []string{"bob", "ghost", "alice", "bob"}
+	project, err := s.CreateProject("alice", dto.ProjectCreation{Title: "Rover", Contributors: &contributors})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var owner models.ProjectContributor
+	if err := db.Where("project_id = ? AND user_id = ?", project.ID, 1).First(&owner).Error; err != nil {
+		t.Fatalf("owner row: %v", err)
+	}
+	if owner.Role != models.RoleOwner {
+		t.Errorf("owner role = %q, want %q", owner.Role, models.RoleOwner)
+	}
+	// Unknown and repeated usernames and the creator are not invited.
+	var invites []models.ProjectInvite
+	if err := db.Where("project_id = ?", project.ID).Find(&invites).Error; err != nil {
+		t.Fatal(err)
+	}
+	if len(invites) != 1 || invites[0].InviteeID != 2 || invites[0].InvitedBy != 1 || invites[0].Role != models.RoleEditor {
+		t.Errorf("invites = %+v, want bob invited as editor by alice", invites)
+	}
+}
+
+func TestCreateProjectRollsBackWhenInvitesFail(t *testing.T) {
+	db := newCreateTestDB(t)
+	s := newCreateTestService(db)
+	if err := db.Migrator().DropTable(&models.ProjectInvite{}); err != nil {
+		t.Fatal(err)
+	}
+
+	contributors := []string{"bob"}
+	if _, err := s.CreateProject("alice", dto.ProjectCreation{Title: "Rover", Contributors: &contributors}); err == nil {
+		t.Fatal("CreateProject() succeeded without the invites table")
+	}
+	for _, table := range []interface{}{&model.Project{}, &models.ProjectContributor{}, &models.ProjectRevision{}} {
+		var count int64
+		if err := db.Model(table).Count(&count).Error; err != nil {
+			t.Fatal(err)
+		}
+		if count != 0 {
+			t.Errorf("%T has %d rows after the failed create, want 0", table, count)
+		}
+	}
+}
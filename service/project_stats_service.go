@@ -0,0 +1,175 @@
+package service
+
+import (
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/aruncs31s/esdcprojectmodule/dto"
+	repository "github.com/aruncs31s/esdcprojectmodule/interfaces/repository"
+	"github.com/aruncs31s/esdcprojectmodule/interfaces/service"
+	"github.com/aruncs31s/esdcprojectmodule/models"
+	userRepo "github.com/aruncs31s/esdcusermodule/repository"
+)
+
+const (
+	// statsWeeks is how many weeks, including the current one, NewPerWeek covers.
+	statsWeeks = 12
+	// statsTopLimit is how many technologies, tags and creators are ranked.
+	statsTopLimit  = 10
+	statsDayLayout = "2006-01-02"
+)
+
+type projectStatsService struct {
+	statsRepo repository.ProjectStatsRepository
+	userRepo  userRepo.UserRepository
+	// cacheTTL is how long computed stats are served before the queries run again.
+	cacheTTL time.Duration
+
+	mu      sync.Mutex
+	cached  *dto.ProjectStats
+	expires time.Time
+}
+
+func NewProjectStatsService(
+	statsRepo repository.ProjectStatsRepository,
+	userRepo userRepo.UserRepository,
+	cacheTTL time.Duration,
+) service.ProjectStatsService {
+	return &projectStatsService{
+		statsRepo: statsRepo,
+		userRepo:  userRepo,
+		cacheTTL:  cacheTTL,
+	}
+}
+
+func (s *projectStatsService) GetStats(username string) (*dto.ProjectStats, error) {
+	if _, err := getAdminID(s.userRepo, username); err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := time.Now()
+	if s.cached != nil && now.Before(s.expires) {
+		return s.cached, nil
+	}
+	stats, err := s.computeStats(now)
+	if err != nil {
+		return nil, err
+	}
+	s.cached = stats
+	s.expires = now.Add(s.cacheTTL)
+	return stats, nil
+}
+
+func (s *projectStatsService) computeStats(now time.Time) (*dto.ProjectStats, error) {
+	totals, err := s.statsRepo.GetTotals()
+	if err != nil {
+		return nil, err
+	}
+	stats := &dto.ProjectStats{
+		TotalProjects: totals.Projects,
+		AverageLikes:  totals.AverageLikes,
+		AverageViews:  totals.AverageViews,
+		GeneratedAt:   now,
+	}
+	byStatus, err := s.statsRepo.CountByStatus()
+	if err != nil {
+		return nil, err
+	}
+	stats.ByStatus = countsByName(byStatus, nil)
+	byVisibility, err := s.statsRepo.CountByVisibility()
+	if err != nil {
+		return nil, err
+	}
+	stats.ByVisibility = countsByName(byVisibility, func(value string) string {
+		visibility, _ := strconv.Atoi(value)
+		return models.VisibilityName(visibility)
+	})
+	byCategory, err := s.statsRepo.CountByCategory()
+	if err != nil {
+		return nil, err
+	}
+	stats.ByCategory = countsByName(byCategory, nil)
+	firstWeek := weekStart(now).AddDate(0, 0, -7*(statsWeeks-1))
+	perDay, err := s.statsRepo.CountCreatedPerDay(firstWeek)
+	if err != nil {
+		return nil, err
+	}
+	stats.NewPerWeek = countPerWeek(perDay, firstWeek)
+	technologies, err := s.statsRepo.GetTopTechnologies(statsTopLimit)
+	if err != nil {
+		return nil, err
+	}
+	stats.TopTechnologies = formatStatCounts(technologies)
+	tags, err := s.statsRepo.GetTopTags(statsTopLimit)
+	if err != nil {
+		return nil, err
+	}
+	stats.TopTags = formatStatCounts(tags)
+	creators, err := s.statsRepo.GetTopCreators(statsTopLimit)
+	if err != nil {
+		return nil, err
+	}
+	stats.TopCreators = make([]dto.CreatorStats, 0, len(creators))
+	for _, creator := range creators {
+		stats.TopCreators = append(stats.TopCreators, dto.CreatorStats{
+			Username: creator.Username,
+			Projects: creator.Projects,
+			Likes:    creator.Likes,
+		})
+	}
+	return stats, nil
+}
+
+// countsByName turns the groups into a map, name converts the stored value to the
+// name shown to admins when it is not nil.
+func countsByName(counts []models.ProjectStatCount, name func(value string) string) map[string]int64 {
+	byName := make(map[string]int64, len(counts))
+	for _, count := range counts {
+		key := count.Value
+		if name != nil {
+			key = name(count.Value)
+		}
+		byName[key] += count.Count
+	}
+	return byName
+}
+
+func formatStatCounts(counts []models.ProjectStatCount) []dto.StatCount {
+	formatted := make([]dto.StatCount, 0, len(counts))
+	for _, count := range counts {
+		formatted = append(formatted, dto.StatCount{Name: count.Value, Count: count.Count})
+	}
+	return formatted
+}
+
+// weekStart returns the UTC midnight of the Monday starting the week of t.
+func weekStart(t time.Time) time.Time {
+	t = t.UTC()
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	return day.AddDate(0, 0, -(int(day.Weekday())+6)%7)
+}
+
+// countPerWeek adds up the days into statsWeeks weeks starting at firstWeek,
+// weeks without new projects are kept with a zero count.
+func countPerWeek(perDay []models.ProjectDayCount, firstWeek time.Time) []dto.WeekCount {
+	weeks := make([]dto.WeekCount, statsWeeks)
+	for i := range weeks {
+		weeks[i].WeekStart = firstWeek.AddDate(0, 0, 7*i).Format(statsDayLayout)
+	}
+	for _, count := range perDay {
+		if len(count.Day) < len(statsDayLayout) {
+			continue
+		}
+		day, err := time.Parse(statsDayLayout, count.Day[:len(statsDayLayout)])
+		if err != nil || day.Before(firstWeek) {
+			continue
+		}
+		week := int(day.Sub(firstWeek).Hours()) / (7 * 24)
+		if week < statsWeeks {
+			weeks[week].Count += count.Count
+		}
+	}
+	return weeks
+}
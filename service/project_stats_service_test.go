@@ -0,0 +1,249 @@
+package service
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	model "github.com/aruncs31s/esdcmodels"
+	"github.com/aruncs31s/esdcprojectmodule/dto"
+	"github.com/aruncs31s/esdcprojectmodule/models"
+	"github.com/aruncs31s/esdcprojectmodule/repository"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+// statsTestNow is a Wednesday, its week starts on 2026-03-02 and the first of
+// the 12 weeks on 2025-12-15.
+var statsTestNow = time.Date(2026, time.March, 4, 15, 0, 0, 0, time.UTC)
+
+// newStatsTestDB seeds five projects of alice, bob and carol, one of them
+// created before the weeks covered by the stats.
+func newStatsTestDB(t *testing.T) *gorm.DB {
+	t.Helper()
+	db, err := gorm.Open(sqlite.Open("file::memory:"), &gorm.Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Every connection opens its own in-memory database.
+	sqlDB, err := db.DB()
+	if err != nil {
+		t.Fatal(err)
+	}
+	sqlDB.SetMaxOpenConns(1)
+	t.Cleanup(func() { sqlDB.Close() })
+	if err := db.AutoMigrate(&model.User{}, &model.Tag{}, &model.Technologies{}, &model.Project{}); err != nil {
+		t.Fatal(err)
+	}
+	users := []model.User{
+		{ID: 1, Name: "Alice", Username: "alice", Email: "alice@example.com", Password: "x"},
+		{ID: 2, Name: "Bob", Username: "bob", Email: "bob@example.com", Password: "x"},
+		{ID: 3, Name: "Carol", Username: "carol", Email: "carol@example.com", Password: "x"},
+	}
+	if err := db.Create(&users).Error; err != nil {
+		t.Fatal(err)
+	}
+	tag := func(names ...string) *[]model.Tag {
+		tags := make([]model.Tag, len(names))
+		for i, name := range names {
+			if err := db.FirstOrCreate(&tags[i], model.Tag{Name: name}).Error; err != nil {
+				t.Fatal(err)
+			}
+		}
+		return &tags
+	}
+	tech := func(names ...string) *[]model.Technologies {
+		technologies := make([]model.Technologies, len(names))
+		for i, name := range names {
+			if err := db.FirstOrCreate(&technologies[i], model.Technologies{Name: name}).Error; err != nil {
+				t.Fatal(err)
+			}
+		}
+		return &technologies
+	}
+	projects := []model.Project{
+		{
+			Title: "Line follower", Category: "Robotics", Status: models.StatusActive, Visibility: models.VisibilityPublic,
+			Likes: 3, Views: 10, CreatedBy: 1, CreatedAt: time.Date(2026, time.March, 3, 9, 0, 0, 0, time.UTC),
+			Tags: tag("arduino", "led"), Technologies: tech("C++"),
+		},
+		{
+			Title: "Weather station", Category: "IoT", Status: models.StatusDraft, Visibility: models.VisibilityPrivate,
+			Likes: 2, Views: 20, CreatedBy: 1, CreatedAt: time.Date(2026, time.March, 2, 0, 30, 0, 0, time.UTC),
+			Tags: tag("arduino", "esp32"), Technologies: tech("C++", "Python"),
+		},
+		{
+			Title: "Smart lamp", Category: "IoT", Status: models.StatusActive, Visibility: models.VisibilityPublic,
+			Likes: 6, Views: 30, CreatedBy: 2, CreatedAt: time.Date(2026, time.February, 22, 23, 0, 0, 0, time.UTC),
+			Tags: tag("esp32", "led"), Technologies: tech("Python"),
+		},
+		{
+			Title: "Rover", Category: "Robotics", Status: models.StatusArchived, Visibility: models.VisibilityPrivate,
+			Likes: 4, Views: 40, CreatedBy: 2, CreatedAt: time.Date(2025, time.December, 15, 0, 0, 0, 0, time.UTC),
+			Tags: tag("arduino"), Technologies: tech("Go"),
+		},
+		{
+			Title: "Notes", Category: "General", Status: models.StatusActive, Visibility: models.VisibilityPublic,
+			Likes: 0, Views: 50, CreatedBy: 3, CreatedAt: time.Date(2025, time.December, 14, 23, 59, 0, 0, time.UTC),
+			Tags: tag("misc"),
+		},
+	}
+	for i := range projects {
+		if err := db.Create(&projects[i]).Error; err != nil {
+			t.Fatal(err)
+		}
+	}
+	// The column default replaces a zero visibility on create.
+	if err := db.Model(&model.Project{}).
+		Where("title IN ?", []string{"Line follower", "Smart lamp", "Notes"}).
+		Update("visibility", models.VisibilityPublic).Error; err != nil {
+		t.Fatal(err)
+	}
+	return db
+}
+
+func TestProjectStatsServiceComputeStats(t *testing.T) {
+	s := &projectStatsService{statsRepo: repository.NewProjectStatsRepository(newStatsTestDB(t))}
+
+	stats, err := s.computeStats(statsTestNow)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stats.TotalProjects != 5 || stats.AverageLikes != 3 || stats.AverageViews != 30 {
+		t.Fatalf("totals = %d projects, %v likes, %v views, want 5, 3, 30",
+			stats.TotalProjects, stats.AverageLikes, stats.AverageViews)
+	}
+	wantByStatus := map[string]int64{models.StatusActive: 3, models.StatusDraft: 1, models.StatusArchived: 1}
+	if !reflect.DeepEqual(stats.ByStatus, wantByStatus) {
+		t.Errorf("ByStatus = %v, want %v", stats.ByStatus, wantByStatus)
+	}
+	wantByVisibility := map[string]int64{"public": 3, "private": 2}
+	if !reflect.DeepEqual(stats.ByVisibility, wantByVisibility) {
+		t.Errorf("ByVisibility = %v, want %v", stats.ByVisibility, wantByVisibility)
+	}
+	wantByCategory := map[string]int64{"Robotics": 2, "IoT": 2, "General": 1}
+	if !reflect.DeepEqual(stats.ByCategory, wantByCategory) {
+		t.Errorf("ByCategory = %v, want %v", stats.ByCategory, wantByCategory)
+	}
+
+	// Notes was created the day before the first week and is left out.
+	wantWeeks := map[string]int64{"2025-12-15": 1, "2026-02-16": 1, "2026-03-02": 2}
+	if len(stats.NewPerWeek) != statsWeeks {
+		t.Fatalf("NewPerWeek has %d weeks, want %d", len(stats.NewPerWeek), statsWeeks)
+	}
+	for i, week := range stats.NewPerWeek {
+		wantStart := time.Date(2025, time.December, 15+7*i, 0, 0, 0, 0, time.UTC).Format(statsDayLayout)
+		if week.WeekStart != wantStart || week.Count != wantWeeks[wantStart] {
+			t.Errorf("NewPerWeek[%d] = %+v, want %s with %d", i, week, wantStart, wantWeeks[wantStart])
+		}
+	}
+
+	// Ties are ranked by name.
+	wantTags := []dto.StatCount{{Name: "arduino", Count: 3}, {Name: "esp32", Count: 2}, {Name: "led", Count: 2}, {Name: "misc", Count: 1}}
+	if !reflect.DeepEqual(stats.TopTags, wantTags) {
+		t.Errorf("TopTags = %v, want %v", stats.TopTags, wantTags)
+	}
+	wantTechnologies := []dto.StatCount{{Name: "C++", Count: 2}, {Name: "Python", Count: 2}, {Name: "Go", Count: 1}}
+	if !reflect.DeepEqual(stats.TopTechnologies, wantTechnologies) {
+		t.Errorf("TopTechnologies = %v, want %v", stats.TopTechnologies, wantTechnologies)
+	}
+	// Ties on projects are ranked by likes.
+	wantCreators := []dto.CreatorStats{
+		{Username: "bob", Projects: 2, Likes: 10},
+		{Username: "alice", Projects: 2, Likes: 5},
+		{Username: "carol", Projects: 1, Likes: 0},
+	}
+	if !reflect.DeepEqual(stats.TopCreators, wantCreators) {
+		t.Errorf("TopCreators = %v, want %v", stats.TopCreators, wantCreators)
+	}
+}
+
+func TestProjectStatsRepositoryTopLimit(t *testing.T) {
+	statsRepo := repository.NewProjectStatsRepository(newStatsTestDB(t))
+
+	tags, err := statsRepo.GetTopTags(2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantTags := []models.ProjectStatCount{{Value: "arduino", Count: 3}, {Value: "esp32", Count: 2}}
+	if !reflect.DeepEqual(tags, wantTags) {
+		t.Errorf("GetTopTags(2) = %v, want %v", tags, wantTags)
+	}
+	creators, err := statsRepo.GetTopCreators(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(creators) != 1 || creators[0].Username != "bob" {
+		t.Errorf("GetTopCreators(1) = %+v, want bob", creators)
+	}
+}
+
+func TestCountPerWeek(t *testing.T) {
+	firstWeek := time.Date(2025, time.December, 15, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name   string
+		perDay []models.ProjectDayCount
+		want   map[int]int64
+	}{
+		{"no projects", nil, nil},
+		{
+			"days of one week add up",
+			[]models.ProjectDayCount{{Day: "2025-12-15", Count: 1}, {Day: "2025-12-17", Count: 2}, {Day: "2025-12-21", Count: 3}},
+			map[int]int64{0: 6},
+		},
+		{
+			"monday starts the next week",
+			[]models.ProjectDayCount{{Day: "2025-12-21", Count: 1}, {Day: "2025-12-22", Count: 4}},
+			map[int]int64{0: 1, 1: 4},
+		},
+		{
+			"last week",
+			[]models.ProjectDayCount{{Day: "2026-03-02", Count: 2}, {Day: "2026-03-08", Count: 1}},
+			map[int]int64{11: 3},
+		},
+		{
+			"days outside the weeks are left out",
+			[]models.ProjectDayCount{{Day: "2025-12-14", Count: 5}, {Day: "2026-03-09", Count: 7}},
+			nil,
+		},
+		{
+			"time after the date is ignored",
+			[]models.ProjectDayCount{{Day: "2026-01-05 00:00:00", Count: 2}},
+			map[int]int64{3: 2},
+		},
+		{
+			"unreadable days are skipped",
+			[]models.ProjectDayCount{{Day: "", Count: 1}, {Day: "yesterday!", Count: 1}, {Day: "2025-12-16", Count: 1}},
+			map[int]int64{0: 1},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			weeks := countPerWeek(tt.perDay, firstWeek)
+			if len(weeks) != statsWeeks {
+				t.Fatalf("countPerWeek() returned %d weeks, want %d", len(weeks), statsWeeks)
+			}
+			for i, week := range weeks {
+				wantStart := firstWeek.AddDate(0, 0, 7*i).Format(statsDayLayout)
+				if week.WeekStart != wantStart || week.Count != tt.want[i] {
+					t.Errorf("week %d = %+v, want %s with %d", i, week, wantStart, tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestWeekStart(t *testing.T) {
+	monday := time.Date(2026, time.March, 2, 0, 0, 0, 0, time.UTC)
+	for _, now := range []time.Time{
+		monday,
+		statsTestNow,
+		time.Date(2026, time.March, 8, 23, 59, 0, 0, time.UTC),
+		// Monday morning east of UTC is still Sunday in UTC.
+		time.Date(2026, time.March, 9, 3, 0, 0, 0, time.FixedZone("IST", 5*3600+1800)),
+	} {
+		if got := weekStart(now); !got.Equal(monday) {
+			t.Errorf("weekStart(%v) = %v, want %v", now, got, monday)
+		}
+	}
+}
@@ -0,0 +1,211 @@
+package service
+
+import (
+	"errors"
+
+	"github.com/aruncs31s/esdcprojectmodule/dto"
+	repository "github.com/aruncs31s/esdcprojectmodule/interfaces/repository"
+	"github.com/aruncs31s/esdcprojectmodule/interfaces/service"
+	"github.com/aruncs31s/esdcprojectmodule/models"
+	utils "github.com/aruncs31s/esdcprojectmodule/utils"
+	userRepo "github.com/aruncs31s/esdcusermodule/repository"
+	"gorm.io/gorm"
+)
+
+type projectTransferService struct {
+	projectRepo  repository.ProjectRepository
+	transferRepo repository.ProjectTransferRepository
+	userRepo     userRepo.UserRepository
+	authorizer   service.ProjectAuthorizer
+}
+
+func NewProjectTransferService(
+	projectRepo repository.ProjectRepository,
+	transferRepo repository.ProjectTransferRepository,
+	userRepo userRepo.UserRepository,
+	authorizer service.ProjectAuthorizer,
+) service.ProjectTransferService {
+	return &projectTransferService{
+		projectRepo:  projectRepo,
+		transferRepo: transferRepo,
+		userRepo:     userRepo,
+		authorizer:   authorizer,
+	}
+}
+
+func (s *projectTransferService) RequestTransfer(username string, projectID uint, newOwner string, force bool) (*dto.ProjectTransfer, error) {
+	user, err := s.userRepo.FindByUsername(username)
+	if err != nil {
+		return nil, err
+	}
+	if force && user.Role != models.UserRoleAdmin {
+		return nil, utils.ErrForceNotAllowed
+	}
+	if err := s.authorizer.Authorize(user.ID, projectID, models.ActionTransferOwnership); err != nil {
+		return nil, err
+	}
+	project, err := s.projectRepo.GetByIDIncludingPrivate(projectID)
+	if err != nil {
+		return nil, err
+	}
+	target, err := s.userRepo.FindByUsername(newOwner)
+	if err != nil {
+		return nil, err
+	}
+	if target.ID == project.CreatedBy {
+		return nil, utils.ErrTransferToOwner
+	}
+	transfer := &models.ProjectTransfer{
+		ProjectID:   projectID,
+		FromUserID:  project.CreatedBy,
+		ToUserID:    target.ID,
+		RequestedBy: user.ID,
+		Status:      models.TransferStatusPending,
+		Forced:      force,
+	}
+	if force {
+		if err := s.transferRepo.Complete(transfer); err != nil {
+			return nil, err
+		}
+	} else {
+		_, err := s.transferRepo.GetPendingForProject(projectID)
+		if err == nil {
+			return nil, utils.ErrTransferPending
+		}
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, err
+		}
+		if err := s.transferRepo.Create(transfer); err != nil {
+			return nil, err
+		}
+	}
+	stored, err := s.transferRepo.GetByID(transfer.ID)
+	if err != nil {
+		return nil, err
+	}
+	formatted := formatTransfer(stored)
+	return &formatted, nil
+}
+
+func (s *projectTransferService) GetMyTransfers(username string) ([]dto.ProjectTransfer, error) {
+	userID, err := s.userRepo.FindUserIDByUsername(username)
+	if err != nil {
+		return nil, err
+	}
+	transfers, err := s.transferRepo.GetPendingForUser(userID)
+	if err != nil {
+		return nil, err
+	}
+	return formatTransfers(transfers), nil
+}
+
+func (s *projectTransferService) GetProjectTransfers(username string, projectID uint) ([]dto.ProjectTransfer, error) {
+	userID, err := s.userRepo.FindUserIDByUsername(username)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.authorizer.Authorize(userID, projectID, models.ActionEdit); err != nil {
+		return nil, err
+	}
+	transfers, err := s.transferRepo.GetByProject(projectID)
+	if err != nil {
+		return nil, err
+	}
+	return formatTransfers(transfers), nil
+}
+
+func (s *projectTransferService) AcceptTransfer(username string, transferID uint) error {
+	transfer, err := s.getTransferForRecipient(username, transferID)
+	if err != nil {
+		return err
+	}
+	project, err := s.projectRepo.GetByIDIncludingPrivate(transfer.ProjectID)
+	if err != nil {
+		return err
+	}
+	// The project changed hands since the transfer was proposed.
+	if project.CreatedBy != transfer.FromUserID {
+		_ = s.transferRepo.UpdateStatus(transfer.ID, models.TransferStatusCancelled)
+		return utils.ErrTransferNotPending
+	}
+	if err := s.transferRepo.Complete(transfer); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return utils.ErrTransferNotPending
+		}
+		return err
+	}
+	return nil
+}
+
+func (s *projectTransferService) DeclineTransfer(username string, transferID uint) error {
+	if _, err := s.getTransferForRecipient(username, transferID); err != nil {
+		return err
+	}
+	return s.updateTransferStatus(transferID, models.TransferStatusDeclined)
+}
+
+func (s *projectTransferService) CancelTransfer(username string, projectID uint) error {
+	userID, err := s.userRepo.FindUserIDByUsername(username)
+	if err != nil {
+		return err
+	}
+	if err := s.authorizer.Authorize(userID, projectID, models.ActionTransferOwnership); err != nil {
+		return err
+	}
+	transfer, err := s.transferRepo.GetPendingForProject(projectID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return utils.ErrTransferNotFound
+		}
+		return err
+	}
+	return s.updateTransferStatus(transfer.ID, models.TransferStatusCancelled)
+}
+
+func (s *projectTransferService) updateTransferStatus(transferID uint, status string) error {
+	if err := s.transferRepo.UpdateStatus(transferID, status); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return utils.ErrTransferNotPending
+		}
+		return err
+	}
+	return nil
+}
+
+func (s *projectTransferService) getTransferForRecipient(username string, transferID uint) (*models.ProjectTransfer, error) {
+	userID, err := s.userRepo.FindUserIDByUsername(username)
+	if err != nil {
+		return nil, err
+	}
+	transfer, err := s.transferRepo.GetByID(transferID)
+	// Do not reveal transfers offered to someone else.
+	if err != nil || transfer.ToUserID != userID {
+		return nil, utils.ErrTransferNotFound
+	}
+	if !transfer.IsPending() {
+		return nil, utils.ErrTransferNotPending
+	}
+	return transfer, nil
+}
+
+func formatTransfers(transfers []models.ProjectTransfer) []dto.ProjectTransfer {
+	formatted := make([]dto.ProjectTransfer, len(transfers))
+	for i := range transfers {
+		formatted[i] = formatTransfer(&transfers[i])
+	}
+	return formatted
+}
+
+func formatTransfer(transfer *models.ProjectTransfer) dto.ProjectTransfer {
+	return dto.ProjectTransfer{
+		ID:           transfer.ID,
+		ProjectID:    transfer.ProjectID,
+		ProjectTitle: transfer.Project.Title,
+		From:         utils.GetCreatorDetails(transfer.FromUser),
+		To:           utils.GetCreatorDetails(transfer.ToUser),
+		Status:       transfer.Status,
+		Forced:       transfer.Forced,
+		CreatedAt:    transfer.CreatedAt,
+		RespondedAt:  transfer.RespondedAt,
+	}
+}
@@ -0,0 +1,322 @@
+package service
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aruncs31s/esdcprojectmodule/dto"
+	repository "github.com/aruncs31s/esdcprojectmodule/interfaces/repository"
+	"github.com/aruncs31s/esdcprojectmodule/interfaces/service"
+	"github.com/aruncs31s/esdcprojectmodule/models"
+	"github.com/aruncs31s/esdcprojectmodule/provider"
+	utils "github.com/aruncs31s/esdcprojectmodule/utils"
+	sharedUtils "github.com/aruncs31s/esdcsharedhelpersmodule/utils"
+	userRepo "github.com/aruncs31s/esdcusermodule/repository"
+	"gorm.io/gorm"
+)
+
+const (
+	// GitHubWebhookPath is where GitHub sends the deliveries of every project.
+	GitHubWebhookPath  = "/api/hooks/github"
+	webhookSecretBytes = 32
+	// webhookEventsShown is how many of the latest events GetWebhook returns.
+	webhookEventsShown = 20
+)
+
+// GitHub events the webhook acts on, the others are acknowledged and ignored.
+const (
+	gitHubEventPing    = "ping"
+	gitHubEventPush    = "push"
+	gitHubEventRelease = "release"
+)
+
+// gitHubDelivery holds the fields of the GitHub payloads the webhook uses.
+type gitHubDelivery struct {
+	Action     string `json:"action"`
+	Ref        string `json:"ref"`
+	After      string `json:"after"`
+	Repository struct {
+		HTMLURL  string `json:"html_url"`
+		FullName string `json:"full_name"`
+	} `json:"repository"`
+	Release *struct {
+		TagName     string     `json:"tag_name"`
+		Body        string     `json:"body"`
+		Draft       bool       `json:"draft"`
+		PublishedAt *time.Time `json:"published_at"`
+		Assets      []struct {
+			Name               string `json:"name"`
+			BrowserDownloadURL string `json:"browser_download_url"`
+		} `json:"assets"`
+	} `json:"release"`
+}
+
+type projectWebhookService struct {
+	projectRepo repository.ProjectRepository
+	webhookRepo repository.ProjectWebhookRepository
+	releaseRepo repository.ProjectReleaseRepository
+	userRepo    userRepo.UserRepository
+	authorizer  service.ProjectAuthorizer
+}
+
+func NewProjectWebhookService(
+	projectRepo repository.ProjectRepository,
+	webhookRepo repository.ProjectWebhookRepository,
+	releaseRepo repository.ProjectReleaseRepository,
+	userRepo userRepo.UserRepository,
+	authorizer service.ProjectAuthorizer,
+) service.ProjectWebhookService {
+	return &projectWebhookService{
+		projectRepo: projectRepo,
+		webhookRepo: webhookRepo,
+		releaseRepo: releaseRepo,
+		userRepo:    userRepo,
+		authorizer:  authorizer,
+	}
+}
+
+func (s *projectWebhookService) GetWebhook(username string, projectID uint) (*dto.ProjectWebhook, error) {
+	if _, err := s.getEditor(username, projectID); err != nil {
+		return nil, err
+	}
+	webhook, err := s.webhookRepo.Get(projectID)
+	if err != nil {
+		return nil, err
+	}
+	events, err := s.webhookRepo.GetEvents(projectID, webhookEventsShown, 0)
+	if err != nil {
+		return nil, err
+	}
+	return formatWebhook(webhook, events), nil
+}
+
+func (s *projectWebhookService) CreateWebhook(username string, projectID uint) (*dto.ProjectWebhook, error) {
+	userID, err := s.getEditor(username, projectID)
+	if err != nil {
+		return nil, err
+	}
+	project, err := s.projectRepo.GetByIDIncludingPrivate(projectID)
+	if err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(project.GithubLink) == "" {
+		return nil, utils.ErrNoRepoLink
+	}
+	if _, ok := provider.GitHubRepoName(project.GithubLink); !ok {
+		return nil, utils.ErrUnsupportedRepoLink
+	}
+	secret := make([]byte, webhookSecretBytes)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, err
+	}
+	webhook, err := s.webhookRepo.Get(projectID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		webhook, err = &models.ProjectWebhook{ProjectID: projectID}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	webhook.Secret = hex.EncodeToString(secret)
+	webhook.CreatedBy = userID
+	if err := s.webhookRepo.Save(webhook); err != nil {
+		return nil, err
+	}
+	formatted := formatWebhook(webhook, nil)
+	formatted.Secret = webhook.Secret
+	return formatted, nil
+}
+
+func (s *projectWebhookService) DeleteWebhook(username string, projectID uint) error {
+	if _, err := s.getEditor(username, projectID); err != nil {
+		return err
+	}
+	if _, err := s.webhookRepo.Get(projectID); err != nil {
+		return err
+	}
+	return s.webhookRepo.Delete(projectID)
+}
+
+func (s *projectWebhookService) ReceiveGitHub(event, deliveryID, signature string, payload []byte) (*dto.WebhookDelivery, error) {
+	var delivery gitHubDelivery
+	if deliveryID == "" || json.Unmarshal(payload, &delivery) != nil {
+		return nil, utils.ErrInvalidPayload
+	}
+	repoName, ok := provider.GitHubRepoName(delivery.Repository.HTMLURL)
+	if !ok {
+		return nil, utils.ErrInvalidPayload
+	}
+	webhooks, err := s.webhookRepo.FindByRepo(repoName)
+	if err != nil {
+		return nil, err
+	}
+	matched := make([]models.ProjectWebhook, 0, len(webhooks))
+	for _, webhook := range webhooks {
+		if name, ok := provider.GitHubRepoName(webhook.Project.GithubLink); ok && name == repoName {
+			matched = append(matched, webhook)
+		}
+	}
+	// An unknown repository fails like a bad signature, so callers can't tell which
+	// repositories have a webhook.
+	if len(matched) == 0 {
+		return nil, utils.ErrInvalidSignature
+	}
+	result := &dto.WebhookDelivery{Event: event, Projects: make([]uint, 0)}
+	verified := false
+	for _, webhook := range matched {
+		// Forks keep the github link of their source, each project checks its own secret.
+		if !validSignature(webhook.Secret, signature, payload) {
+			continue
+		}
+		verified = true
+		applied, err := s.applyDelivery(webhook, event, deliveryID, &delivery)
+		if err != nil {
+			return nil, err
+		}
+		if applied {
+			result.Projects = append(result.Projects, webhook.ProjectID)
+		}
+	}
+	if !verified {
+		return nil, utils.ErrInvalidSignature
+	}
+	return result, nil
+}
+
+// applyDelivery applies the delivery to one project and records it.
+//
+// It reports false for events the webhook ignores and deliveries that were already received.
+func (s *projectWebhookService) applyDelivery(webhook models.ProjectWebhook, event, deliveryID string, delivery *gitHubDelivery) (bool, error) {
+	received, err := s.webhookRepo.HasEvent(webhook.ProjectID, deliveryID)
+	if err != nil || received {
+		return false, err
+	}
+	now := time.Now()
+	record := &models.ProjectWebhookEvent{
+		ProjectID:  webhook.ProjectID,
+		DeliveryID: deliveryID,
+		Event:      event,
+		Action:     delivery.Action,
+		ReceivedAt: now,
+	}
+	switch {
+	case event == gitHubEventPing:
+		record.Summary = "webhook connected"
+	case event == gitHubEventPush:
+		record.Summary = fmt.Sprintf("%s at %s", delivery.Ref, shortCommit(delivery.After))
+		if err := s.webhookRepo.TouchActivity(webhook.ProjectID, now); err != nil {
+			return false, err
+		}
+	case event == gitHubEventRelease && delivery.Action == "published" && delivery.Release != nil && !delivery.Release.Draft:
+		record.Summary = delivery.Release.TagName
+		err := s.createRelease(webhook, delivery, now)
+		if errors.Is(err, sharedUtils.ErrBadRequest) {
+			// The delivery is still accepted, the event shows why there is no release.
+			record.Error = err.Error()
+		} else if err != nil {
+			return false, err
+		}
+		if err := s.webhookRepo.TouchActivity(webhook.ProjectID, now); err != nil {
+			return false, err
+		}
+	default:
+		return false, nil
+	}
+	if err := s.webhookRepo.RecordEvent(record); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// createRelease adds the published GitHub release as a release of the project,
+// created by the user who set up the webhook.
+func (s *projectWebhookService) createRelease(webhook models.ProjectWebhook, delivery *gitHubDelivery, now time.Time) error {
+	release := delivery.Release
+	version, err := utils.ParseSemver(release.TagName)
+	if err != nil {
+		return utils.ErrInvalidVersion
+	}
+	assets := make([]dto.ReleaseArtifact, len(release.Assets))
+	for i, asset := range release.Assets {
+		assets[i] = dto.ReleaseArtifact{Name: asset.Name, URL: asset.BrowserDownloadURL}
+	}
+	artifacts, err := getReleaseArtifacts(assets)
+	if err != nil {
+		return err
+	}
+	releasedAt := now
+	if release.PublishedAt != nil {
+		releasedAt = *release.PublishedAt
+	}
+	return s.releaseRepo.Create(&models.ProjectRelease{
+		ProjectID:  webhook.ProjectID,
+		Version:    version.String(),
+		Notes:      release.Body,
+		Artifacts:  artifacts,
+		ReleasedAt: releasedAt,
+		CreatedBy:  webhook.CreatedBy,
+	})
+}
+
+// getEditor checks that the user can edit the project and returns the user ID.
+func (s *projectWebhookService) getEditor(username string, projectID uint) (uint, error) {
+	userID, err := s.userRepo.FindUserIDByUsername(username)
+	if err != nil {
+		return 0, err
+	}
+	if err := s.authorizer.Authorize(userID, projectID, models.ActionEdit); err != nil {
+		return 0, err
+	}
+	return userID, nil
+}
+
+// validSignature checks the X-Hub-Signature-256 header, "sha256=" followed by the
+// hex HMAC of the payload.
+func validSignature(secret, signature string, payload []byte) bool {
+	digest, ok := strings.CutPrefix(signature, "sha256=")
+	if !ok {
+		return false
+	}
+	got, err := hex.DecodeString(digest)
+	if err != nil {
+		return false
+	}
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+	return hmac.Equal(got, mac.Sum(nil))
+}
+
+func shortCommit(sha string) string {
+	if len(sha) > 7 {
+		return sha[:7]
+	}
+	return sha
+}
+
+func formatWebhook(webhook *models.ProjectWebhook, events []models.ProjectWebhookEvent) *dto.ProjectWebhook {
+	formatted := &dto.ProjectWebhook{
+		ProjectID:   webhook.ProjectID,
+		PayloadURL:  GitHubWebhookPath,
+		ContentType: "application/json",
+		CreatedAt:   webhook.CreatedAt,
+		Events:      make([]dto.ProjectWebhookEvent, len(events)),
+	}
+	for i, event := range events {
+		formatted.Events[i] = dto.ProjectWebhookEvent{
+			ID:         event.ID,
+			DeliveryID: event.DeliveryID,
+			Event:      event.Event,
+			Action:     event.Action,
+			Summary:    event.Summary,
+			Error:      event.Error,
+			ReceivedAt: event.ReceivedAt,
+		}
+	}
+	return formatted
+}
@@ -0,0 +1,45 @@
+package service
+
+import (
+	"errors"
+	"testing"
+
+	model "github.com/aruncs31s/esdcmodels"
+	"github.com/aruncs31s/esdcprojectmodule/interfaces/repository"
+	"github.com/aruncs31s/esdcprojectmodule/models"
+	utils "github.com/aruncs31s/esdcprojectmodule/utils"
+)
+
+type fakeWebhookRepository struct {
+	repository.ProjectWebhookRepository
+	webhooks []models.ProjectWebhook
+}
+
+func (r *fakeWebhookRepository) FindByRepo(string) ([]models.ProjectWebhook, error) {
+	return r.webhooks, nil
+}
+
+func TestReceiveGitHubHidesUnknownRepositories(t *testing.T) {
+	s := &projectWebhookService{webhookRepo: &fakeWebhookRepository{webhooks: []models.ProjectWebhook{{
+		ProjectID: 1,
+		Secret:    "secret",
+		Project:   model.Project{GithubLink: "https://github.com/alice/robot"},
+	}}}}
+
+	tests := []struct {
+		name string
+		repo string
+	}{
+		{"bad signature", "alice/robot"},
+		{"unknown repository", "alice/other"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			payload := []byte(`{"repository":{"html_url":"https://github.com/` + tt.repo + `"}}`)
+			_, err := s.ReceiveGitHub("push", "d1", "sha256=00", payload)
+			if !errors.Is(err, utils.ErrInvalidSignature) {
+				t.Errorf("ReceiveGitHub() error = %v, want %v", err, utils.ErrInvalidSignature)
+			}
+		})
+	}
+}
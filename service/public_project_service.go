@@ -13,17 +13,34 @@ import (
 
 type publicProjectsService struct {
 	publicProjectRepository repository.PublicProjectRepository
+	forkRepo                repository.ProjectForkRepository
 	userRepo                userRepo.UserRepository
 }
 
+// PublicProjectsOption configures the service built by NewPublicProjectsService.
+type PublicProjectsOption func(*publicProjectsService)
+
+// WithForkCounts fills the fork count of the projects from forkRepo, without it the
+// fork counts are left at 0.
+func WithForkCounts(forkRepo repository.ProjectForkRepository) PublicProjectsOption {
+	return func(s *publicProjectsService) {
+		s.forkRepo = forkRepo
+	}
+}
+
 func NewPublicProjectsService(
 	publicProjectRepository repository.PublicProjectRepository,
 	userRepo userRepo.UserRepository,
+	opts ...PublicProjectsOption,
 ) service.PublicProjectService {
-	return &publicProjectsService{
+	s := &publicProjectsService{
 		publicProjectRepository: publicProjectRepository,
 		userRepo:                userRepo,
 	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
 }
 
 func (s *publicProjectsService) GetAllPublicProjects(limit, offset int) (*[]dto.ProjectResponseForPublic, error) {
@@ -36,6 +53,9 @@ func (s *publicProjectsService) GetAllPublicProjects(limit, offset int) (*[]dto.
 	}
 
 	projectsPresentation := getFormatedProjects(projects)
+	if err := s.setForkCounts(projectsPresentation); err != nil {
+		return nil, err
+	}
 	return &projectsPresentation, nil
 }
 
@@ -58,6 +78,9 @@ func (s *publicProjectsService) GetAllUserProjects(user string, limit, offset in
 	}
 
 	projectsPresentation := getFormatedProjects(projects)
+	if err := s.setForkCounts(projectsPresentation); err != nil {
+		return nil, err
+	}
 	return &projectsPresentation, nil
 }
 func (s *publicProjectsService) GetProject(projectID uint) (*dto.ProjectResponseForPublic, error) {
@@ -67,9 +90,23 @@ func (s *publicProjectsService) GetProject(projectID uint) (*dto.ProjectResponse
 	}
 
 	projectPresentation := formatProject(project)
+	if s.forkRepo != nil {
+		counts, err := s.forkRepo.GetForkCounts([]uint{project.ID})
+		if err != nil {
+			return nil, err
+		}
+		projectPresentation.ForkCount = counts[project.ID]
+	}
 	return projectPresentation, nil
 }
 
+func (s *publicProjectsService) setForkCounts(projects []dto.ProjectResponseForPublic) error {
+	if s.forkRepo == nil {
+		return nil
+	}
+	return setForkCounts(s.forkRepo, projects)
+}
+
 func getFormatedProjects(projects *[]model.Project) []dto.ProjectResponseForPublic {
 	projectsPresentation := make([]dto.ProjectResponseForPublic, len(*projects))
 	for i, project := range *projects {
@@ -83,7 +120,7 @@ func formatProject(project *model.Project) *dto.ProjectResponseForPublic {
 		Title:               project.Title,
 		Description:         project.Description,
 		GithubLink:          project.GithubLink,
-		Image:               project.Image,
+		Image:               utils.GetProjectImage(project.ID, project.Image),
 		LiveUrl:             project.LiveURL,
 		CreatedAt:           project.CreatedAt,
 		UpdatedAt:           project.UpdatedAt,
@@ -97,6 +134,7 @@ func formatProject(project *model.Project) *dto.ProjectResponseForPublic {
 		Version:             project.Version,
 		Cost:                project.Cost,
 		Category:            project.Category,
+		ForkedFromID:        project.ForkedFrom,
 		CreatorDetails:      utils.GetCreatorDetails(project.Creator),
 		ContributorsDetails: utils.GetContributorsUsernames(project.Contributors),
 		TagsDetails:         utils.GetTagsNames(project.Tags),
@@ -0,0 +1,96 @@
+package storage
+
+import (
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/aruncs31s/esdcprojectmodule/interfaces/storage"
+)
+
+// ErrInvalidKey is returned for keys that would end up outside the root directory.
+var ErrInvalidKey = errors.New("storage: invalid key")
+
+type localBlobStore struct {
+	root    string
+	baseURL string
+}
+
+// NewLocalBlobStore stores blobs under root on the local filesystem.
+//
+// URLs are built as baseURL + "/" + key, serving root under baseURL is up to the caller.
+func NewLocalBlobStore(root, baseURL string) storage.BlobStore {
+	return &localBlobStore{
+		root:    root,
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+	}
+}
+
+func (s *localBlobStore) Put(key string, data io.Reader, contentType string) error {
+	filePath, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(filePath), 0o755); err != nil {
+		return err
+	}
+	// Write to a temporary file first so readers never see a half written blob.
+	tmp, err := os.CreateTemp(filepath.Dir(filePath), ".upload-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := io.Copy(tmp, data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	if err := os.Chmod(tmp.Name(), 0o644); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), filePath)
+}
+
+func (s *localBlobStore) Open(key string) (io.ReadCloser, error) {
+	filePath, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+	return os.Open(filePath)
+}
+
+func (s *localBlobStore) Delete(key string) error {
+	filePath, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(filePath); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	// Drop the directories left empty, os.Remove refuses the ones that are not.
+	root := filepath.Clean(s.root)
+	for dir := filepath.Dir(filePath); dir != root && strings.HasPrefix(dir, root); dir = filepath.Dir(dir) {
+		if os.Remove(dir) != nil {
+			break
+		}
+	}
+	return nil
+}
+
+func (s *localBlobStore) URL(key string) string {
+	return s.baseURL + "/" + key
+}
+
+func (s *localBlobStore) path(key string) (string, error) {
+	cleaned := path.Clean("/" + key)
+	if cleaned == "/" || cleaned != "/"+key {
+		return "", ErrInvalidKey
+	}
+	return filepath.Join(s.root, filepath.FromSlash(cleaned)), nil
+}
@@ -0,0 +1,46 @@
+package utils
+
+import (
+	"fmt"
+	"net/url"
+)
+
+// DefaultImageURLs builds the image URLs used when a user or project has no image of its own.
+type DefaultImageURLs struct {
+	Avatar func(username string) string
+	Cover  func(projectID uint) string
+}
+
+// generatedImageURLs point at the placeholders generated by the module itself.
+var generatedImageURLs = DefaultImageURLs{
+	Avatar: func(username string) string {
+		return "/api/public/projects/avatars/" + url.PathEscape(username) + ".svg"
+	},
+	Cover: func(projectID uint) string {
+		return fmt.Sprintf("/api/public/projects/%d/cover.svg", projectID)
+	},
+}
+
+var defaultImageURLs = generatedImageURLs
+
+// SetDefaultImageURLs replaces the builders of the default image URLs.
+//
+// A nil builder uses the generated placeholder for that kind of image.
+func SetDefaultImageURLs(urls DefaultImageURLs) {
+	defaultImageURLs = generatedImageURLs
+	if urls.Avatar != nil {
+		defaultImageURLs.Avatar = urls.Avatar
+	}
+	if urls.Cover != nil {
+		defaultImageURLs.Cover = urls.Cover
+	}
+}
+
+// GetProjectImage returns the cover of the project, or the default cover when it has none.
+func GetProjectImage(projectID uint, image *string) *string {
+	if image != nil && *image != "" {
+		return image
+	}
+	cover := defaultImageURLs.Cover(projectID)
+	return &cover
+}
@@ -0,0 +1,92 @@
+package utils
+
+import (
+	"fmt"
+
+	sharedUtils "github.com/aruncs31s/esdcsharedhelpersmodule/utils"
+)
+
+// Errors returned by the services. Each one wraps one of the shared errors so
+// handlers can pick the response with errors.Is.
+var (
+	ErrActionNotAllowed        = fmt.Errorf("%w: you are not allowed to do this on the project", sharedUtils.ErrForbidden)
+	ErrInvalidRole             = fmt.Errorf("%w: invalid contributor role", sharedUtils.ErrBadRequest)
+	ErrOwnerRole               = fmt.Errorf("%w: the owner role can not be assigned or removed", sharedUtils.ErrBadRequest)
+	ErrContributorNotFound     = fmt.Errorf("%w: contributor not found", sharedUtils.ErrNotFound)
+	ErrInviteNotFound          = fmt.Errorf("%w: invite not found", sharedUtils.ErrNotFound)
+	ErrInviteNotPending        = fmt.Errorf("%w: invite is no longer pending", sharedUtils.ErrBadRequest)
+	ErrTransferNotFound        = fmt.Errorf("%w: transfer not found", sharedUtils.ErrNotFound)
+	ErrTransferNotPending      = fmt.Errorf("%w: transfer is no longer pending", sharedUtils.ErrBadRequest)
+	ErrTransferPending         = fmt.Errorf("%w: the project already has a pending transfer", sharedUtils.ErrBadRequest)
+	ErrTransferToOwner         = fmt.Errorf("%w: the user already owns the project", sharedUtils.ErrBadRequest)
+	ErrForceNotAllowed         = fmt.Errorf("%w: only admins can force a transfer", sharedUtils.ErrForbidden)
+	ErrEmptyTitle              = fmt.Errorf("%w: title can not be empty", sharedUtils.ErrBadRequest)
+	ErrInvalidVisibility       = fmt.Errorf("%w: visibility must be public or private", sharedUtils.ErrBadRequest)
+	ErrInvalidCost             = fmt.Errorf("%w: cost can not be negative", sharedUtils.ErrBadRequest)
+	ErrRevisionNotFound        = fmt.Errorf("%w: revision not found", sharedUtils.ErrNotFound)
+	ErrInvalidVersion          = fmt.Errorf("%w: version must look like MAJOR.MINOR.PATCH", sharedUtils.ErrBadRequest)
+	ErrVersionNotIncreased     = fmt.Errorf("%w: version must be greater than the latest release and not lower than the project version", sharedUtils.ErrBadRequest)
+	ErrInvalidArtifact         = fmt.Errorf("%w: artifacts need a name and an http(s) url", sharedUtils.ErrBadRequest)
+	ErrNotDraft                = fmt.Errorf("%w: the project is not a draft", sharedUtils.ErrBadRequest)
+	ErrPublishInPast           = fmt.Errorf("%w: publish_at must be in the future", sharedUtils.ErrBadRequest)
+	ErrPublishWithUpdate       = fmt.Errorf("%w: use publish to make a draft live", sharedUtils.ErrBadRequest)
+	ErrImageTooLarge           = fmt.Errorf("%w: image is too large", sharedUtils.ErrBadRequest)
+	ErrUnsupportedImage        = fmt.Errorf("%w: image must be a jpeg, png, gif or webp", sharedUtils.ErrBadRequest)
+	ErrImageNotFound           = fmt.Errorf("%w: the project has no uploaded image", sharedUtils.ErrNotFound)
+	ErrUnsupportedFile         = fmt.Errorf("%w: file type is not allowed as an attachment", sharedUtils.ErrBadRequest)
+	ErrFileTooLarge            = fmt.Errorf("%w: file is larger than allowed for its type", sharedUtils.ErrBadRequest)
+	ErrAttachmentNotFound      = fmt.Errorf("%w: attachment not found", sharedUtils.ErrNotFound)
+	ErrInvalidOrder            = fmt.Errorf("%w: order must list every attachment of the project once", sharedUtils.ErrBadRequest)
+	ErrRepoNotFound            = fmt.Errorf("%w: repository not found on the code host", sharedUtils.ErrNotFound)
+	ErrUnsupportedRepoLink     = fmt.Errorf("%w: the github link is not a supported repository", sharedUtils.ErrBadRequest)
+	ErrRepoNotSynced           = fmt.Errorf("%w: the repository of the project has not been synced yet", sharedUtils.ErrNotFound)
+	ErrNoRepoLink              = fmt.Errorf("%w: the project has no github link", sharedUtils.ErrBadRequest)
+	ErrRepoAlreadyLinked       = fmt.Errorf("%w: another project already links to the repository", sharedUtils.ErrBadRequest)
+	ErrNoImportURL             = fmt.Errorf("%w: url of the repository to import is required", sharedUtils.ErrBadRequest)
+	ErrInvalidSignature        = fmt.Errorf("%w: the webhook signature does not match", sharedUtils.ErrForbidden)
+	ErrInvalidPayload          = fmt.Errorf("%w: the webhook payload could not be read", sharedUtils.ErrBadRequest)
+	ErrAdminOnly               = fmt.Errorf("%w: only admins can do this", sharedUtils.ErrForbidden)
+	ErrHiddenStatus            = fmt.Errorf("%w: only moderators can hide or trash a project or bring it back", sharedUtils.ErrForbidden)
+	ErrInvalidReason           = fmt.Errorf("%w: reason must be spam, inappropriate, copyright, malicious or other", sharedUtils.ErrBadRequest)
+	ErrAlreadyReported         = fmt.Errorf("%w: you already reported the project", sharedUtils.ErrBadRequest)
+	ErrReportOwnProject        = fmt.Errorf("%w: you can not report your own project", sharedUtils.ErrBadRequest)
+	ErrInvalidModerationAction = fmt.Errorf("%w: action must be hide, warn, remove or dismiss", sharedUtils.ErrBadRequest)
+	ErrInvalidFeatureRange     = fmt.Errorf("%w: ends_at must be after starts_at", sharedUtils.ErrBadRequest)
+	ErrFeatureNotFound         = fmt.Errorf("%w: the project is not featured", sharedUtils.ErrNotFound)
+	ErrPinNotOwner             = fmt.Errorf("%w: you can only pin your own projects", sharedUtils.ErrForbidden)
+	ErrTooManyPins             = fmt.Errorf("%w: you already pinned as many projects as allowed", sharedUtils.ErrBadRequest)
+	ErrPinNotFound             = fmt.Errorf("%w: the project is not pinned", sharedUtils.ErrNotFound)
+	ErrInvalidPinOrder         = fmt.Errorf("%w: order must list every pinned project once", sharedUtils.ErrBadRequest)
+	ErrEmptyStatus             = fmt.Errorf("%w: status can not be empty", sharedUtils.ErrBadRequest)
+	ErrInvalidStatus           = fmt.Errorf("%w: status must be active, inactive, archived, draft, hidden or trashed", sharedUtils.ErrBadRequest)
+	ErrRepoRateLimited         = fmt.Errorf("%w: the code host rate limit was reached, try again later", sharedUtils.ErrInternal)
+	ErrNoUsernames             = fmt.Errorf("%w: no usernames provided", sharedUtils.ErrBadRequest)
+	ErrInvalidSlug             = fmt.Errorf("%w: slug must be lowercase letters and digits separated by dashes", sharedUtils.ErrBadRequest)
+	ErrSlugTaken               = fmt.Errorf("%w: another collection already uses the slug", sharedUtils.ErrBadRequest)
+	ErrCollectionNotFound      = fmt.Errorf("%w: collection not found", sharedUtils.ErrNotFound)
+	ErrCollectionNotOwner      = fmt.Errorf("%w: you can only change your own collections", sharedUtils.ErrForbidden)
+	ErrDuplicateProject        = fmt.Errorf("%w: a project can only be in the collection once", sharedUtils.ErrBadRequest)
+	ErrProjectNotVisible       = fmt.Errorf("%w: you can only add projects you can see", sharedUtils.ErrForbidden)
+	ErrBookmarkNotFound        = fmt.Errorf("%w: the project is not bookmarked", sharedUtils.ErrNotFound)
+	ErrFolderNotFound          = fmt.Errorf("%w: bookmark folder not found", sharedUtils.ErrNotFound)
+	ErrEmptyFolderName         = fmt.Errorf("%w: folder name can not be empty", sharedUtils.ErrBadRequest)
+	ErrFolderNameTaken         = fmt.Errorf("%w: you already have a folder with that name", sharedUtils.ErrBadRequest)
+	ErrProjectNotFound         = fmt.Errorf("%w: project not found", sharedUtils.ErrNotFound)
+	ErrInvalidBulkOperation    = fmt.Errorf("%w: operation must be set_status, set_visibility, add_tag, remove_tag, trash, restore or transfer_owner", sharedUtils.ErrBadRequest)
+	ErrBulkTarget              = fmt.Errorf("%w: send either project ids or a filter", sharedUtils.ErrBadRequest)
+	ErrTooManyBulkProjects     = fmt.Errorf("%w: too many projects for one bulk operation", sharedUtils.ErrBadRequest)
+	ErrEmptyTag                = fmt.Errorf("%w: tag can not be empty", sharedUtils.ErrBadRequest)
+	ErrNoNewOwner              = fmt.Errorf("%w: username of the new owner is required", sharedUtils.ErrBadRequest)
+	ErrTrashedStatus           = fmt.Errorf("%w: use the trash and restore operations to trash a project", sharedUtils.ErrBadRequest)
+	ErrProjectTrashed          = fmt.Errorf("%w: the project is in the trash, restore it first", sharedUtils.ErrBadRequest)
+	ErrInvalidExportFormat     = fmt.Errorf("%w: format must be csv, json or ndjson", sharedUtils.ErrBadRequest)
+	ErrUnsupportedImport       = fmt.Errorf("%w: the body must be csv or json", sharedUtils.ErrBadRequest)
+	ErrInvalidImportMode       = fmt.Errorf("%w: mode must be all_or_nothing or best_effort", sharedUtils.ErrBadRequest)
+	ErrNoImportRows            = fmt.Errorf("%w: there are no projects to import", sharedUtils.ErrBadRequest)
+	ErrTooManyImportRows       = fmt.Errorf("%w: too many projects for one import", sharedUtils.ErrBadRequest)
+	ErrNoCreator               = fmt.Errorf("%w: creator is required", sharedUtils.ErrBadRequest)
+	ErrUnknownUser             = fmt.Errorf("%w: no user with the username", sharedUtils.ErrNotFound)
+	ErrInvalidImportDraft      = fmt.Errorf("%w: draft must be true or false", sharedUtils.ErrBadRequest)
+	ErrInvalidImportPublishAt  = fmt.Errorf("%w: publish_at must be an RFC 3339 time", sharedUtils.ErrBadRequest)
+	ErrProjectNotTrashed       = fmt.Errorf("%w: the project is not in the trash", sharedUtils.ErrBadRequest)
+)
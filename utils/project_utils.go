@@ -11,15 +11,14 @@ func GetCreatorDetails(creator commonModules.User) dto.Contributor {
 		ID:    int(creator.ID),
 		Name:  creator.Username,
 		Email: creator.Email,
-		Image: getImage(creator.Image),
+		Image: getImage(creator.Username, creator.Image),
 	}
 }
-func getImage(image *string) string {
-	if image != nil {
+func getImage(username string, image *string) string {
+	if image != nil && *image != "" {
 		return *image
 	}
-	// Default image URL
-	return "https://example.com/default-image.png"
+	return defaultImageURLs.Avatar(username)
 }
 func GetContributorsUsernames(contributors *[]commonModules.User) *[]dto.Contributor {
 	if contributors == nil {
@@ -30,7 +29,7 @@ func GetContributorsUsernames(contributors *[]commonModules.User) *[]dto.Contrib
 		usernames = append(usernames, dto.Contributor{
 			ID:    int(user.ID),
 			Name:  user.Username,
-			Image: getImage(user.Image),
+			Image: getImage(user.Username, user.Image),
 			Email: user.Email,
 		})
 	}
@@ -0,0 +1,59 @@
+package utils
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Semver is a parsed MAJOR.MINOR.PATCH version.
+type Semver struct {
+	Major int
+	Minor int
+	Patch int
+}
+
+// ParseSemver parses versions like "1.2.3" or "v1.2.3".
+func ParseSemver(version string) (Semver, error) {
+	parts := strings.Split(strings.TrimPrefix(strings.TrimSpace(version), "v"), ".")
+	if len(parts) != 3 {
+		return Semver{}, fmt.Errorf("invalid version %q", version)
+	}
+	numbers := make([]int, 3)
+	for i, part := range parts {
+		n, err := strconv.Atoi(part)
+		if err != nil || n < 0 || part == "" || (len(part) > 1 && part[0] == '0') {
+			return Semver{}, fmt.Errorf("invalid version %q", version)
+		}
+		numbers[i] = n
+	}
+	return Semver{Major: numbers[0], Minor: numbers[1], Patch: numbers[2]}, nil
+}
+
+func (v Semver) String() string {
+	return fmt.Sprintf("%d.%d.%d", v.Major, v.Minor, v.Patch)
+}
+
+// Compare returns -1, 0 or 1 when v is lower than, equal to or greater than other.
+func (v Semver) Compare(other Semver) int {
+	for _, d := range []int{v.Major - other.Major, v.Minor - other.Minor, v.Patch - other.Patch} {
+		if d < 0 {
+			return -1
+		}
+		if d > 0 {
+			return 1
+		}
+	}
+	return 0
+}
+
+// BumpPatch returns the version with the patch number increased.
+// Versions that are not semver start over from 0.0.1.
+func BumpPatch(version string) string {
+	v, err := ParseSemver(version)
+	if err != nil {
+		return "0.0.1"
+	}
+	v.Patch++
+	return v.String()
+}
@@ -0,0 +1,30 @@
+package utils
+
+import (
+	"regexp"
+	"strings"
+)
+
+// MaxSlugLength is the longest slug Slugify returns and IsSlug accepts.
+const MaxSlugLength = 100
+
+var (
+	slugPattern   = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+	slugSeparator = regexp.MustCompile(`[^a-z0-9]+`)
+)
+
+// Slugify turns a title like "Tech Fest 2026 finalists" into "tech-fest-2026-finalists".
+//
+// Returns an empty string when the title has no letters or digits.
+func Slugify(title string) string {
+	slug := strings.Trim(slugSeparator.ReplaceAllString(strings.ToLower(title), "-"), "-")
+	if len(slug) > MaxSlugLength {
+		slug = strings.TrimRight(slug[:MaxSlugLength], "-")
+	}
+	return slug
+}
+
+// IsSlug reports whether slug is made of lowercase letters and digits separated by single dashes.
+func IsSlug(slug string) bool {
+	return len(slug) <= MaxSlugLength && slugPattern.MatchString(slug)
+}